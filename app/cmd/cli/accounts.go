@@ -22,11 +22,14 @@ import (
 func init() {
 	rootCmd.AddCommand(accountsCmd)
 	accountsCmd.AddCommand(createCmd)
+	accountsCmd.AddCommand(recoverCmd)
 	accountsCmd.AddCommand(getValidator)
 	accountsCmd.AddCommand(setValidator)
 	accountsCmd.AddCommand(deleteCmd)
 	accountsCmd.AddCommand(listCmd)
 	accountsCmd.AddCommand(showCmd)
+	accountsCmd.AddCommand(labelCmd)
+	accountsCmd.AddCommand(watchCmd)
 	accountsCmd.AddCommand(updatePassphraseCmd)
 	accountsCmd.AddCommand(signCmd)
 	accountsCmd.AddCommand(importArmoredCmd)
@@ -35,6 +38,8 @@ func init() {
 	accountsCmd.AddCommand(exportRawCmd)
 	accountsCmd.AddCommand(sendTxCmd)
 	accountsCmd.AddCommand(sendRawTxCmd)
+	accountsCmd.AddCommand(signTxCmd)
+	accountsCmd.AddCommand(broadcastTxCmd)
 	accountsCmd.AddCommand(newMultiPublicKey)
 	accountsCmd.AddCommand(signMS)
 	accountsCmd.AddCommand(signNexMS)
@@ -54,13 +59,26 @@ from creating and deleting accounts; to importing and exporting accounts.`,
 
 var pwd, oldPwd, decryptPwd, encryptPwd string
 
+var (
+	createMnemonic bool
+	recoverIndex   uint32
+	recoverRange   string
+	watchLabel     string
+)
+
 func init() {
+	createCmd.Flags().BoolVar(&createMnemonic, "mnemonic", false, "derive the account from a new mnemonic, to recover it and more accounts with accounts recover")
+	recoverCmd.Flags().Uint32Var(&recoverIndex, "index", 0, "the index of the account to recover")
+	recoverCmd.Flags().StringVar(&recoverRange, "range", "", "the indexes of the accounts to recover, as <first>-<last>")
+	watchCmd.Flags().StringVar(&watchLabel, "label", "", "the label of the watched address")
+	recoverCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	buildMultisig.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	createCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	deleteCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	sendTxCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	setValidator.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	signTxCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signMS.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signNexMS.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 
@@ -80,9 +98,11 @@ func init() {
 
 // createCmd represents the create command
 var createCmd = &cobra.Command{
-	Use:   "create",
+	Use:   "create [--mnemonic]",
 	Short: "Create a new account",
 	Long: `Creates and persists a new account in the Keybase.
+With --mnemonic, the account is derived from a new BIP-39 mnemonic, which is printed once: accounts recover derives
+the account and the next ones from it again.
 Will prompt the user for a passphrase to encrypt the generated keypair.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
@@ -92,6 +112,16 @@ Will prompt the user for a passphrase to encrypt the generated keypair.`,
 		fmt.Print("Enter passphrase again: \n")
 		confirmedpass := app.Credentials(pwd)
 		if pass == confirmedpass {
+			if createMnemonic {
+				mnemonic, kp, err := kb.CreateMnemonic(confirmedpass)
+				if err != nil {
+					fmt.Printf("Account generation Failed, %s", err)
+					return
+				}
+				fmt.Printf("Account generated successfully:\nAddress: %s\nPath: %s\n", kp.GetAddress(), kp.HDPath)
+				fmt.Printf("\nWrite down this mnemonic, it is stored nowhere and recovers this account and the next ones with accounts recover:\n\n%s\n", mnemonic)
+				return
+			}
 			kp, err := kb.Create(confirmedpass)
 			if err != nil {
 				fmt.Printf("Account generation Failed, %s", err)
@@ -106,6 +136,82 @@ Will prompt the user for a passphrase to encrypt the generated keypair.`,
 	},
 }
 
+var recoverCmd = &cobra.Command{
+	Use:   "recover [<mnemonic>] [--index <index> | --range <first>-<last>]",
+	Short: "Recover accounts from a mnemonic",
+	Long: `Derives the accounts of the BIP-39 mnemonic at the SLIP-0010 path m/44'/635'/0'/0'/<index>', and persists them in
+the Keybase with their derivation path. The account at index 0 is recovered by default.
+Prompts for the mnemonic when it is not given, so it stays out of the shell history.
+Will prompt the user for a passphrase to encrypt the recovered keypairs.`,
+	Args: cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		indexes, err := recoverIndexes(cmd.Flags().Changed("index"))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		mnemonic := strings.Join(args, " ")
+		if mnemonic == "" {
+			fmt.Println("Enter mnemonic: ")
+			mnemonic = app.Credentials("")
+		}
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
+		fmt.Print("Enter Passphrase: \n")
+		pass := app.Credentials(pwd)
+		fmt.Print("Enter passphrase again: \n")
+		if pass != app.Credentials(pwd) {
+			fmt.Println("Account recovery Failed, Passphrases do not match")
+			return
+		}
+		kps, err := kb.Recover(mnemonic, indexes, pass)
+		if err != nil {
+			fmt.Printf("Account recovery Failed, %s\n", err)
+			return
+		}
+		fmt.Println("Accounts recovered successfully:")
+		for _, kp := range kps {
+			fmt.Printf("%s\t%s\n", kp.HDPath, kp.GetAddress())
+		}
+	},
+}
+
+// maxRecoverRange is the most accounts a --range recovers at once, each one is derived and encrypted in the keybase
+const maxRecoverRange = 1000
+
+// recoverIndexes returns the indexes of the --index or --range flags
+func recoverIndexes(indexSet bool) ([]uint32, error) {
+	if recoverRange == "" {
+		return []uint32{recoverIndex}, nil
+	}
+	if indexSet {
+		return nil, fmt.Errorf("--index and --range cannot be used together")
+	}
+	bounds := strings.Split(recoverRange, "-")
+	if len(bounds) != 2 {
+		return nil, fmt.Errorf("the range %s is not <first>-<last>", recoverRange)
+	}
+	first, err := strconv.ParseUint(bounds[0], 10, 31)
+	if err != nil {
+		return nil, fmt.Errorf("invalid first index %s: %s", bounds[0], err.Error())
+	}
+	last, err := strconv.ParseUint(bounds[1], 10, 31)
+	if err != nil {
+		return nil, fmt.Errorf("invalid last index %s: %s", bounds[1], err.Error())
+	}
+	if first > last {
+		return nil, fmt.Errorf("the first index of the range %s is after the last", recoverRange)
+	}
+	if last-first >= maxRecoverRange {
+		return nil, fmt.Errorf("the range %s has more than %d indexes", recoverRange, maxRecoverRange)
+	}
+	var indexes []uint32
+	for i := first; i <= last; i++ {
+		indexes = append(indexes, uint32(i))
+	}
+	return indexes, nil
+}
+
 var getNodesLean = &cobra.Command{
 	Use:   "get-validators",
 	Short: "Retrieves all nodes set by set-validators",
@@ -207,19 +313,18 @@ Will prompt the user for the account passphrase`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
-		kb := app.MustGetKeybase()
-		if kb == nil {
-			fmt.Println(app.UninitializedKeybaseError.Error())
-			return
-
-		}
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
 		addr, err := types.AddressFromHex(args[0])
 		if err != nil {
 			fmt.Printf("Address Error %s", err)
 			return
 		}
-		fmt.Print("Enter passphrase: \n")
-		err = kb.Delete(addr, app.Credentials(pwd))
+		passphrase := ""
+		if kp, err := kb.Get(addr); err != nil || !kp.IsWatchOnly() {
+			fmt.Print("Enter passphrase: \n")
+			passphrase = app.Credentials(pwd)
+		}
+		err = kb.Delete(addr, passphrase)
 		if err != nil {
 			fmt.Printf("Error Deleting Account, check your credentials")
 			return
@@ -236,11 +341,7 @@ var unsafeDeleteCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
-		kb := app.MustGetKeybase()
-		if kb == nil {
-			fmt.Println(app.UninitializedKeybaseError.Error())
-			return
-		}
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
 		addr, err := types.AddressFromHex(args[0])
 		if err != nil {
 			fmt.Printf("Address error %s", err)
@@ -262,31 +363,38 @@ var unsafeDeleteCmd = &cobra.Command{
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all accounts",
-	Long: `Lists all the account addresses stored in the keybase.
+	Long: `Lists all the account addresses stored in the keybase, with their labels.
 Example output:
-	(0) b3746D30F2A579a2efe7F2F6E8E06277a78054C1
-	(1) ab514F27e98DE7E3ecE3789b511dA955C3F09Bbc`,
+	(0) b3746D30F2A579a2efe7F2F6E8E06277a78054C1 servicer-1
+	(1) ab514F27e98DE7E3ecE3789b511dA955C3F09Bbc output-1 (watch-only)`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
-		kb := app.MustGetKeybase()
-		if kb == nil {
-			fmt.Println(app.UninitializedKeybaseError.Error())
-			return
-		}
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
 		kp, err := kb.List()
 		if err != nil {
 			fmt.Printf("Error retrieving accounts from keybase, %s", err)
 			return
 		}
+		if len(kp) == 0 {
+			fmt.Println(app.UninitializedKeybaseError.Error())
+			return
+		}
 		for i, key := range kp {
-			fmt.Printf("(%d) %s\n", i, key.GetAddress().String())
+			line := fmt.Sprintf("(%d) %s", i, key.GetAddress().String())
+			if key.Label != "" {
+				line += " " + key.Label
+			}
+			if key.IsWatchOnly() {
+				line += " (watch-only)"
+			}
+			fmt.Println(line)
 		}
 	},
 }
 
 // showCmd represents the show command
 var showCmd = &cobra.Command{
-	Use:   "show <address>",
+	Use:   "show <address | label>",
 	Short: "Shows a pubkey for address",
 	Long: `Lists an account address and public key.
 Example output:
@@ -295,24 +403,89 @@ Example output:
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
-		kb := app.MustGetKeybase()
-		if kb == nil {
-			fmt.Println(app.UninitializedKeybaseError.Error())
-			return
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
+		kp, err := kb.GetByLabel(args[0])
+		if err != nil {
+			addr, err := types.AddressFromHex(args[0])
+			if err != nil {
+				fmt.Printf("Address Error, %s", err)
+				return
+			}
+			kp, err = kb.Get(addr)
+			if err != nil {
+				fmt.Printf("Error Getting pubkey For Address, %s", err)
+				return
+			}
+		}
+		pubKey := "unknown"
+		if kp.PublicKey != nil {
+			pubKey = hex.EncodeToString(kp.PublicKey.RawBytes())
+		}
+		fmt.Printf("Address:\t%s\nPublic Key:\t%s\n", kp.GetAddress().String(), pubKey)
+		if kp.Label != "" {
+			fmt.Printf("Label:\t\t%s\n", kp.Label)
+		}
+		if kp.IsWatchOnly() {
+			fmt.Println("Watch-only:\ttrue")
 		}
+		if kp.IsDerived() {
+			fmt.Printf("Path:\t\t%s\nSeed:\t\t%s\n", kp.HDPath, kp.SeedFingerprint)
+		}
+	},
+}
+
+var labelCmd = &cobra.Command{
+	Use:   "label <address> [<label>]",
+	Short: "Labels an account",
+	Long: `Sets the human label of an account of the keybase, shown by accounts list and used by accounts show and overview.
+Labels are unique in the keybase. Without <label>, the label of the account is removed.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
 		addr, err := types.AddressFromHex(args[0])
 		if err != nil {
-			fmt.Printf("Address Error, %s", err)
+			fmt.Printf("Address Error, %s\n", err)
+			return
+		}
+		label := ""
+		if len(args) == 2 {
+			label = args[1]
+		}
+		if err := kb.SetLabel(addr, label); err != nil {
+			fmt.Printf("Error Labeling Account, %s\n", err)
 			return
 		}
-		kp, err := kb.Get(addr)
+		fmt.Println("Account labeled successfully")
+	},
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch <address | publicKey> [--label <label>]",
+	Short: "Adds a watch-only account",
+	Long: `Adds an address to the keybase without its private key, to label it and follow it with accounts overview:
+an output address, an application or a servicer of another machine. A watch-only account can't sign, and is replaced
+by its private key if it is imported later on.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
+		var pubKey crypto.PublicKey
+		addr, err := types.AddressFromHex(args[0])
 		if err != nil {
-			fmt.Printf("Error Getting pubkey For Address, %s", err)
+			pubKey, err = crypto.NewPublicKey(args[0])
+			if err != nil {
+				fmt.Printf("Neither an address nor a public key: %s\n", args[0])
+				return
+			}
+			addr = types.Address(pubKey.Address())
+		}
+		kp, err := kb.AddWatchOnly(addr, pubKey, watchLabel)
+		if err != nil {
+			fmt.Printf("Error Adding Watch-only Account, %s\n", err)
 			return
 		}
-		fmt.Printf("Address:\t%s\nPublic Key:\t%s\n",
-			kp.GetAddress().String(),
-			hex.EncodeToString(kp.PublicKey.RawBytes()))
+		fmt.Printf("Watch-only account added successfully:\nAddress: %s\n", kp.GetAddress())
 	},
 }
 
@@ -514,6 +687,7 @@ Prompts the user for <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(6),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		amount, err := strconv.Atoi(args[2])
 		if err != nil {
 			fmt.Println(err)
@@ -526,23 +700,12 @@ Prompts the user for <fromAddr> account passphrase.`,
 		}
 		memo := args[5]
 		fmt.Printf("Adding Memo: %v\n", memo)
-		fmt.Println("Enter passphrase: ")
-		res, err := SendTransaction(args[0], args[1], app.Credentials(pwd), args[3], types.NewInt(int64(amount)), int64(fees), memo, false)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := SendTransaction(args[0], args[1], txPassphrase(generateOnly), args[3], types.NewInt(int64(amount)), int64(fees), memo, false, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
 
@@ -629,10 +792,12 @@ var newMultiPublicKey = &cobra.Command{
 }
 
 var buildMultisig = &cobra.Command{
-	Use:   "build-MS-Tx <signer-address> <json-message> <ordered-comma-separated-hex-pubkeys> <networkID> <fees>",
+	Use:   "build-MS-Tx <signer-address> <json-message | tx-file> <ordered-comma-separated-hex-pubkeys> <networkID> <fees>",
 	Short: "Build and sign a multisig tx",
 	Args:  cobra.ExactArgs(5),
-	Long:  `Build and sign a multisignature transaction from scratch: result is hex encoded std tx object.`,
+	Long: `Build and sign a multisignature transaction from scratch: result is hex encoded std tx object.
+A transaction file written with --generate-only can be given instead of the json message, its fee is kept and the signed
+transaction is written back to the file.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		msg := args[1]
@@ -648,6 +813,12 @@ var buildMultisig = &cobra.Command{
 		}
 
 		multiSigPubKey := crypto.PublicKeyMultiSignature{PublicKeys: pks}
+		if f, ok := readTxFileArg(msg, args[3]); ok {
+			fmt.Println("Enter passphrase: ")
+			f, err := app.BuildMultisigTxFile(args[0], f, app.Credentials(pwd), multiSigPubKey)
+			writeMultisigTxFile(msg, f, err)
+			return
+		}
 		fmt.Println("Enter passphrase: ")
 		fees, err := strconv.Atoi(args[4])
 		if err != nil {
@@ -663,10 +834,11 @@ var buildMultisig = &cobra.Command{
 }
 
 var signMS = &cobra.Command{
-	Use:   "sign-ms-tx <signer-address> <hex-amino-stdtx> <hex-pubkeys> <networkID> ",
+	Use:   "sign-ms-tx <signer-address> <hex-amino-stdtx | tx-file> <hex-pubkeys> <networkID> ",
 	Short: "sign a multisig tx",
-	Long:  `sign a multisignature transaction using public keys, and the transaciton object, result is hex encoded std tx object`,
-	Args:  cobra.ExactArgs(4),
+	Long: `sign a multisignature transaction using public keys, and the transaciton object, result is hex encoded std tx object
+The transaction can also be a transaction file, the signed transaction is then written back to the file.`,
+	Args: cobra.ExactArgs(4),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		msg := args[1]
@@ -680,6 +852,12 @@ var signMS = &cobra.Command{
 			}
 			pks = append(pks, p)
 		}
+		if f, ok := readTxFileArg(msg, args[3]); ok {
+			fmt.Println("Enter passphrase: ")
+			f, err := app.SignMultisigTxFile(args[0], f, app.Credentials(pwd), pks)
+			writeMultisigTxFile(msg, f, err)
+			return
+		}
 		fmt.Println("Enter passphrase: ")
 		bz, err := app.SignMultisigOutOfOrder(args[0], msg, app.Credentials(pwd), args[3], pks, false)
 		if err != nil {
@@ -690,14 +868,21 @@ var signMS = &cobra.Command{
 }
 
 var signNexMS = &cobra.Command{
-	Use:   "sign-ms-next <signer-address> <hex-stdtx> <networkID> ",
+	Use:   "sign-ms-next <signer-address> <hex-stdtx | tx-file> <networkID> ",
 	Short: "Sign a multisig tx",
 	Long: `Sign a multisignature transaction using the transaciton object, result is hex encoded std tx object
+The transaction can also be a transaction file, the signed transaction is then written back to the file.
 NOTE: you MUST be the next signer (in order of public keys in the ms public key object) or the signature will be invalid.`,
 	Args: cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		msg := args[1]
+		if f, ok := readTxFileArg(msg, args[2]); ok {
+			fmt.Println("Enter password: ")
+			f, err := app.SignMultisigTxFile(args[0], f, app.Credentials(pwd), nil)
+			writeMultisigTxFile(msg, f, err)
+			return
+		}
 		fmt.Println("Enter password: ")
 		bz, err := app.SignMultisigNext(args[0], msg, app.Credentials(pwd), args[2], false)
 		if err != nil {
@@ -706,3 +891,101 @@ NOTE: you MUST be the next signer (in order of public keys in the ms public key
 		fmt.Println("Multisig transaction: \n" + hex.EncodeToString(bz))
 	},
 }
+
+var signTxCmd = &cobra.Command{
+	Use:   "sign-tx <tx-file>",
+	Short: "Sign a transaction file",
+	Long: `Signs the unsigned transaction of <tx-file>, written by a transaction command with --generate-only, with the key of
+its signer. Only the keybase is needed, so the keys can stay on an offline host. The signed transaction is written back to
+<tx-file>, to be sent with accounts broadcast.
+Will prompt the user for the signer account passphrase.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		f, err := app.ReadTxFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		kb, err := app.GetKeybase()
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Signing the transaction of %s for %s\n", f.ChainID, f.Signer)
+		fmt.Println("Enter passphrase: ")
+		f, err = f.Sign(kb, app.Credentials(pwd))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if err = f.Write(args[0]); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Signed transaction written to " + args[0])
+	},
+}
+
+var broadcastTxCmd = &cobra.Command{
+	Use:   "broadcast <tx-file>",
+	Short: "Send a signed transaction file",
+	Long:  `Sends the signed transaction of <tx-file>, signed with accounts sign-tx or the multisig commands, through /v1/client/rawtx.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		f, err := app.ReadTxFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if !f.IsSigned() {
+			fmt.Println("the transaction is not signed, sign it with accounts sign-tx")
+			return
+		}
+		bz, err := f.Encode()
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sendRawTx(&rpc.SendRawTxParams{
+			Addr:        f.Signer,
+			RawHexBytes: hex.EncodeToString(bz),
+		}, "")
+	},
+}
+
+// readTxFileArg reads the transaction file of a multisig command, when the argument is a file rather than the json
+// message or hex transaction
+func readTxFileArg(arg, chainID string) (app.TxFile, bool) {
+	if _, err := os.Stat(arg); err != nil {
+		return app.TxFile{}, false
+	}
+	f, err := app.ReadTxFile(arg)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if f.ChainID != chainID {
+		fmt.Printf("the transaction of %s is for %s, not %s\n", arg, f.ChainID, chainID)
+		os.Exit(1)
+	}
+	return f, true
+}
+
+func writeMultisigTxFile(path string, f app.TxFile, err error) {
+	if err != nil {
+		fmt.Println(fmt.Errorf("error signing the multisig: %v", err))
+		return
+	}
+	if err = f.Write(path); err != nil {
+		fmt.Println(err)
+		return
+	}
+	bz, err := f.Encode()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Multisig transaction written to " + path + ": \n" + hex.EncodeToString(bz))
+}
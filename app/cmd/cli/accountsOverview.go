@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/app/cmd/rpc"
+	"github.com/pokt-network/pocket-core/crypto/keys"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	overviewAll    bool
+	overviewJSON   bool
+	overviewHeight int64
+)
+
+// overviewClaimsPerPage is the page size used to count the pending claims of an address
+const overviewClaimsPerPage = 1000
+
+func init() {
+	overviewCmd.Flags().BoolVar(&overviewAll, "all", false, "include the accounts without a label")
+	overviewCmd.Flags().BoolVar(&overviewJSON, "json", false, "print the overview as json")
+	overviewCmd.Flags().Int64Var(&overviewHeight, "height", 0, "the height of the state queried, defaults to the latest height")
+	accountsCmd.AddCommand(overviewCmd)
+}
+
+var overviewCmd = &cobra.Command{
+	Use:   "overview [--all] [--json] [--height <height>]",
+	Short: "Shows the on-chain state of the labeled accounts",
+	Long: `Queries the remote cli url for the balance, the node and the application of every labeled or watch-only
+account of the keybase, and prints them as a table: the stake and jailed status of a node or an application, and the
+pending claims of a node with the relays they prove. With --all, the accounts without a label are included.
+Example output:
+	LABEL                  ADDRESS                                   BALANCE   NODE    NODE STAKE   JAILED  APP STAKE  CLAIMS
+	servicer-1             b3746d30f2a579a2efe7f2f6e8e06277a78054c1  1000000   Staked  15000000000  false   -          2 (418 relays)
+	output-1 (watch-only)  ab514f27e98de7e3ece3789b511da955c3f09bbc  98000000  -       -            -       -          -`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
+		kps, err := kb.List()
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var rows []accountOverview
+		for _, kp := range kps {
+			if !overviewAll && kp.Label == "" && !kp.IsWatchOnly() {
+				continue
+			}
+			rows = append(rows, queryAccountOverview(kp, overviewHeight))
+		}
+		if overviewJSON {
+			if rows == nil {
+				rows = []accountOverview{}
+			}
+			bz, _ := json.MarshalIndent(rows, "", "    ")
+			fmt.Println(string(bz))
+			return
+		}
+		if len(rows) == 0 {
+			fmt.Println("No labeled or watch-only accounts in the keybase, see accounts label and accounts watch, or use --all")
+			return
+		}
+		printAccountsOverview(rows)
+	},
+}
+
+// accountOverview is the on-chain state of an account of the keybase
+type accountOverview struct {
+	Label     string                `json:"label,omitempty"`
+	Address   string                `json:"address"`
+	WatchOnly bool                  `json:"watch_only"`
+	Balance   string                `json:"balance,omitempty"`
+	Node      *stakeOverview        `json:"node,omitempty"`
+	App       *stakeOverview        `json:"app,omitempty"`
+	Claims    *pendingClaimsSummary `json:"pending_claims,omitempty"`
+	Errors    []string              `json:"errors,omitempty"`
+}
+
+// stakeOverview is the stake of a node or an application
+type stakeOverview struct {
+	Status string `json:"status"`
+	Jailed bool   `json:"jailed"`
+	Stake  string `json:"stake"`
+}
+
+// pendingClaimsSummary counts the claims of a node that aren't proven yet
+type pendingClaimsSummary struct {
+	Claims int   `json:"claims"`
+	Relays int64 `json:"relays"`
+}
+
+func queryAccountOverview(kp keys.KeyPair, height int64) accountOverview {
+	address := kp.GetAddress().String()
+	row := accountOverview{Label: kp.Label, Address: address, WatchOnly: kp.IsWatchOnly()}
+	addError := func(what string, err error) {
+		row.Errors = append(row.Errors, fmt.Sprintf("%s: %s", what, err))
+	}
+	params, _ := json.Marshal(rpc.HeightAndAddrParams{Height: height, Address: address})
+	if res, err := queryRPC(GetBalancePath, params, false); err != nil {
+		addError("balance", err)
+	} else {
+		var balance struct {
+			Balance json.Number `json:"balance"`
+		}
+		if err := json.Unmarshal([]byte(res), &balance); err != nil {
+			addError("balance", err)
+		} else {
+			row.Balance = balance.Balance.String()
+		}
+	}
+	if res, err := queryRPC(GetNodePath, params, false); err != nil {
+		if !strings.Contains(err.Error(), "validator not found for") {
+			addError("node", err)
+		}
+	} else {
+		var node struct {
+			Status sdk.StakeStatus `json:"status"`
+			Jailed bool            `json:"jailed"`
+			Stake  string          `json:"tokens"`
+		}
+		if err := json.Unmarshal([]byte(res), &node); err != nil {
+			addError("node", err)
+		} else {
+			row.Node = &stakeOverview{Status: node.Status.String(), Jailed: node.Jailed, Stake: node.Stake}
+		}
+	}
+	if res, err := queryRPC(GetAppPath, params, false); err != nil {
+		if !strings.Contains(err.Error(), "application does not exist for that address") {
+			addError("app", err)
+		}
+	} else {
+		var application struct {
+			Status sdk.StakeStatus `json:"status"`
+			Jailed bool            `json:"jailed"`
+			Stake  string          `json:"staked_tokens"`
+		}
+		if err := json.Unmarshal([]byte(res), &application); err != nil {
+			addError("app", err)
+		} else {
+			row.App = &stakeOverview{Status: application.Status.String(), Jailed: application.Jailed, Stake: application.Stake}
+		}
+	}
+	if row.Node != nil {
+		claims, err := queryPendingClaims(address, height)
+		if err != nil {
+			addError("claims", err)
+		} else {
+			row.Claims = &claims
+		}
+	}
+	return row
+}
+
+// queryPendingClaims pages through the claims of the node at the height
+func queryPendingClaims(address string, height int64) (pendingClaimsSummary, error) {
+	var summary pendingClaimsSummary
+	for page := 1; ; page++ {
+		params, _ := json.Marshal(rpc.PaginatedHeightAndAddrParams{Height: height, Addr: address, Page: page, PerPage: overviewClaimsPerPage})
+		res, err := queryRPC(GetNodeClaimsPath, params, false)
+		if err != nil {
+			return summary, err
+		}
+		var p struct {
+			Result []struct {
+				TotalProofs int64 `json:"total_proofs"`
+			} `json:"result"`
+			Total int `json:"total_pages"`
+		}
+		if err := json.Unmarshal([]byte(res), &p); err != nil {
+			return summary, err
+		}
+		for _, c := range p.Result {
+			summary.Claims++
+			summary.Relays += c.TotalProofs
+		}
+		if page >= p.Total {
+			return summary, nil
+		}
+	}
+}
+
+func printAccountsOverview(rows []accountOverview) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "LABEL\tADDRESS\tBALANCE\tNODE\tNODE STAKE\tJAILED\tAPP STAKE\tCLAIMS")
+	for _, r := range rows {
+		label := r.Label
+		if label == "" {
+			label = "-"
+		}
+		if r.WatchOnly {
+			label += " (watch-only)"
+		}
+		node, nodeStake, jailed, appStake, claims := "-", "-", "-", "-", "-"
+		if r.Node != nil {
+			node, nodeStake, jailed = r.Node.Status, r.Node.Stake, fmt.Sprint(r.Node.Jailed)
+		}
+		if r.App != nil {
+			appStake = r.App.Stake
+			if r.App.Status != sdk.StakeStatusStaked {
+				appStake += " (" + r.App.Status + ")"
+			}
+			if r.App.Jailed {
+				appStake += " (jailed)"
+			}
+		}
+		if r.Claims != nil {
+			claims = fmt.Sprintf("%d (%d relays)", r.Claims.Claims, r.Claims.Relays)
+		}
+		balance := r.Balance
+		if balance == "" {
+			balance = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", label, r.Address, balance, node, nodeStake, jailed, appStake, claims)
+	}
+	_ = w.Flush()
+	for _, r := range rows {
+		for _, e := range r.Errors {
+			fmt.Printf("error for %s: %s\n", r.Address, e)
+		}
+	}
+}
@@ -2,7 +2,6 @@ package cli
 
 import (
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -19,6 +18,7 @@ func init() {
 	rootCmd.AddCommand(appCmd)
 	appCmd.AddCommand(appStakeCmd)
 	appCmd.AddCommand(appUnstakeCmd)
+	appCmd.AddCommand(appPartialUnstakeCmd)
 	appCmd.AddCommand(createAATCmd)
 }
 
@@ -46,6 +46,7 @@ If no changes are desired for the parameter, just enter the current param value
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		fromAddr := args[0]
 		amount, err := strconv.Atoi(args[1])
 		if err != nil {
@@ -63,23 +64,17 @@ If no changes are desired for the parameter, just enter the current param value
 		}
 		rawChains := reg.ReplaceAllString(args[2], "")
 		chains := strings.Split(rawChains, ",")
-		fmt.Println("Enter passphrase: ")
-		res, err := StakeApp(chains, fromAddr, app.Credentials(pwd), args[3], types.NewInt(int64(amount)), int64(fee), false)
+		pubKey, err := txPublicKey(cmd, fromAddr, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		res, err := StakeApp(chains, fromAddr, pubKey, txPassphrase(generateOnly), args[3], types.NewInt(int64(amount)), int64(fee), false, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
 
@@ -91,28 +86,47 @@ Prompts the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		fee, err := strconv.Atoi(args[2])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnstakeApp(args[0], app.Credentials(pwd), args[1], int64(fee), false)
+		res, err := UnstakeApp(args[0], txPassphrase(generateOnly), args[1], int64(fee), false, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		sendRawTx(res, generateOnly)
+	},
+}
+
+var appPartialUnstakeCmd = &cobra.Command{
+	Use:   "partial-unstake <fromAddr> <amount> <networkID> <fee>",
+	Short: "Release part of an app's stake",
+	Long: `Remove <amount> from the stake of an app that stays staked, the app must remain above the minimum stake.
+The max relays of the app are lowered right away and the amount is released to the app account after the unstaking time.
+Prompts the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
+		amount, ok := types.NewIntFromString(args[1])
+		if !ok {
+			fmt.Println("invalid amount: " + args[1])
+			return
+		}
+		fee, err := strconv.Atoi(args[3])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := PartialUnstakeApp(args[0], txPassphrase(generateOnly), args[2], amount, int64(fee), generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
 
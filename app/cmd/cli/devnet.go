@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/spf13/cobra"
+)
+
+var (
+	devnetDir              string
+	devnetNodes            int
+	devnetApps             int
+	devnetChains           []string
+	devnetBlocksPerSession int64
+	devnetBasePort         int
+	devnetMockPort         int
+	devnetMocksOnly        bool
+)
+
+func init() {
+	devnetCmd.Flags().StringVar(&devnetDir, "dir", "devnet", "the directory of the devnet, with a datadir per node")
+	devnetCmd.Flags().IntVar(&devnetNodes, "nodes", 4, "the number of staked validators")
+	devnetCmd.Flags().IntVar(&devnetApps, "apps", 1, "the number of staked applications")
+	devnetCmd.Flags().StringSliceVar(&devnetChains, "chains", []string{"0001"}, "the relay chains, each served by a mock blockchain")
+	devnetCmd.Flags().Int64Var(&devnetBlocksPerSession, "blocks-per-session", app.DefaultDevnetBlocksPerSession, "the blocks of a session")
+	devnetCmd.Flags().IntVar(&devnetBasePort, "base-port", app.DefaultDevnetBasePort, "the ports of node i start at base-port + 10*i")
+	devnetCmd.Flags().IntVar(&devnetMockPort, "mock-port", app.DefaultDevnetMockPort, "the mock blockchain of chain j listens on mock-port + j")
+	devnetCmd.Flags().BoolVar(&devnetMocksOnly, "mocks-only", false, "serve the mock blockchains of the existing devnet in --dir")
+	utilCmd.AddCommand(devnetCmd)
+}
+
+var devnetCmd = &cobra.Command{
+	Use:   "devnet [--nodes <n>] [--apps <m>] [--chains <chain>,<chain>]",
+	Short: "Generates a local network and serves its mock blockchains",
+	Long: `Generates a local network of <n> staked validators and <m> staked applications in --dir: the genesis, keys, configs,
+persistent peers and chains of every node, with sessions of a few seconds long blocks.
+Then serves a mock JSON-RPC blockchain per chain, answering every request with a result derived from the request only,
+so relays, claims and proofs run end to end without external services. Start each node in another terminal with:
+pocket start --datadir <dir>/node<i> --keybase=false
+The devnet.json manifest lists the nodes, the application keys and the mock blockchains.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		var devnet app.Devnet
+		var err error
+		if devnetMocksOnly {
+			devnet, err = app.ReadDevnet(devnetDir)
+		} else {
+			devnet, err = app.GenerateDevnet(app.DevnetOptions{
+				Dir:              devnetDir,
+				Nodes:            devnetNodes,
+				Apps:             devnetApps,
+				Chains:           devnetChains,
+				BlocksPerSession: devnetBlocksPerSession,
+				BasePort:         devnetBasePort,
+				MockPort:         devnetMockPort,
+			})
+		}
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		for _, node := range devnet.Nodes {
+			fmt.Printf("node %s: pocket start --datadir %s --keybase=false\n", node.Address, node.DataDir)
+		}
+		for _, a := range devnet.Apps {
+			fmt.Printf("app %s: %s\n", a.Address, a.PublicKey)
+		}
+		servers, err := app.ServeDevnetMocks(devnet)
+		if err != nil {
+			fmt.Println(err)
+		}
+		for i := range servers {
+			fmt.Printf("chain %s: mock blockchain on %s\n", devnet.Chains[i].ID, devnet.Chains[i].URL)
+		}
+		if err == nil {
+			signalChannel := make(chan os.Signal, 1)
+			signal.Notify(signalChannel, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
+			<-signalChannel
+		}
+		for _, srv := range servers {
+			_ = srv.Close()
+		}
+	},
+}
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	utilCmd.AddCommand(genesisCmd)
+	genesisCmd.AddCommand(genesisValidateCmd)
+	genesisCmd.AddCommand(genesisAddAccountCmd)
+	genesisCmd.AddCommand(genesisAddValidatorCmd)
+	genesisCmd.AddCommand(genesisAddAppCmd)
+	genesisCmd.AddCommand(genesisDiffCmd)
+}
+
+var genesisCmd = &cobra.Command{
+	Use:   "genesis",
+	Short: "genesis file tools",
+	Long:  `Validate, edit and compare genesis files, when preparing a network or a reset.`,
+}
+
+var genesisValidateCmd = &cobra.Command{
+	Use:   "validate <genesisFile>",
+	Short: "Validates a genesis file",
+	Long: `Runs the genesis validation of every module, then checks the modules agree with each other:
+the accounts are unique, the staked pools hold the staked tokens and the supply is the coins of the accounts.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		g, err := app.ReadGenesisFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		errs := g.Validate()
+		for _, err := range errs {
+			fmt.Println("ERROR: " + err.Error())
+		}
+		if len(errs) != 0 {
+			fmt.Printf("%s is invalid: %d errors\n", args[0], len(errs))
+			return
+		}
+		fmt.Printf("%s is valid\n", args[0])
+	},
+}
+
+var genesisAddAccountCmd = &cobra.Command{
+	Use:   "add-account <genesisFile> <publicKey> <amount>",
+	Short: "Adds an account to a genesis file",
+	Long:  `Adds the account of <publicKey> with <amount> tokens. The genesis file is only replaced if it is still valid.`,
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		editGenesis(args[0], func(g app.GenesisFile) error {
+			pubKey, err := crypto.NewPublicKey(args[1])
+			if err != nil {
+				return err
+			}
+			amount, err := parseGenesisAmount(args[2])
+			if err != nil {
+				return err
+			}
+			return g.AddAccount(pubKey, amount)
+		})
+	},
+}
+
+var genesisAddValidatorCmd = &cobra.Command{
+	Use:   "add-validator <genesisFile> <publicKey> <relayChainIDs> <serviceURI> <amount> [<outputAddress>]",
+	Short: "Stakes a validator in a genesis file",
+	Long: `Stakes the validator of <publicKey> with <amount> tokens for the comma separated <relayChainIDs>,
+optionally with a custodial <outputAddress>. The staked pool and the supply follow, if the genesis holds them.
+The genesis file is only replaced if it is still valid.`,
+	Args: cobra.RangeArgs(5, 6),
+	Run: func(cmd *cobra.Command, args []string) {
+		editGenesis(args[0], func(g app.GenesisFile) error {
+			pubKey, err := crypto.NewPublicKey(args[1])
+			if err != nil {
+				return err
+			}
+			amount, err := parseGenesisAmount(args[4])
+			if err != nil {
+				return err
+			}
+			var output sdk.Address
+			if len(args) == 6 {
+				output, err = sdk.AddressFromHex(args[5])
+				if err != nil {
+					return err
+				}
+			}
+			return g.AddValidator(pubKey, strings.Split(args[2], ","), args[3], amount, output)
+		})
+	},
+}
+
+var genesisAddAppCmd = &cobra.Command{
+	Use:   "add-app <genesisFile> <publicKey> <relayChainIDs> <amount>",
+	Short: "Stakes an application in a genesis file",
+	Long: `Stakes the application of <publicKey> with <amount> tokens for the comma separated <relayChainIDs>, its max relays
+are computed from the stake at genesis. The staked pool and the supply follow, if the genesis holds them.
+The genesis file is only replaced if it is still valid.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		editGenesis(args[0], func(g app.GenesisFile) error {
+			pubKey, err := crypto.NewPublicKey(args[1])
+			if err != nil {
+				return err
+			}
+			amount, err := parseGenesisAmount(args[3])
+			if err != nil {
+				return err
+			}
+			return g.AddApp(pubKey, strings.Split(args[2], ","), amount)
+		})
+	},
+}
+
+var genesisDiffCmd = &cobra.Command{
+	Use:   "diff <genesisFile> <otherGenesisFile>",
+	Short: "Compares two genesis files",
+	Long: `Prints the values that differ between the genesis files, module by module. The accounts, validators
+and applications are matched by address.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		left, err := app.ReadGenesisFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		right, err := app.ReadGenesisFile(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		diffs, err := app.DiffGenesis(left, right)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		for _, d := range diffs {
+			fmt.Printf("%s:\n  - %s\n  + %s\n", d.Path, genesisValue(d.Left), genesisValue(d.Right))
+		}
+		fmt.Printf("%d differences\n", len(diffs))
+	},
+}
+
+// editGenesis applies the edit to the genesis file, and writes it back if still valid
+func editGenesis(path string, edit func(g app.GenesisFile) error) {
+	g, err := app.ReadGenesisFile(path)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := edit(g); err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := g.Write(path); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%s updated\n", path)
+}
+
+func parseGenesisAmount(s string) (sdk.BigInt, error) {
+	amount, ok := sdk.NewIntFromString(s)
+	if !ok || !amount.IsPositive() {
+		return sdk.BigInt{}, fmt.Errorf("invalid amount: %s", s)
+	}
+	return amount, nil
+}
+
+func genesisValue(v interface{}) string {
+	if v == nil {
+		return "(absent)"
+	}
+	bz, _ := json.Marshal(v)
+	return string(bz)
+}
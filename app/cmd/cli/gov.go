@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
@@ -44,6 +43,7 @@ Actions: [burn, transfer]`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		toAddr := args[2]
 		fromAddr := args[1]
 		amount, err := strconv.Atoi(args[0])
@@ -56,24 +56,13 @@ Actions: [burn, transfer]`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		pass := app.Credentials(pwd)
-		res, err := DAOTx(fromAddr, toAddr, pass, types.NewInt(int64(amount)), "dao_transfer", args[3], int64(fees), false)
+		pass := txPassphrase(generateOnly)
+		res, err := DAOTx(fromAddr, toAddr, pass, types.NewInt(int64(amount)), "dao_transfer", args[3], int64(fees), false, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
 
@@ -85,6 +74,7 @@ Actions: [burn, transfer]`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		var toAddr string
 		if len(args) == 4 {
 			toAddr = args[2]
@@ -100,24 +90,13 @@ Actions: [burn, transfer]`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		pass := app.Credentials(pwd)
-		res, err := DAOTx(fromAddr, toAddr, pass, types.NewInt(int64(amount)), "dao_burn", args[3], int64(fees), false)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		pass := txPassphrase(generateOnly)
+		res, err := DAOTx(fromAddr, toAddr, pass, types.NewInt(int64(amount)), "dao_burn", args[3], int64(fees), false, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
 var govChangeParam = &cobra.Command{
@@ -128,29 +107,19 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
-		fmt.Println("Enter Password: ")
+		generateOnly := generateOnlyFlag(cmd)
 		fees, err := strconv.Atoi(args[4])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
 
-		res, err := ChangeParam(args[0], args[2], []byte(args[3]), app.Credentials(pwd), args[1], int64(fees), false)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
+		res, err := ChangeParam(args[0], args[2], []byte(args[3]), txPassphrase(generateOnly), args[1], int64(fees), false, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
 
@@ -162,6 +131,7 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		i, err := strconv.Atoi(args[1])
 		if err != nil {
 			log.Fatal(err)
@@ -176,23 +146,12 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			return
 		}
 
-		fmt.Println("Enter Password: ")
-		res, err := Upgrade(args[0], u, app.Credentials(pwd), args[3], int64(fees), false)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := Upgrade(args[0], u, txPassphrase(generateOnly), args[3], int64(fees), false, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
 
@@ -215,6 +174,7 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		height := args[1]
 		key := args[2]
 		fstring := fmt.Sprintf("%s:%s", key, height)
@@ -230,22 +190,11 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			return
 		}
 
-		fmt.Println("Enter Password: ")
-		res, err := Upgrade(args[0], u, app.Credentials(pwd), args[3], int64(fees), false)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := Upgrade(args[0], u, txPassphrase(generateOnly), args[3], int64(fees), false, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
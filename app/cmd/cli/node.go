@@ -1,9 +1,9 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/types"
 	"github.com/spf13/cobra"
 	"strconv"
 )
@@ -11,6 +11,7 @@ import (
 func init() {
 	rootCmd.AddCommand(nodesCmd)
 	nodesCmd.AddCommand(nodeUnstakeCmd)
+	nodesCmd.AddCommand(nodePartialUnstakeCmd)
 	nodesCmd.AddCommand(nodeUnjailCmd)
 }
 
@@ -23,6 +24,7 @@ from staking and unstaking; to unjailing.`,
 
 func init() {
 	nodeUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	nodePartialUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	nodeUnjailCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 }
 
@@ -34,6 +36,7 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		fee, err := strconv.Atoi(args[3])
 		if err != nil {
 			fmt.Println(err)
@@ -44,23 +47,41 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnstakeNode(args[0], args[1], app.Credentials(pwd), args[2], int64(fee), isBefore8)
+		res, err := UnstakeNode(args[0], args[1], txPassphrase(generateOnly), args[2], int64(fee), isBefore8, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		sendRawTx(res, generateOnly)
+	},
+}
+
+var nodePartialUnstakeCmd = &cobra.Command{
+	Use:   "partial-unstake <operatorAddr> <fromAddr> <amount> <networkID> <fee>",
+	Short: "Release part of a node's stake",
+	Long: `Remove <amount> from the stake of a node that stays staked, the node must remain above the minimum stake.
+The amount is released to the output address (or the operator address) after the unstaking time, and can be slashed until then.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
+		amount, ok := types.NewIntFromString(args[2])
+		if !ok {
+			fmt.Println("invalid amount: " + args[2])
+			return
+		}
+		fee, err := strconv.Atoi(args[4])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := PartialUnstakeNode(args[0], args[1], txPassphrase(generateOnly), args[3], amount, int64(fee), generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
 
@@ -72,6 +93,7 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		fee, err := strconv.Atoi(args[3])
 		if err != nil {
 			fmt.Println(err)
@@ -82,22 +104,11 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Password: ")
-		res, err := UnjailNode(args[0], args[1], app.Credentials(pwd), args[2], int64(fee), isBefore8)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := UnjailNode(args[0], args[1], txPassphrase(generateOnly), args[2], int64(fee), isBefore8, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
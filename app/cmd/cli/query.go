@@ -3,12 +3,15 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
 
 	"github.com/pokt-network/pocket-core/app/cmd/rpc"
 	"github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/verify"
 	types2 "github.com/pokt-network/pocket-core/x/apps/types"
+	coreTypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/pokt-network/pocket-core/app"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
@@ -30,8 +33,11 @@ func init() {
 	queryCmd.AddCommand(queryApp)
 	queryCmd.AddCommand(queryNodeParams)
 	queryCmd.AddCommand(queryAppParams)
+	queryCmd.AddCommand(queryNodeUnbonding)
+	queryCmd.AddCommand(queryAppUnbonding)
 	queryCmd.AddCommand(queryNodeClaims)
 	queryCmd.AddCommand(queryNodeClaim)
+	queryCmd.AddCommand(querySession)
 	queryCmd.AddCommand(queryPocketParams)
 	queryCmd.AddCommand(queryPocketSupportedChains)
 	queryCmd.AddCommand(querySupply)
@@ -41,6 +47,12 @@ func init() {
 	queryCmd.AddCommand(queryParam)
 	queryCmd.AddCommand(queryDAOOwner)
 	queryCmd.AddCommand(querySigningInfo)
+	queryCmd.AddCommand(queryVerify)
+	for _, c := range []*cobra.Command{queryBalance, queryAccount, queryNode, queryApp, queryNodeClaim, queryParam} {
+		c.Flags().BoolVar(&proveQuery, "prove", false, "include the merkle proof of the result, verifiable with the "+queryVerify.Name()+" command")
+	}
+	queryVerify.Flags().StringVar(&verifyAddress, "address", "", "the expected address of the proved account, node or app")
+	queryVerify.Flags().StringVar(&verifyParam, "param", "", "the expected acl key of the proved param e.g. pos/StakeMinimum")
 }
 
 var queryCmd = &cobra.Command{
@@ -282,6 +294,7 @@ var queryBalance = &cobra.Command{
 		params := rpc.HeightAndAddrParams{
 			Height:  int64(height),
 			Address: args[0],
+			Prove:   proveQuery,
 		}
 		j, err := json.Marshal(params)
 		if err != nil {
@@ -318,6 +331,7 @@ var queryAccount = &cobra.Command{
 		params := rpc.HeightAndAddrParams{
 			Height:  int64(height),
 			Address: args[0],
+			Prove:   proveQuery,
 		}
 		j, err := json.Marshal(params)
 		if err != nil {
@@ -432,6 +446,7 @@ var queryNode = &cobra.Command{
 		params := rpc.HeightAndAddrParams{
 			Height:  int64(height),
 			Address: args[0],
+			Prove:   proveQuery,
 		}
 		j, err := json.Marshal(params)
 		if err != nil {
@@ -561,6 +576,7 @@ var queryApp = &cobra.Command{
 		params := rpc.HeightAndAddrParams{
 			Height:  int64(height),
 			Address: args[0],
+			Prove:   proveQuery,
 		}
 		j, err := json.Marshal(params)
 		if err != nil {
@@ -576,6 +592,78 @@ var queryApp = &cobra.Command{
 	},
 }
 
+var queryNodeUnbonding = &cobra.Command{
+	Use:   "node-unbonding <address> [<height>]",
+	Short: "Gets the unbonding stake of a node",
+	Long:  `Retrieves the partially unstaked tokens of the node at <address> that are not released yet, at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetNodeUnbondingPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryAppUnbonding = &cobra.Command{
+	Use:   "app-unbonding <address> [<height>]",
+	Short: "Gets the unbonding stake of an app",
+	Long:  `Retrieves the partially unstaked tokens of the app at <address> that are not released yet, at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetAppUnbondingPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryAppParams = &cobra.Command{
 	Use:   "app-params [<height>]",
 	Short: "Gets app parameters",
@@ -677,6 +765,7 @@ var queryNodeClaim = &cobra.Command{
 			SBlockHeight: int64(sessionheight),
 			Height:       int64(height),
 			ReceiptType:  args[2],
+			Prove:        proveQuery,
 		}
 		j, err := json.Marshal(params)
 		if err != nil {
@@ -692,6 +781,45 @@ var queryNodeClaim = &cobra.Command{
 	},
 }
 
+var querySession = &cobra.Command{
+	Use:   "session <appPubKey> <relayChainID> [<sessionHeight>]",
+	Short: "Calculates a session from the state",
+	Long: `Regenerates the session of <appPubKey> for <relayChainID> that started at <sessionHeight> from historical state.
+Returns the session key, the nodes selected and the ordered list of candidates drawn, with the reason each rejected one was skipped.
+Defaults to the latest session.`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var sessionHeight int
+		if len(args) == 2 {
+			sessionHeight = 0 // latest
+		} else {
+			var err error
+			sessionHeight, err = strconv.Atoi(args[2])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.QuerySessionParams{
+			AppPubKey:    args[0],
+			Blockchain:   args[1],
+			SBlockHeight: int64(sessionHeight),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetSessionPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryPocketParams = &cobra.Command{
 	Use:   "pocket-params [<height>]",
 	Short: "Gets pocket parameters",
@@ -916,6 +1044,7 @@ var queryParam = &cobra.Command{
 		params := rpc.HeightAndKeyParams{
 			Height: int64(height),
 			Key:    args[0],
+			Prove:  proveQuery,
 		}
 		j, err := json.Marshal(params)
 		if err != nil {
@@ -1003,3 +1132,87 @@ var querySigningInfo = &cobra.Command{
 		fmt.Println(res)
 	},
 }
+
+var (
+	proveQuery    bool
+	verifyAddress string
+	verifyParam   string
+)
+
+var queryVerify = &cobra.Command{
+	Use:   "verify <responseFile> <trustedBlockFile>",
+	Short: "Verifies a proved query response against a trusted block",
+	Long: `Verifies the merkle proof of a query response made with --prove against the app hash of a trusted block.
+The trusted block file is the output of the block query for the height following the proved height (<proof height> + 1), from a source you trust.
+Use --address or --param to also check that the proof is for the expected object.
+Prints the value decoded from the verified proof, which should be used instead of the result returned by the node.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		bz, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var res rpc.ProvedResponse
+		if err = json.Unmarshal(bz, &res); err != nil {
+			fmt.Println("error unmarshalling the proved response: ", err.Error())
+			return
+		}
+		bz, err = ioutil.ReadFile(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var block coreTypes.ResultBlock
+		if err = app.Codec().UnmarshalJSON(bz, &block); err != nil {
+			fmt.Println("error unmarshalling the trusted block: ", err.Error())
+			return
+		}
+		if err = verifyProofKey(res.Proof); err != nil {
+			fmt.Println(err)
+			return
+		}
+		if err = res.Proof.VerifyHeader(block.Block.Header); err != nil {
+			fmt.Println("the proof is INVALID: ", err.Error())
+			return
+		}
+		value, err := app.DecodeStoreProofValue(res.Proof)
+		if err != nil {
+			fmt.Println("the proof is valid but the value could not be decoded: ", err.Error())
+			return
+		}
+		j, err := app.Codec().MarshalJSONIndent(value, "", "    ")
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("the proof is valid for %s/%s at height %d\n%s\n", res.Proof.Store, res.Proof.Key, res.Proof.Height, j)
+	},
+}
+
+// verifyProofKey checks the proof is for the object given by the --address or --param flags
+func verifyProofKey(proof verify.StoreProof) error {
+	switch {
+	case verifyParam != "":
+		store, key := verify.ParamKey(verifyParam)
+		return proof.VerifyKey(store, key)
+	case verifyAddress != "":
+		addr, err := types.AddressFromHex(verifyAddress)
+		if err != nil {
+			return err
+		}
+		var store string
+		var key []byte
+		switch proof.Store {
+		case nodeTypes.StoreKey:
+			store, key = verify.NodeKey(addr)
+		case types2.StoreKey:
+			store, key = verify.AppKey(addr)
+		default:
+			store, key = verify.AccountKey(addr)
+		}
+		return proof.VerifyKey(store, key)
+	}
+	return nil
+}
@@ -2,11 +2,14 @@ package cli
 
 import (
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"strconv"
 	"time"
@@ -34,6 +37,9 @@ var (
 	GetSigningInfoPath,
 	GetAppsPath,
 	GetAppParamsPath,
+	GetNodeUnbondingPath,
+	GetAppUnbondingPath,
+	GetSessionPath,
 	GetPocketParamsPath,
 	GetNodeClaimsPath,
 	GetNodeClaimPath,
@@ -88,6 +94,12 @@ func init() {
 			GetAppsPath = route.Path
 		case "QueryAppParams":
 			GetAppParamsPath = route.Path
+		case "QueryNodeUnbonding":
+			GetNodeUnbondingPath = route.Path
+		case "QueryAppUnbonding":
+			GetAppUnbondingPath = route.Path
+		case "QuerySession":
+			GetSessionPath = route.Path
 		case "QueryPocketParams":
 			GetPocketParamsPath = route.Path
 		case "QueryBlockTxs":
@@ -113,10 +125,17 @@ func init() {
 }
 
 func QueryRPC(path string, jsonArgs []byte) (string, error) {
+	return queryRPC(path, jsonArgs, true)
+}
+
+// queryRPC queries the remote cli url, printing it first unless the output is parsed
+func queryRPC(path string, jsonArgs []byte, printURL bool) (string, error) {
 	//cliURL := app.GlobalConfig.PocketConfig.RemoteCLIURL + ":" + app.GlobalConfig.PocketConfig.RPCPort + path
 	cliURL := app.GlobalConfig.PocketConfig.RemoteCLIURL + path
 	types.SetRPCTimeout(app.GlobalConfig.PocketConfig.RPCTimeout)
-	fmt.Println(cliURL)
+	if printURL {
+		fmt.Println(cliURL)
+	}
 	req, err := http.NewRequest("POST", cliURL, bytes.NewBuffer(jsonArgs))
 	if err != nil {
 		return "", err
@@ -151,7 +170,7 @@ func QueryRPC(path string, jsonArgs []byte) (string, error) {
 
 func QuerySecuredRPC(path string, jsonArgs []byte, token sdk.AuthToken) (string, error) {
 	//cliURL := app.GlobalConfig.PocketConfig.RemoteCLIURL + ":" + app.GlobalConfig.PocketConfig.RPCPort + path
-	cliURL := app.GlobalConfig.PocketConfig.RemoteCLIURL + path
+	cliURL := privateRPCURL(app.GlobalConfig.PocketConfig) + path
 	types.SetRPCTimeout(app.GlobalConfig.PocketConfig.RPCTimeout)
 	fmt.Println(cliURL)
 	req, err := http.NewRequest("POST", cliURL, bytes.NewBuffer(jsonArgs))
@@ -159,11 +178,14 @@ func QuerySecuredRPC(path string, jsonArgs []byte, token sdk.AuthToken) (string,
 		return "", err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	q := req.URL.Query()
-	q.Add("authtoken", token.Value)
-	req.URL.RawQuery = q.Encode()
+	req.Header.Set("Authorization", "Bearer "+token.Value)
+	transport, err := privateRPCTransport(app.GlobalConfig.PocketConfig)
+	if err != nil {
+		return "", err
+	}
 	client := &http.Client{
-		Timeout: types.GetRPCTimeout() * time.Millisecond,
+		Timeout:   types.GetRPCTimeout() * time.Millisecond,
+		Transport: transport,
 	}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -188,3 +210,49 @@ func QuerySecuredRPC(path string, jsonArgs []byte, token sdk.AuthToken) (string,
 	}
 	return "", fmt.Errorf("the http status code was not okay: %d, and the status was: %s, with a response of %v", resp.StatusCode, resp.Status, string(bz))
 }
+
+// privateRPCURL is the url of the private routes, on the private address of the node when it has one
+func privateRPCURL(config sdk.PocketConfig) string {
+	if config.RPCPrivateAddr == "" {
+		return config.RemoteCLIURL
+	}
+	host, port, err := net.SplitHostPort(config.RPCPrivateAddr)
+	if err != nil {
+		return config.RemoteCLIURL
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	scheme := "http"
+	if config.RPCTLSCertFile != "" {
+		scheme = "https"
+	}
+	return scheme + "://" + net.JoinHostPort(host, port)
+}
+
+// privateRPCTransport trusts the certificate of the node, which may be self signed, and presents the client certificate
+// of the cli for mutual tls
+func privateRPCTransport(config sdk.PocketConfig) (http.RoundTripper, error) {
+	if config.RPCTLSCertFile == "" && config.RPCCLICertFile == "" {
+		return http.DefaultTransport, nil
+	}
+	c := &tls.Config{MinVersion: tls.VersionTLS12}
+	if config.RPCTLSCertFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if bz, err := ioutil.ReadFile(config.RPCTLSCertFile); err == nil {
+			pool.AppendCertsFromPEM(bz)
+		}
+		c.RootCAs = pool
+	}
+	if config.RPCCLICertFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.RPCCLICertFile, config.RPCCLIKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load the cli client certificate: %s", err.Error())
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+	return &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: c}, nil
+}
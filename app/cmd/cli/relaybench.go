@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	relayBenchNode        string
+	relayBenchChain       string
+	relayBenchAppKey      string
+	relayBenchDevnet      string
+	relayBenchServicer    string
+	relayBenchConcurrency int
+	relayBenchRate        float64
+	relayBenchCount       int
+	relayBenchDuration    time.Duration
+	relayBenchData        string
+	relayBenchMethod      string
+	relayBenchPath        string
+	relayBenchBlockTime   time.Duration
+	relayBenchAuthToken   string
+	relayBenchJSON        bool
+)
+
+func init() {
+	relayBenchCmd.Flags().StringVar(&relayBenchNode, "node", "", "the url of the node, defaults to the remote cli url or the first node of the devnet")
+	relayBenchCmd.Flags().StringVar(&relayBenchChain, "chain", "", "the relay chain, defaults to the first chain of the devnet")
+	relayBenchCmd.Flags().StringVar(&relayBenchAppKey, "app-key", "", "the hex private key of a staked application, a synthetic application is generated without it")
+	relayBenchCmd.Flags().StringVar(&relayBenchDevnet, "devnet", "", "the directory of a devnet, to relay for its first application")
+	relayBenchCmd.Flags().StringVar(&relayBenchServicer, "servicer", "", "the address of the only session node relayed to, required by a synthetic application")
+	relayBenchCmd.Flags().IntVar(&relayBenchConcurrency, "concurrency", app.DefaultRelayBenchConcurrency, "the relays in flight at once")
+	relayBenchCmd.Flags().Float64Var(&relayBenchRate, "rate", 0, "the relays started per second, 0 doesn't limit them")
+	relayBenchCmd.Flags().IntVar(&relayBenchCount, "count", 0, "the relays sent, defaults to 1000 unless a duration is set")
+	relayBenchCmd.Flags().DurationVar(&relayBenchDuration, "duration", 0, "the length of the test, when no count is set")
+	relayBenchCmd.Flags().StringVar(&relayBenchData, "data", app.DefaultRelayBenchPayload, "the request relayed to the chain")
+	relayBenchCmd.Flags().StringVar(&relayBenchMethod, "method", "", "the http method of a rest request")
+	relayBenchCmd.Flags().StringVar(&relayBenchPath, "path", "", "the path of a rest request")
+	relayBenchCmd.Flags().DurationVar(&relayBenchBlockTime, "block-time", 0, "the expected time between blocks, to dispatch a new session once it ends (default 15m, 5s for a devnet)")
+	relayBenchCmd.Flags().StringVar(&relayBenchAuthToken, "auth-token", "", "the auth token of the private rpc, to count the stored evidence, defaults to the auth.json of the node")
+	relayBenchCmd.Flags().BoolVar(&relayBenchJSON, "json", false, "print the report as json")
+	utilCmd.AddCommand(relayBenchCmd)
+}
+
+var relayBenchCmd = &cobra.Command{
+	Use:   "relay-bench [--devnet <dir>] [--app-key <hex>] [--concurrency <n>] [--rate <relays/s>] [--count <n> | --duration <d>]",
+	Short: "Load tests the relays of a node",
+	Long: `Signs relays with a new client key and an AAT of the application, and sends them to the /v1/client/relay of the
+session nodes at --concurrency and --rate. Prints the latency percentiles, the errors by codespace and code, and how much
+evidence the node stored, counted through its private /v1/private/evidence route before and after the test.
+With --devnet the relays are for the first application of the devnet, dispatched by its first node.
+Without --app-key or --devnet, the relays are for a synthetic application in a session built from the chain state with
+the --servicer only: every relay runs the validation of the servicer up to the application lookup, and is rejected.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := app.RelayBenchOptions{
+			NodeURL:     relayBenchNode,
+			Chain:       relayBenchChain,
+			Servicer:    relayBenchServicer,
+			Concurrency: relayBenchConcurrency,
+			Rate:        relayBenchRate,
+			Count:       relayBenchCount,
+			Duration:    relayBenchDuration,
+			Payload:     pocketTypes.Payload{Data: relayBenchData, Method: relayBenchMethod, Path: relayBenchPath},
+			BlockTime:   relayBenchBlockTime,
+			AuthToken:   relayBenchAuthToken,
+		}
+		if relayBenchDevnet != "" {
+			if err := devnetRelayBenchOptions(&opts); err != nil {
+				fmt.Println(err)
+				return
+			}
+		} else {
+			app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+			if opts.NodeURL == "" {
+				opts.NodeURL = app.GlobalConfig.PocketConfig.RemoteCLIURL
+				opts.PrivateURL = privateRPCURL(app.GlobalConfig.PocketConfig)
+			}
+			if opts.AuthToken == "" {
+				opts.AuthToken = app.GetAuthTokenFromFile().Value
+			}
+		}
+		if relayBenchAppKey != "" {
+			pk, err := crypto.NewPrivateKey(relayBenchAppKey)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			opts.AppKey = pk
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		signalChannel := make(chan os.Signal, 1)
+		signal.Notify(signalChannel, syscall.SIGTERM, syscall.SIGINT)
+		go func() {
+			<-signalChannel
+			cancel()
+		}()
+		report, err := app.RunRelayBench(ctx, opts)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if relayBenchJSON {
+			bz, _ := json.MarshalIndent(report, "", "    ")
+			fmt.Println(string(bz))
+			return
+		}
+		printRelayBenchReport(report)
+	},
+}
+
+// devnetRelayBenchOptions relays for the first application of the devnet, through its first node
+func devnetRelayBenchOptions(opts *app.RelayBenchOptions) error {
+	devnet, err := app.ReadDevnet(relayBenchDevnet)
+	if err != nil {
+		return err
+	}
+	if len(devnet.Nodes) == 0 || len(devnet.Apps) == 0 || len(devnet.Chains) == 0 {
+		return fmt.Errorf("the devnet in %s has no nodes, applications or chains", relayBenchDevnet)
+	}
+	if opts.NodeURL == "" {
+		opts.NodeURL = devnet.Nodes[0].RPCURL
+	}
+	if opts.Chain == "" {
+		opts.Chain = devnet.Chains[0].ID
+	}
+	if opts.BlockTime == 0 {
+		opts.BlockTime = 5 * time.Second
+	}
+	if relayBenchAppKey == "" {
+		relayBenchAppKey = devnet.Apps[0].PrivateKey
+	}
+	if opts.AuthToken == "" {
+		// the node writes its token once started
+		bz, err := os.ReadFile(filepath.Join(devnet.Nodes[0].DataDir, sdk.ConfigDirName, sdk.AuthFileName))
+		if err == nil {
+			var t sdk.AuthToken
+			if err := json.Unmarshal(bz, &t); err == nil {
+				opts.AuthToken = t.Value
+			}
+		}
+	}
+	return nil
+}
+
+func printRelayBenchReport(r app.RelayBenchReport) {
+	application := r.Application
+	if r.Synthetic {
+		application += " (synthetic)"
+	}
+	fmt.Printf("application: %s\n", application)
+	fmt.Printf("session: chain %s at height %d, servicers %v\n", r.Session.Chain, r.Session.SessionBlockHeight, r.Servicers)
+	fmt.Printf("relays: %d sent, %d succeeded in %s (%.1f relays/s)\n", r.Sent, r.Succeeded, r.Elapsed.Round(time.Millisecond), r.RelaysPerSecond())
+	fmt.Printf("latency: p50 %s, p90 %s, p99 %s, max %s, mean %s\n", r.Latency.P50.Round(time.Microsecond), r.Latency.P90.Round(time.Microsecond),
+		r.Latency.P99.Round(time.Microsecond), r.Latency.Max.Round(time.Microsecond), r.Latency.Mean.Round(time.Microsecond))
+	for _, e := range r.Errors {
+		fmt.Printf("error %s: %d (%s)\n", e.Type, e.Count, e.Example)
+	}
+	if r.Evidence == nil {
+		fmt.Println("evidence: not counted, the private rpc or its auth token is unavailable")
+	}
+	for _, e := range r.Evidence {
+		fmt.Printf("evidence of %s: +%d evidence, +%d sealed, +%d relay proofs, +%d challenge proofs\n", e.Address, e.Evidences, e.Sealed, e.Relays, e.Challenges)
+	}
+}
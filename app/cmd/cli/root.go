@@ -10,6 +10,9 @@ import (
 
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/pocket-core/app/cmd/rpc"
+	"github.com/pokt-network/pocket-core/client"
+	"github.com/pokt-network/pocket-core/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/spf13/cobra"
 )
 
@@ -28,6 +31,8 @@ var (
 	useCache               bool
 	forceSetValidatorsLean bool
 	useLean                bool
+	gateway                bool
+	grpcServer             bool
 )
 
 var CLIVersion = app.AppVersion
@@ -60,6 +65,8 @@ func init() {
 	startCmd.Flags().BoolVar(&testnet, "testnet", false, "run with testnet genesis")
 	startCmd.Flags().BoolVar(&profileApp, "profileApp", false, "expose cpu & memory profiling")
 	startCmd.Flags().BoolVar(&useCache, "useCache", false, "use cache")
+	startCmd.Flags().BoolVar(&gateway, "gateway", false, "relay plain requests on /relay/<chainID> of the gateway port, on behalf of the application in the gateway_key_file")
+	startCmd.Flags().BoolVar(&grpcServer, "grpc", false, "serve the grpc query, broadcast and block subscription services on the grpc port")
 	startCmd.Flags().BoolVar(&forceSetValidatorsLean, "forceSetValidators", false, "reads your lean_pocket_user_key_file (lean_nodes_keys.json) and updates your last signed state/validator files before starting your node")
 	rootCmd.AddCommand(startCmd)
 	rootCmd.AddCommand(resetCmd)
@@ -97,6 +104,17 @@ func start(cmd *cobra.Command, args []string) {
 	}
 	tmNode := app.InitApp(datadir, tmNode, persistentPeers, seeds, remoteCLIURL, keybase, genesisType, useCache, forceSetValidatorsLean)
 	go rpc.StartRPC(app.GlobalConfig.PocketConfig.RPCPort, app.GlobalConfig.PocketConfig.RPCTimeout, simulateRelay, profileApp, allBlockTxs, app.GlobalConfig.PocketConfig.ChainsHotReload)
+	if gateway {
+		g, err := newGateway(app.GlobalConfig)
+		if err != nil {
+			fmt.Println("unable to start the gateway: " + err.Error())
+			os.Exit(1)
+		}
+		go rpc.StartGateway(app.GlobalConfig.PocketConfig.GatewayHost, app.GlobalConfig.PocketConfig.GatewayPort, app.GlobalConfig.PocketConfig.RPCTimeout, g)
+	}
+	if grpcServer {
+		go rpc.StartGRPC(app.GlobalConfig.PocketConfig.GRPCPort, app.GlobalConfig.PocketConfig)
+	}
 	// trap kill signals (2,3,15,9)
 	signalChannel := make(chan os.Signal, 1)
 	signal.Notify(signalChannel,
@@ -120,6 +138,32 @@ func start(cmd *cobra.Command, args []string) {
 	}()
 }
 
+// newGateway relays with the key of the gateway key file, and dispatches through the rpc of this node
+func newGateway(config types.Config) (*rpc.Gateway, error) {
+	clientKey, aat, err := rpc.ReadGatewayKeyFile(config.PocketConfig.GetGatewayKeyFilePath())
+	if err != nil {
+		return nil, err
+	}
+	// the session length of the chain, and its block time: the proposal and the commit timeouts
+	params, err := app.PCA.QueryNodeParams(0)
+	if err != nil {
+		return nil, err
+	}
+	consensus := config.TendermintConfig.Consensus
+	c, err := client.NewClient(client.Config{
+		DispatchURLs:     []string{"http://localhost:" + config.PocketConfig.RPCPort},
+		AAT:              aat,
+		ClientKey:        clientKey,
+		BlocksPerSession: params.SessionBlockFrequency,
+		BlockTime:        consensus.TimeoutPropose + consensus.TimeoutCommit,
+		Timeout:          time.Duration(config.PocketConfig.RPCTimeout) * time.Millisecond,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rpc.NewGateway(c, config.PocketConfig.GatewayMaxRetries, pocketTypes.GlobalServiceMetric()), nil
+}
+
 // resetCmd represents the reset command
 var resetCmd = &cobra.Command{
 	Use:   "reset",
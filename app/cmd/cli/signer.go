@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var (
+	signerListen   string
+	signerKeys     string
+	signerCert     string
+	signerKey      string
+	signerClientCA string
+	signerAuditLog string
+	signerChainID  string
+	signerFeeMulti int64
+)
+
+func init() {
+	signerCmd.Flags().StringVar(&signerListen, "listen", "unix:///tmp/pocket-signer.sock", "the unix://<path> or tcp://<host>:<port> the nodes connect to")
+	signerCmd.Flags().StringVar(&signerKeys, "keys", "", "the file of the servicer keys, in the format of the lean_nodes_keys.json file")
+	signerCmd.Flags().StringVar(&signerCert, "cert", "", "the certificate of the signer")
+	signerCmd.Flags().StringVar(&signerKey, "key", "", "the key of the signer certificate")
+	signerCmd.Flags().StringVar(&signerClientCA, "client-ca", "", "the ca the certificates of the nodes must be signed by")
+	signerCmd.Flags().StringVar(&signerAuditLog, "audit-log", "signer_audit.log", "the file the signed claims and proofs and the refused requests are appended to")
+	signerCmd.Flags().StringVar(&signerChainID, "chain-id", "mainnet", "the chain id of the network, the claims and proofs of any other chain are refused")
+	signerCmd.Flags().Int64Var(&signerFeeMulti, "fee-multiplier", 1, "the fee multiplier of the claims and proofs on the network, any other fee is refused")
+	rootCmd.AddCommand(signerCmd)
+}
+
+var signerCmd = &cobra.Command{
+	Use:   "signer --keys <keys-file> --cert <cert-file> --key <key-file> --client-ca <ca-file>",
+	Short: "Holds the servicer keys and signs for the nodes",
+	Long: `Starts the remote signer of the servicer keys of <keys-file>, so they never live on the relay hosts.
+The nodes set remote_signer_addr and their client certificate in their config, and connect over mutual tls.
+The signer signs relay responses, claims and proofs only: it answers a relay proof once, and signs one claim and one proof
+per session, for the chain id and with the fee of the message times the fee multiplier. Claims, proofs and refused
+requests are appended to the audit log, read back on start.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+		keys, err := app.ReadValidatorPrivateKeyFileLean(signerKeys)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if len(keys) == 0 {
+			fmt.Println("no key found in " + signerKeys)
+			return
+		}
+		tlsConfig, err := app.SignerTLSConfig(signerCert, signerKey, signerClientCA, true)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		s, err := app.NewSignerServer(keys, signerChainID, signerFeeMulti, signerAuditLog, logger)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		lis, err := app.ListenSigner(signerListen, tlsConfig)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		signalChannel := make(chan os.Signal, 1)
+		signal.Notify(signalChannel, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
+		go func() {
+			<-signalChannel
+			_ = lis.Close()
+		}()
+		logger.Info(fmt.Sprintf("signing for %d keys on %s", len(keys), signerListen))
+		err = s.Serve(lis)
+		_ = s.Close()
+		logger.Info("signer stopped: " + err.Error())
+	},
+}
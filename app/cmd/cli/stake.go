@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/pocket-core/types"
@@ -39,6 +38,7 @@ If no changes are desired for the parameter, just enter the current param value
 	Args: cobra.ExactArgs(7),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		fromAddr := args[0]
 		amount, err := strconv.Atoi(args[1])
 		if err != nil {
@@ -69,23 +69,17 @@ If no changes are desired for the parameter, just enter the current param value
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Passphrase: ")
-		res, err := LegacyStakeNode(chains, serviceURI, fromAddr, app.Credentials(pwd), args[4], types.NewInt(int64(amount)), int64(fee), isBefore8)
+		pubKey, err := txPublicKey(cmd, fromAddr, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		j, err := json.Marshal(res)
+		res, err := LegacyStakeNode(chains, serviceURI, fromAddr, pubKey, txPassphrase(generateOnly), args[4], types.NewInt(int64(amount)), int64(fee), isBefore8, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		resp, err := QueryRPC(SendRawTxPath, j)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
 
@@ -101,6 +95,7 @@ The signer may be the operator or the output address.`,
 	Args: cobra.ExactArgs(8),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		generateOnly := generateOnlyFlag(cmd)
 		operatorPubKey := args[0]
 		output := args[1]
 		amount, err := strconv.Atoi(args[2])
@@ -132,22 +127,11 @@ The signer may be the operator or the output address.`,
 			fmt.Println(err)
 			return
 		}
-		fmt.Println("Enter Passphrase: ")
-		res, err := StakeNode(chains, serviceURI, operatorPubKey, output, app.Credentials(pwd), args[5], types.NewInt(int64(amount)), int64(fee), isBefore8)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		j, err := json.Marshal(res)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		resp, err := QueryRPC(SendRawTxPath, j)
+		res, err := StakeNode(chains, serviceURI, operatorPubKey, output, txPassphrase(generateOnly), args[5], types.NewInt(int64(amount)), int64(fee), isBefore8, generateOnly)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		fmt.Println(resp)
+		sendRawTx(res, generateOnly)
 	},
 }
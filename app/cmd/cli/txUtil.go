@@ -20,10 +20,11 @@ import (
 	"github.com/pokt-network/pocket-core/x/auth"
 	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
+	"github.com/spf13/cobra"
 )
 
 // SendTransaction - Deliver Transaction to node
-func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, memo string, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, memo string, legacyCodec bool, generateOnly string) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
@@ -35,7 +36,7 @@ func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.Bi
 	if amount.LTE(sdk.ZeroInt()) {
 		return nil, sdk.ErrInternal("must send above 0")
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase(generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +49,7 @@ func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.Bi
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, memo, legacyCodec)
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, memo, legacyCodec, generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -59,16 +60,12 @@ func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.Bi
 }
 
 // LegacyStakeNode - Deliver Stake message to node
-func LegacyStakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, isBefore8 bool) (*rpc.SendRawTxParams, error) {
+func LegacyStakeNode(chains []string, serviceURL, fromAddr string, pubKey crypto.PublicKey, passphrase, chainID string, amount sdk.BigInt, fees int64, isBefore8 bool, generateOnly string) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
-	if err != nil {
-		return nil, err
-	}
-	kp, err := kb.Get(fa)
+	kb, err := txKeybase(generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -95,14 +92,14 @@ func LegacyStakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID
 	var msg sdk.ProtoMsg
 	if isBefore8 {
 		msg = &nodeTypes.LegacyMsgStake{
-			PublicKey:  kp.PublicKey,
+			PublicKey:  pubKey,
 			Chains:     chains,
 			Value:      amount,
 			ServiceUrl: serviceURL,
 		}
 	} else {
 		msg = &nodeTypes.MsgStake{
-			PublicKey:  kp.PublicKey,
+			PublicKey:  pubKey,
 			Chains:     chains,
 			Value:      amount,
 			ServiceUrl: serviceURL,
@@ -113,7 +110,7 @@ func LegacyStakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false)
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false, generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -124,11 +121,11 @@ func LegacyStakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID
 }
 
 // StakeNode - Deliver Stake message to node
-func StakeNode(chains []string, serviceURL, operatorPubKey, output, passphrase, chainID string, amount sdk.BigInt, fees int64, isBefore8 bool) (*rpc.SendRawTxParams, error) {
+func StakeNode(chains []string, serviceURL, operatorPubKey, output, passphrase, chainID string, amount sdk.BigInt, fees int64, isBefore8 bool, generateOnly string) (*rpc.SendRawTxParams, error) {
 	var operatorPublicKey crypto.PublicKey
 	var operatorAddress sdk.Address
 	var fromAddress sdk.Address
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase(generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -147,13 +144,19 @@ func StakeNode(chains []string, serviceURL, operatorPubKey, output, passphrase,
 	if err != nil {
 		return nil, err
 	}
-	kp, err := kb.Get(outputAddress)
-	if err != nil {
+	if generateOnly != "" {
+		// without a keybase, the output address signs the unsigned transaction
+		fromAddress = outputAddress
+	} else if kp, err := kb.Get(outputAddress); err != nil || kp.IsWatchOnly() {
+		// a watch-only output address can't sign, the operator does
 		operatorAddress = sdk.Address(operatorPublicKey.Address())
 		kp, err = kb.Get(operatorAddress)
 		if err != nil {
 			return nil, errors.New("Neither the Output Address nor the Operator Address is able to be retrieved from the keybase" + err.Error())
 		}
+		if kp.IsWatchOnly() {
+			return nil, errors.New("Neither the Output Address nor the Operator Address is able to sign, both are watch-only in the keybase")
+		}
 		fromAddress = kp.GetAddress()
 	} else {
 		fromAddress = outputAddress
@@ -199,7 +202,7 @@ func StakeNode(chains []string, serviceURL, operatorPubKey, output, passphrase,
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fromAddress, chainID, kb, passphrase, fees, "", false)
+	txBz, err := newTxBz(app.Codec(), msg, fromAddress, chainID, kb, passphrase, fees, "", false, generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -210,7 +213,7 @@ func StakeNode(chains []string, serviceURL, operatorPubKey, output, passphrase,
 }
 
 // UnstakeNode - start unstaking message to node
-func UnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64, isBefore8 bool) (*rpc.SendRawTxParams, error) {
+func UnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64, isBefore8 bool, generateOnly string) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
@@ -230,7 +233,40 @@ func UnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 			Signer:  fa,
 		}
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase(generateOnly)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false, generateOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// PartialUnstakeNode - Release part of a node's stake after the unstaking time
+func PartialUnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, generateOnly string) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	oa, err := sdk.AddressFromHex(operatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	msg := nodeTypes.MsgPartialUnstake{
+		Address: oa,
+		Signer:  fa,
+		Amount:  amount,
+	}
+	kb, err := txKeybase(generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -238,7 +274,7 @@ func UnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false)
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", false, generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -249,7 +285,7 @@ func UnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 }
 
 // UnjailNode - Remove node from jail
-func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64, isBefore8 bool) (*rpc.SendRawTxParams, error) {
+func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64, isBefore8 bool, generateOnly string) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
@@ -269,7 +305,7 @@ func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 			Signer:        fa,
 		}
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase(generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -277,7 +313,7 @@ func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false)
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false, generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -287,16 +323,12 @@ func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 	}, nil
 }
 
-func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+func StakeApp(chains []string, fromAddr string, pubKey crypto.PublicKey, passphrase, chainID string, amount sdk.BigInt, fees int64, legacyCodec bool, generateOnly string) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
-	if err != nil {
-		return nil, err
-	}
-	kp, err := kb.Get(fa)
+	kb, err := txKeybase(generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -311,7 +343,7 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 		return nil, sdk.ErrInternal("must stake above zero")
 	}
 	msg := appsType.MsgStake{
-		PubKey: kp.PublicKey,
+		PubKey: pubKey,
 		Chains: chains,
 		Value:  amount,
 	}
@@ -319,7 +351,7 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec, generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -329,12 +361,12 @@ func StakeApp(chains []string, fromAddr, passphrase, chainID string, amount sdk.
 	}, nil
 }
 
-func UnstakeApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+func UnstakeApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bool, generateOnly string) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase(generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -345,7 +377,35 @@ func UnstakeApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bo
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec, generateOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// PartialUnstakeApp - Release part of an app's stake after the unstaking time
+func PartialUnstakeApp(fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, generateOnly string) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := txKeybase(generateOnly)
+	if err != nil {
+		return nil, err
+	}
+	msg := appsType.MsgPartialUnstake{
+		Address: fa,
+		Amount:  amount,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", false, generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -355,7 +415,7 @@ func UnstakeApp(fromAddr, passphrase, chainID string, fees int64, legacyCodec bo
 	}, nil
 }
 
-func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chainID string, fees int64, legacyCodec bool, generateOnly string) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
@@ -364,7 +424,7 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chain
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase(generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -378,7 +438,7 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chain
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec, generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -388,12 +448,12 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chain
 	}, nil
 }
 
-func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passphrase, chainID string, fees int64, legacyCodec bool, generateOnly string) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase(generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -412,7 +472,7 @@ func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passp
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec, generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -422,12 +482,12 @@ func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passp
 	}, nil
 }
 
-func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID string, fees int64, legacyCodec bool, generateOnly string) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	kb, err := app.GetKeybase()
+	kb, err := txKeybase(generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -439,7 +499,7 @@ func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID stri
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec, generateOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -449,7 +509,100 @@ func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID stri
 	}, nil
 }
 
-func newTxBz(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64, memo string, legacyCodec bool) (transactionBz []byte, err error) {
+func init() {
+	for _, cmd := range []*cobra.Command{sendTxCmd, custodialStakeCmd, nonCustodialstakeCmd, nodeUnstakeCmd, nodePartialUnstakeCmd,
+		nodeUnjailCmd, appStakeCmd, appUnstakeCmd, appPartialUnstakeCmd, govDAOTransfer, govDAOBurn, govChangeParam, govUpgrade,
+		govFeatureEnable} {
+		cmd.Flags().String("generate-only", "", "write the unsigned transaction to this file instead of signing and sending it, to sign it offline with accounts sign-tx")
+	}
+	for _, cmd := range []*cobra.Command{custodialStakeCmd, appStakeCmd} {
+		cmd.Flags().String("pub-key", "", "hex public key of <fromAddr>, required with --generate-only as the keybase isn't read")
+	}
+}
+
+// generateOnlyFlag returns the file the unsigned transaction of the command is written to, empty to sign and send it
+func generateOnlyFlag(cmd *cobra.Command) string {
+	generateOnly, _ := cmd.Flags().GetString("generate-only")
+	return generateOnly
+}
+
+// txPublicKey returns the public key of the --pub-key flag, or else the one of the address in the keybase
+func txPublicKey(cmd *cobra.Command, fromAddr, generateOnly string) (crypto.PublicKey, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	if pubKeyHex, _ := cmd.Flags().GetString("pub-key"); pubKeyHex != "" {
+		pk, err := crypto.NewPublicKey(pubKeyHex)
+		if err != nil {
+			return nil, err
+		}
+		if !sdk.Address(pk.Address()).Equals(fa) {
+			return nil, fmt.Errorf("the public key is not the one of %s", fromAddr)
+		}
+		return pk, nil
+	}
+	if generateOnly != "" {
+		return nil, fmt.Errorf("the public key of %s is required with --generate-only, pass it with --pub-key", fromAddr)
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	kp, err := kb.Get(fa)
+	if err != nil {
+		return nil, err
+	}
+	if kp.PublicKey == nil {
+		return nil, fmt.Errorf("the public key of the watch-only account %s is unknown, pass it with --pub-key", fromAddr)
+	}
+	return kp.PublicKey, nil
+}
+
+// txKeybase returns the keybase that signs the transactions, an unsigned transaction doesn't need one
+func txKeybase(generateOnly string) (keys.Keybase, error) {
+	if generateOnly != "" {
+		return nil, nil
+	}
+	return app.GetKeybase()
+}
+
+// txPassphrase prompts for the passphrase of the signer, unless the transaction is only generated
+func txPassphrase(generateOnly string) string {
+	if generateOnly != "" {
+		return ""
+	}
+	fmt.Println("Enter passphrase: ")
+	return app.Credentials(pwd)
+}
+
+// sendRawTx broadcasts the transaction of a helper, or tells where the unsigned transaction was written
+func sendRawTx(res *rpc.SendRawTxParams, generateOnly string) {
+	if generateOnly != "" {
+		fmt.Println("Unsigned transaction written to " + generateOnly)
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	resp, err := QueryRPC(SendRawTxPath, j)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(resp)
+}
+
+func newTxBz(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64, memo string, legacyCodec bool, generateOnly string) (transactionBz []byte, err error) {
+	if generateOnly != "" {
+		f, err := app.NewTxFile(msg, fromAddr, chainID, fee, memo, legacyCodec)
+		if err != nil {
+			return nil, err
+		}
+		return nil, f.Write(generateOnly)
+	}
 	// fees
 	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee)))
 	// entroyp
@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/app/cmd/rpc"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/state"
@@ -19,9 +22,18 @@ func init() {
 	utilCmd.AddCommand(decodeTxCmd)
 	utilCmd.AddCommand(exportGenesisForReset)
 	utilCmd.AddCommand(convertPocketEvidenceDB)
+	utilCmd.AddCommand(convertEvidenceDBBackendCmd)
 	utilCmd.AddCommand(completionCmd)
 	utilCmd.AddCommand(updateConfigsCmd)
 	utilCmd.AddCommand(printDefaultConfigCmd)
+	utilCmd.AddCommand(reindexTxsCmd)
+	utilCmd.AddCommand(rotateAuthTokenCmd)
+	utilCmd.AddCommand(stateDiffCmd)
+	stateDiffCmd.Flags().StringSliceVar(&stateDiffModules, "module", nil, "the modules compared, among "+strings.Join(app.StateDiffModuleNames(), ", ")+" (default all)")
+	stateDiffCmd.Flags().BoolVar(&stateDiffJSON, "json", false, "print the differences as json")
+	reindexTxsCmd.Flags().Int64Var(&reindexFrom, "from", 0, "the first height to reindex, defaults to resuming after the last checkpoint")
+	reindexTxsCmd.Flags().Int64Var(&reindexTo, "to", 0, "the last height to reindex, defaults to the latest height in the blockstore")
+	reindexTxsCmd.Flags().IntVar(&reindexBatchSize, "batch-size", app.DefaultReindexBatchSize, "the number of transactions written per batch")
 }
 
 var utilCmd = &cobra.Command{
@@ -77,6 +89,21 @@ var printDefaultConfigCmd = &cobra.Command{
 	},
 }
 
+var rotateAuthTokenCmd = &cobra.Command{
+	Use:   "rotate-auth-token",
+	Short: "Rotates the auth token of the private rpc routes",
+	Long:  `Replaces the auth token of the running node without a restart. The new token is written to auth.json and the current one stops working.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		res, err := QuerySecuredRPC(rpc.RotateAuthTokenPath, []byte{}, app.GetAuthTokenFromFile())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var decodeTxCmd = &cobra.Command{
 	Use:   "decode-tx <tx> <legacyCodec>",
 	Short: "Decodes a given transaction encoded in Amino/Proto base64 bytes",
@@ -114,20 +141,11 @@ var exportGenesisForReset = &cobra.Command{
 			fmt.Println("error parsing height: ", err)
 			return
 		}
-		db, err := app.OpenApplicationDB(app.GlobalConfig)
-		if err != nil {
-			fmt.Println("error loading application database: ", err)
-			return
-		}
-		loggerFile, _ := os.Open(os.DevNull)
-		a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false, app.GlobalConfig.PocketConfig.IavlCacheSize)
-		// initialize stores
-		blockStore, _, _, _, err := state.BlocksAndStateFromDB(&app.GlobalConfig.TendermintConfig, state.DefaultDBProvider)
+		a, err := openStoppedApp()
 		if err != nil {
-			fmt.Println("err loading blockstore: ", err.Error())
+			fmt.Println(err)
 			return
 		}
-		a.SetBlockstore(blockStore)
 		chainID := args[1]
 		j, err := a.ExportState(int64(height), chainID)
 		if err != nil {
@@ -138,6 +156,91 @@ var exportGenesisForReset = &cobra.Command{
 	},
 }
 
+// openStoppedApp opens the application and block databases of a stopped node, to read its state at past heights
+func openStoppedApp() (*app.PocketCoreApp, error) {
+	db, err := app.OpenApplicationDB(app.GlobalConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error loading application database: %s", err)
+	}
+	loggerFile, _ := os.Open(os.DevNull)
+	a := app.NewPocketCoreApp(nil, nil, nil, nil, log.NewTMLogger(loggerFile), db, false, app.GlobalConfig.PocketConfig.IavlCacheSize)
+	// initialize stores
+	blockStore, _, _, _, err := state.BlocksAndStateFromDB(&app.GlobalConfig.TendermintConfig, state.DefaultDBProvider)
+	if err != nil {
+		return nil, fmt.Errorf("err loading blockstore: %s", err)
+	}
+	a.SetBlockstore(blockStore)
+	return a, nil
+}
+
+var (
+	stateDiffModules []string
+	stateDiffJSON    bool
+)
+
+var stateDiffCmd = &cobra.Command{
+	Use:   "state-diff <heightA> <heightB> [--module <module>,<module>] [--json]",
+	Short: "Shows the state differences between two heights",
+	Long: `Opens the state of the stopped node at both heights and walks the stores of the modules to print the entries that
+differ, decoded: the accounts, validators and applications by address, the params by name and the claims by servicer
+and session header hash. Changed entries are printed field by field. The other keys of the stores that differ, indexes
+and caches of the entries, are only counted.
+The node must be stopped, and both heights kept by its pruning.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		heights := make([]int64, 2)
+		for i, arg := range args {
+			h, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil || h <= 0 {
+				fmt.Printf("invalid height %s\n", arg)
+				return
+			}
+			heights[i] = h
+		}
+		a, err := openStoppedApp()
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		diff, err := a.StateDiff(heights[0], heights[1], stateDiffModules)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if stateDiffJSON {
+			bz, _ := json.MarshalIndent(diff, "", "    ")
+			fmt.Println(string(bz))
+			return
+		}
+		printStateDiff(diff)
+	},
+}
+
+func printStateDiff(diff app.StateDiff) {
+	fmt.Printf("state differences from height %d to height %d\n", diff.HeightA, diff.HeightB)
+	for _, m := range diff.Modules {
+		fmt.Printf("\n%s: %d changed, %d other keys\n", m.Module, len(m.Changes), m.Other)
+		for _, c := range m.Changes {
+			switch c.Change() {
+			case "added":
+				fmt.Printf("  + %s %s\n", c.Key, genesisValue(c.Right))
+			case "removed":
+				fmt.Printf("  - %s %s\n", c.Key, genesisValue(c.Left))
+			default:
+				if len(c.Fields) == 0 {
+					fmt.Printf("  ~ %s: %s -> %s\n", c.Key, genesisValue(c.Left), genesisValue(c.Right))
+					continue
+				}
+				fmt.Printf("  ~ %s\n", c.Key)
+				for _, f := range c.Fields {
+					fmt.Printf("      %s: %s -> %s\n", f.Path, genesisValue(f.Left), genesisValue(f.Right))
+				}
+			}
+		}
+	}
+}
+
 var convertPocketEvidenceDB = &cobra.Command{
 	Use:   "convert-pocket-evidence-db",
 	Short: "convert pocket evidence db to proto from amino",
@@ -154,6 +257,52 @@ var convertPocketEvidenceDB = &cobra.Command{
 	},
 }
 
+var convertEvidenceDBBackendCmd = &cobra.Command{
+	Use:   "convert-evidence-db-backend <fromBackend> <toBackend>",
+	Short: "convert the pocket evidence db to another storage backend",
+	Long: `Copies the pocket evidence dbs (including the lean pocket and quarantine dbs) from one storage backend to another: goleveldb or logdb.
+The source dbs are left in place. The node must be stopped before running this command, set evidence_db_backend in the config to the new backend afterwards.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		err := types.ConvertEvidenceDB(app.GlobalConfig, args[0], args[1], func(name string, records int) {
+			fmt.Printf("converted %d records of %s\n", records, name)
+		})
+		if err != nil {
+			fmt.Println("ERROR: ", err.Error())
+			return
+		}
+		fmt.Printf("Successfully converted the evidence db to %s, set evidence_db_backend to %s in the config\n", args[1], args[1])
+	},
+}
+
+var (
+	reindexFrom      int64
+	reindexTo        int64
+	reindexBatchSize int
+)
+
+var reindexTxsCmd = &cobra.Command{
+	Use:   "reindex-txs [--from <height>] [--to <height>]",
+	Short: "rebuild the transaction index from the blockstore",
+	Long: `Rebuilds the transaction indexer entries from the blocks in the blockstore and the stored abci responses.
+Progress is checkpointed with every batch: running the command again without --from resumes after the last completed height.
+The node must be stopped before running this command.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		err := app.ReindexTransactions(app.GlobalConfig, reindexFrom, reindexTo, reindexBatchSize, func(height, latestHeight int64, indexed int) {
+			fmt.Printf("reindexed %d txs up to height %d of %d\n", indexed, height, latestHeight)
+		})
+		if err != nil {
+			fmt.Println("ERROR: ", err.Error())
+			fmt.Println("run the command again without --from to resume after the last checkpoint")
+			return
+		}
+		fmt.Println("Successfully reindexed the transactions")
+	},
+}
+
 var (
 	blocks bool
 )
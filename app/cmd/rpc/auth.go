@@ -0,0 +1,143 @@
+package rpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	PrivatePathPrefix   = "/v1/private/"
+	RotateAuthTokenPath = "/v1/private/rotateauthtoken"
+)
+
+// authToken returns the token of a Bearer Authorization header, or else of the legacy authtoken query parameter
+func authToken(r *http.Request) string {
+	if token := bearerToken(r.Header.Get("Authorization")); token != "" {
+		return token
+	}
+	return r.URL.Query().Get("authtoken")
+}
+
+// bearerToken returns the token of a Bearer Authorization header value, empty if it isn't one
+func bearerToken(h string) string {
+	const prefix = "Bearer "
+	if len(h) > len(prefix) && strings.EqualFold(h[:len(prefix)], prefix) {
+		return strings.TrimSpace(h[len(prefix):])
+	}
+	return ""
+}
+
+// authorized answers 401 unless the request carries the current auth token
+func authorized(w http.ResponseWriter, r *http.Request) bool {
+	if app.ValidAuthToken(authToken(r)) {
+		return true
+	}
+	w.Header().Set("WWW-Authenticate", `Bearer realm="pocket"`)
+	WriteErrorResponse(w, http.StatusUnauthorized, "wrong authtoken")
+	return false
+}
+
+// requireClientCert answers 403 to the requests without a client certificate verified against the private client ca
+func requireClientCert(next httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+			WriteErrorResponse(w, http.StatusForbidden, "a verified client certificate is required")
+			return
+		}
+		next(w, r, ps)
+	}
+}
+
+func isPrivateRoute(route Route) bool {
+	return strings.HasPrefix(route.Path, PrivatePathPrefix)
+}
+
+// splitPrivateRoutes separates the private routes, served on their own address, from the public ones
+func splitPrivateRoutes(routes Routes) (public Routes, private Routes) {
+	for _, route := range routes {
+		if isPrivateRoute(route) {
+			private = append(private, route)
+			continue
+		}
+		public = append(public, route)
+	}
+	return
+}
+
+// securePrivateRoutes requires a client certificate on the private routes when mutual tls is configured
+func securePrivateRoutes(routes Routes, config sdk.PocketConfig) Routes {
+	if config.RPCPrivateClientCAFile == "" {
+		return routes
+	}
+	secured := make(Routes, len(routes))
+	for i, route := range routes {
+		if isPrivateRoute(route) {
+			route.HandlerFunc = requireClientCert(route.HandlerFunc)
+		}
+		secured[i] = route
+	}
+	return secured
+}
+
+// rpcTLSConfig is the tls config of an rpc server, nil when the rpc is served over plain http. Servers of private routes
+// request the client certificates of the private client ca, the dedicated private server requires them
+func rpcTLSConfig(config sdk.PocketConfig, clientAuth tls.ClientAuthType) (*tls.Config, error) {
+	if (config.RPCTLSCertFile == "") != (config.RPCTLSKeyFile == "") {
+		return nil, fmt.Errorf("both rpc_tls_cert_file and rpc_tls_key_file must be set")
+	}
+	if config.RPCTLSCertFile == "" {
+		if config.RPCPrivateClientCAFile != "" {
+			return nil, fmt.Errorf("rpc_private_client_ca_file requires rpc_tls_cert_file and rpc_tls_key_file")
+		}
+		return nil, nil
+	}
+	c := &tls.Config{MinVersion: tls.VersionTLS12}
+	if config.RPCPrivateClientCAFile != "" && clientAuth != tls.NoClientCert {
+		bz, err := ioutil.ReadFile(config.RPCPrivateClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the private client ca file: %s", err.Error())
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bz) {
+			return nil, fmt.Errorf("no certificate found in the private client ca file %s", config.RPCPrivateClientCAFile)
+		}
+		c.ClientCAs = pool
+		c.ClientAuth = clientAuth
+	}
+	return c, nil
+}
+
+// serveRPC listens over tls when a certificate is configured
+func serveRPC(srv *http.Server, config sdk.PocketConfig) error {
+	if srv.TLSConfig != nil {
+		return srv.ListenAndServeTLS(config.RPCTLSCertFile, config.RPCTLSKeyFile)
+	}
+	return srv.ListenAndServe()
+}
+
+// RotateAuthToken replaces the auth token and returns the new one, the current token stops working
+func RotateAuthToken(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !authorized(w, r) {
+		return
+	}
+	t, err := app.RotateAuthToken()
+	if err != nil {
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	j, err := json.Marshal(t)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
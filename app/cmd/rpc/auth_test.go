@@ -0,0 +1,162 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setTestAuthToken(t *testing.T, value string) {
+	previous := app.AuthToken
+	app.AuthToken = sdk.AuthToken{Value: value, Issued: time.Now()}
+	t.Cleanup(func() { app.AuthToken = previous })
+}
+
+func TestAuthorized(t *testing.T) {
+	setTestAuthToken(t, "secret")
+	check := func(r *http.Request) int {
+		w := httptest.NewRecorder()
+		if authorized(w, r) {
+			return http.StatusOK
+		}
+		return w.Code
+	}
+	r := httptest.NewRequest("POST", "/v1/private/chains", nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	assert.Equal(t, http.StatusOK, check(r))
+	r = httptest.NewRequest("POST", "/v1/private/chains", nil)
+	r.Header.Set("Authorization", "bearer wrong")
+	assert.Equal(t, http.StatusUnauthorized, check(r))
+	// the query parameter is still accepted
+	assert.Equal(t, http.StatusOK, check(httptest.NewRequest("POST", "/v1/private/chains?authtoken=secret", nil)))
+	assert.Equal(t, http.StatusUnauthorized, check(httptest.NewRequest("POST", "/v1/private/chains", nil)))
+	// no token is set
+	setTestAuthToken(t, "")
+	assert.Equal(t, http.StatusUnauthorized, check(httptest.NewRequest("POST", "/v1/private/chains?authtoken=", nil)))
+}
+
+func TestRotateAuthToken(t *testing.T) {
+	setTestAuthToken(t, "secret")
+	dataDir := t.TempDir()
+	require.Nil(t, os.MkdirAll(filepath.Join(dataDir, sdk.ConfigDirName), 0700))
+	previous := app.GlobalConfig.PocketConfig.DataDir
+	app.GlobalConfig.PocketConfig.DataDir = dataDir
+	defer func() { app.GlobalConfig.PocketConfig.DataDir = previous }()
+
+	router := Router(Routes{Route{Name: "RotateAuthToken", Method: "POST", Path: RotateAuthTokenPath, HandlerFunc: RotateAuthToken}})
+	rotate := func(token string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", RotateAuthTokenPath, nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+	w := rotate("secret")
+	require.Equal(t, http.StatusOK, w.Code)
+	var token sdk.AuthToken
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), &token))
+	assert.NotEqual(t, "secret", token.Value)
+	assert.Equal(t, token.Value, app.GetAuthToken().Value)
+	assert.Equal(t, token.Value, app.GetAuthTokenFromFile().Value)
+	// the previous token stopped working
+	assert.Equal(t, http.StatusUnauthorized, rotate("secret").Code)
+	assert.Equal(t, http.StatusOK, rotate(token.Value).Code)
+}
+
+func TestRequireClientCert(t *testing.T) {
+	routes := securePrivateRoutes(Routes{
+		Route{Name: "AppVersion", Method: "GET", Path: "/v1", HandlerFunc: Version},
+		Route{Name: "QueryChains", Method: "POST", Path: "/v1/private/chains", HandlerFunc: func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {}},
+	}, sdk.PocketConfig{RPCPrivateClientCAFile: "ca.pem"})
+	router := Router(routes)
+	serve := func(method, path string, state *tls.ConnectionState) int {
+		r := httptest.NewRequest(method, path, nil)
+		r.TLS = state
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w.Code
+	}
+	assert.Equal(t, http.StatusOK, serve("GET", "/v1", nil))
+	assert.Equal(t, http.StatusForbidden, serve("POST", "/v1/private/chains", nil))
+	assert.Equal(t, http.StatusForbidden, serve("POST", "/v1/private/chains", &tls.ConnectionState{}))
+	verified := &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}}
+	assert.Equal(t, http.StatusOK, serve("POST", "/v1/private/chains", verified))
+	// no mutual tls
+	assert.Equal(t, routes[1].Path, securePrivateRoutes(routes, sdk.PocketConfig{})[1].Path)
+}
+
+func TestSplitPrivateRoutes(t *testing.T) {
+	public, private := splitPrivateRoutes(GetRoutes())
+	assert.NotEmpty(t, public)
+	for _, r := range public {
+		assert.False(t, isPrivateRoute(r))
+	}
+	paths := make([]string, 0, len(private))
+	for _, r := range private {
+		paths = append(paths, r.Path)
+	}
+	assert.ElementsMatch(t, []string{"/v1/private/stop", "/v1/private/nodes", "/v1/private/evidence", "/v1/private/chains", RotateAuthTokenPath}, paths)
+}
+
+func TestRPCTLSConfig(t *testing.T) {
+	c, err := rpcTLSConfig(sdk.PocketConfig{}, tls.RequireAndVerifyClientCert)
+	assert.Nil(t, err)
+	assert.Nil(t, c)
+	_, err = rpcTLSConfig(sdk.PocketConfig{RPCTLSCertFile: "cert.pem"}, tls.NoClientCert)
+	assert.NotNil(t, err)
+	_, err = rpcTLSConfig(sdk.PocketConfig{RPCPrivateClientCAFile: "ca.pem"}, tls.RequireAndVerifyClientCert)
+	assert.NotNil(t, err)
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	require.Nil(t, ioutil.WriteFile(caFile, newTestCertificatePEM(t), 0600))
+	config := sdk.PocketConfig{RPCTLSCertFile: "cert.pem", RPCTLSKeyFile: "key.pem", RPCPrivateClientCAFile: caFile}
+	c, err = rpcTLSConfig(config, tls.RequireAndVerifyClientCert)
+	require.Nil(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, c.ClientAuth)
+	assert.NotNil(t, c.ClientCAs)
+	// the public server of split private routes doesn't ask for client certificates
+	c, err = rpcTLSConfig(config, tls.NoClientCert)
+	require.Nil(t, err)
+	assert.Nil(t, c.ClientCAs)
+	// not a certificate
+	require.Nil(t, ioutil.WriteFile(caFile, []byte("foo"), 0600))
+	_, err = rpcTLSConfig(config, tls.VerifyClientCertIfGiven)
+	assert.NotNil(t, err)
+}
+
+func newTestCertificatePEM(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "pocket test ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
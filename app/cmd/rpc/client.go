@@ -89,8 +89,7 @@ func Relay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 
 // UpdateChains
 func UpdateChains(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	value := r.URL.Query().Get("authtoken")
-	if value == app.AuthToken.Value {
+	if authorized(w, r) {
 		var hostedChainsSlice []types.HostedBlockchain
 		if err := PopModel(w, r, ps, &hostedChainsSlice); err != nil {
 			WriteErrorResponse(w, 400, err.Error())
@@ -108,22 +107,28 @@ func UpdateChains(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 		if err != nil {
 			WriteErrorResponse(w, 400, err.Error())
 		} else {
-			j, er := json.Marshal(result)
+			j, er := json.Marshal(redactedChains(result))
 			if er != nil {
 				WriteErrorResponse(w, 400, er.Error())
 				return
 			}
 			WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 		}
-	} else {
-		WriteErrorResponse(w, 401, "wrong authtoken "+value)
 	}
 }
 
+// redactedChains returns the hosted chains to show, without the values of the static headers of their relay policy
+func redactedChains(chains map[string]types.HostedBlockchain) map[string]types.HostedBlockchain {
+	redacted := make(map[string]types.HostedBlockchain, len(chains))
+	for id, chain := range chains {
+		redacted[id] = chain.Redacted()
+	}
+	return redacted
+}
+
 // Stop
 func Stop(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	value := r.URL.Query().Get("authtoken")
-	if value == app.AuthToken.Value {
+	if authorized(w, r) {
 		app.ShutdownPocketCore()
 		err := app.PCA.TMNode().Stop()
 		if err != nil {
@@ -134,8 +139,6 @@ func Stop(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		}
 		fmt.Println("Stop Successful, PID:" + fmt.Sprint(os.Getpid()))
 		os.Exit(0)
-	} else {
-		WriteErrorResponse(w, 401, "wrong authtoken "+value)
 	}
 }
 
@@ -0,0 +1,270 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/client"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/keeper"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+const (
+	GatewayRelayPath = "/relay/:chain"
+	// the weight of the newest sample in the latency moving average of a node
+	gatewayLatencyWeight = 0.3
+	// how long a failing node is avoided, multiplied by its consecutive failures
+	gatewayFailureCooldown = 10 * time.Second
+	gatewayMaxCooldown     = 5 * time.Minute
+	// the max size of a relayed request body
+	gatewayMaxBodyBytes = 1048576
+)
+
+// GatewayKeyFile is the key the gateway relays with: either an application private key, that issues its own AAT,
+// or a client private key along with the AAT an application delegated to it
+type GatewayKeyFile struct {
+	PrivateKey string           `json:"priv_key"`
+	AAT        *pocketTypes.AAT `json:"aat,omitempty"`
+}
+
+// ReadGatewayKeyFile reads the gateway key file and returns the client key and the AAT to relay with
+func ReadGatewayKeyFile(filePath string) (crypto.PrivateKey, pocketTypes.AAT, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, pocketTypes.AAT{}, fmt.Errorf("an error occurred attempting to read the gateway key file: %s", err.Error())
+	}
+	var kf GatewayKeyFile
+	if err := json.Unmarshal(data, &kf); err != nil {
+		return nil, pocketTypes.AAT{}, fmt.Errorf("an error occurred unmarshalling the gateway key file: %s", err.Error())
+	}
+	pk, err := crypto.NewPrivateKey(kf.PrivateKey)
+	if err != nil {
+		return nil, pocketTypes.AAT{}, err
+	}
+	if kf.AAT != nil {
+		return pk, *kf.AAT, nil
+	}
+	// the application key issues a token for itself
+	aat, sdkErr := keeper.AATGeneration(pk.PublicKey().RawString(), pk.PublicKey().RawString(), pk)
+	if sdkErr != nil {
+		return nil, pocketTypes.AAT{}, sdkErr
+	}
+	return pk, aat, nil
+}
+
+// Gateway relays plain requests on behalf of a staked application: it signs them with its AAT and sends them to the
+// session nodes, failing over to the fastest healthy node when one errors
+type Gateway struct {
+	client     *client.Client
+	maxRetries int
+	metrics    *pocketTypes.ServiceMetrics
+	mu         sync.Mutex
+	nodes      map[string]*gatewayNodeStats // by node address
+}
+
+// gatewayNodeStats is the latency and health of a session node, as seen by the gateway
+type gatewayNodeStats struct {
+	Latency     time.Duration // moving average of the successful relays
+	Relays      int64
+	Failures    int64 // consecutive failures, reset by a successful relay
+	LastFailure time.Time
+}
+
+// cooledDown returns true if the node may be picked again after its last failures
+func (s *gatewayNodeStats) cooledDown(now time.Time) bool {
+	if s.Failures == 0 {
+		return true
+	}
+	cooldown := gatewayFailureCooldown * time.Duration(s.Failures)
+	if cooldown > gatewayMaxCooldown {
+		cooldown = gatewayMaxCooldown
+	}
+	return now.Sub(s.LastFailure) >= cooldown
+}
+
+// NewGateway returns a gateway relaying through the client, metrics may be nil
+func NewGateway(c *client.Client, maxRetries int, metrics *pocketTypes.ServiceMetrics) *Gateway {
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+	return &Gateway{
+		client:     c,
+		maxRetries: maxRetries,
+		metrics:    metrics,
+		nodes:      make(map[string]*gatewayNodeStats),
+	}
+}
+
+// pickNode returns the session node to relay to: the fastest healthy node not tried yet, nodes without samples first
+// so every node gets measured. If every untried node is failing, the one with the fewest failures is picked
+func (g *Gateway) pickNode(nodes []nodesTypes.Validator, tried map[string]bool) (nodesTypes.Validator, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := time.Now()
+	best, fallback := -1, -1
+	var bestStats, fallbackStats gatewayNodeStats
+	for i, n := range nodes {
+		if tried[n.Address.String()] {
+			continue
+		}
+		var stats gatewayNodeStats
+		if s, ok := g.nodes[n.Address.String()]; ok {
+			stats = *s
+		}
+		if !stats.cooledDown(now) {
+			if fallback == -1 || stats.Failures < fallbackStats.Failures {
+				fallback, fallbackStats = i, stats
+			}
+			continue
+		}
+		if best == -1 || stats.Latency < bestStats.Latency {
+			best, bestStats = i, stats
+		}
+	}
+	if best == -1 {
+		best = fallback
+	}
+	if best == -1 {
+		return nodesTypes.Validator{}, false
+	}
+	return nodes[best], true
+}
+
+// recordRelay updates the stats of the node after a relay
+func (g *Gateway) recordRelay(chain string, node sdk.Address, elapsed time.Duration, err error) {
+	g.mu.Lock()
+	stats, ok := g.nodes[node.String()]
+	if !ok {
+		stats = &gatewayNodeStats{}
+		g.nodes[node.String()] = stats
+	}
+	if err != nil {
+		stats.Failures++
+		stats.LastFailure = time.Now()
+	} else {
+		if stats.Relays == 0 {
+			stats.Latency = elapsed
+		} else {
+			stats.Latency = time.Duration(gatewayLatencyWeight*float64(elapsed) + (1-gatewayLatencyWeight)*float64(stats.Latency))
+		}
+		stats.Relays++
+		stats.Failures = 0
+	}
+	g.mu.Unlock()
+	if g.metrics == nil {
+		return
+	}
+	if err != nil {
+		g.metrics.AddGatewayErrorFor(chain, &node)
+		return
+	}
+	g.metrics.AddGatewayRelayFor(chain, float64(elapsed.Milliseconds()), &node)
+}
+
+// Relay sends the payload to a node of the chain session. A failing node is replaced by the next best node of the
+// session, and a session rejected by its nodes is dispatched again, up to the configured number of retries
+func (g *Gateway) Relay(ctx context.Context, chain string, payload pocketTypes.Payload) (*pocketTypes.RelayResponse, error) {
+	session, err := g.client.Session(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+	tried := make(map[string]bool)
+	var lastErr error
+	for attempt := 0; attempt <= g.maxRetries; attempt++ {
+		node, ok := g.pickNode(session.Nodes, tried)
+		if !ok {
+			break
+		}
+		tried[node.Address.String()] = true
+		relay, err := g.client.NewRelay(session, node, payload)
+		if err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		resp, err := g.client.SendRelay(ctx, node, relay)
+		if err == nil {
+			g.recordRelay(chain, node.Address, time.Since(start), nil)
+			return resp, nil
+		}
+		lastErr = err
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		var rErr *client.RelayError
+		if errors.As(err, &rErr) && rErr.WarrantsDispatch() {
+			// the session is over, not the node
+			if session, err = g.client.Redispatch(ctx, chain, rErr); err != nil {
+				return nil, err
+			}
+			tried = make(map[string]bool)
+			continue
+		}
+		g.recordRelay(chain, node.Address, time.Since(start), err)
+	}
+	if lastErr == nil {
+		lastErr = client.ErrEmptySession
+	}
+	return nil, lastErr
+}
+
+// ServeRelay relays the plain request body to the chain of the path and writes back the chain response. The relays
+// are paid by the application of the gateway, so the requests must carry the auth token of the node
+func (g *Gateway) ServeRelay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if !authorized(w, r) {
+		return
+	}
+	// a byte more to tell a body too large, a truncated body would be relayed as broken json
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, gatewayMaxBodyBytes+1))
+	if err != nil {
+		WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(body) > gatewayMaxBodyBytes {
+		WriteErrorResponse(w, http.StatusRequestEntityTooLarge, "the request body is too large")
+		return
+	}
+	payload := pocketTypes.Payload{Data: string(body), Method: r.Method}
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		payload.Headers = map[string]string{"Content-Type": contentType}
+	}
+	resp, err := g.Relay(r.Context(), ps.ByName("chain"), payload)
+	if err != nil {
+		WriteErrorResponse(w, http.StatusBadGateway, err.Error())
+		return
+	}
+	WriteRaw(w, resp.Response, r.URL.Path, r.RemoteAddr)
+}
+
+// Router returns the routes of the gateway
+func (g *Gateway) Router() *httprouter.Router {
+	return Router(Routes{{Name: "GatewayRelay", Method: "POST", Path: GatewayRelayPath, HandlerFunc: g.ServeRelay}})
+}
+
+// StartGateway serves the gateway relay endpoint on the host and port, the host defaults to localhost
+func StartGateway(host, port string, timeout int64, g *Gateway) {
+	if host == "" {
+		host = sdk.DefaultGatewayHost
+	}
+	srv := &http.Server{
+		ReadTimeout:       30 * time.Second,
+		ReadHeaderTimeout: 20 * time.Second,
+		WriteTimeout:      60 * time.Second,
+		Addr:              net.JoinHostPort(host, port),
+		Handler:           http.TimeoutHandler(g.Router(), time.Duration(timeout)*time.Millisecond, "Server Timeout Handling Request"),
+	}
+	log.Fatal(srv.ListenAndServe())
+}
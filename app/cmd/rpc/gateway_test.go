@@ -0,0 +1,147 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/client"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newGatewayTestNode is a session node that signs its relay responses, or fails them when failing is set
+func newGatewayTestNode(t *testing.T, response string, failing *bool) (nodesTypes.Validator, *int) {
+	key := crypto.Ed25519PrivateKey{}.GenPrivateKey()
+	relays := new(int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*relays++
+		if failing != nil && *failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var relay pocketTypes.Relay
+		if err := json.NewDecoder(r.Body).Decode(&relay); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		sig, _ := key.Sign(pocketTypes.RelayResponse{Response: response, Proof: relay.Proof}.Hash())
+		_ = json.NewEncoder(w).Encode(map[string]string{"signature": hex.EncodeToString(sig), "response": response})
+	}))
+	t.Cleanup(srv.Close)
+	return nodesTypes.Validator{
+		Address:    sdk.Address(key.PublicKey().Address()),
+		PublicKey:  key.PublicKey(),
+		Status:     sdk.Staked,
+		Chains:     []string{"0001"},
+		ServiceURL: srv.URL,
+	}, relays
+}
+
+func newTestGateway(t *testing.T, nodes ...nodesTypes.Validator) *Gateway {
+	appKey := crypto.Ed25519PrivateKey{}.GenPrivateKey()
+	dispatcher := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := pocketTypes.DispatchResponse{BlockHeight: 1}
+		res.Session.SessionHeader = pocketTypes.SessionHeader{ApplicationPubKey: appKey.PublicKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+		for _, n := range nodes {
+			res.Session.SessionNodes = append(res.Session.SessionNodes, n)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(dispatcher.Close)
+	kf, err := json.Marshal(GatewayKeyFile{PrivateKey: appKey.RawString()})
+	require.Nil(t, err)
+	keyFile := filepath.Join(t.TempDir(), "gateway_key.json")
+	require.Nil(t, os.WriteFile(keyFile, kf, 0600))
+	clientKey, aat, err := ReadGatewayKeyFile(keyFile)
+	require.Nil(t, err)
+	c, err := client.NewClient(client.Config{DispatchURLs: []string{dispatcher.URL}, AAT: aat, ClientKey: clientKey})
+	require.Nil(t, err)
+	return NewGateway(c, 2, nil)
+}
+
+func TestReadGatewayKeyFile(t *testing.T) {
+	appKey := crypto.Ed25519PrivateKey{}.GenPrivateKey()
+	keyFile := filepath.Join(t.TempDir(), "gateway_key.json")
+	require.Nil(t, os.WriteFile(keyFile, []byte(`{"priv_key":"`+appKey.RawString()+`"}`), 0600))
+	clientKey, aat, err := ReadGatewayKeyFile(keyFile)
+	require.Nil(t, err)
+	assert.Nil(t, aat.Validate())
+	assert.Equal(t, appKey.PublicKey().RawString(), aat.ApplicationPublicKey)
+	assert.Equal(t, clientKey.PublicKey().RawString(), aat.ClientPublicKey)
+	_, _, err = ReadGatewayKeyFile(filepath.Join(t.TempDir(), "missing.json"))
+	assert.NotNil(t, err)
+}
+
+func TestGateway_Failover(t *testing.T) {
+	failing := true
+	bad, badRelays := newGatewayTestNode(t, `{"result":"0x1"}`, &failing)
+	good, goodRelays := newGatewayTestNode(t, `{"result":"0x1"}`, nil)
+	g := newTestGateway(t, bad, good)
+	setTestAuthToken(t, "secret")
+	relayRequest := func(body string) *http.Request {
+		r := httptest.NewRequest("POST", "/relay/0001", strings.NewReader(body))
+		r.Header.Set("Authorization", "Bearer secret")
+		return r
+	}
+	// the first pick is the bad node, unmeasured nodes are tried in order
+	router := g.Router()
+	for i := 0; i < 3; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, relayRequest(`{"method":"eth_blockNumber"}`))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"result":"0x1"}`, w.Body.String())
+	}
+	// the failing node is avoided after its failure
+	assert.Equal(t, 1, *badRelays)
+	assert.Equal(t, 3, *goodRelays)
+	// every node failing is a bad gateway
+	g2 := newTestGateway(t, bad)
+	w := httptest.NewRecorder()
+	g2.Router().ServeHTTP(w, relayRequest(`{}`))
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+	// the relays are paid by the application, only the node operator may send them
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/relay/0001", strings.NewReader(`{}`)))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	// a body too large is rejected instead of being relayed truncated
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, relayRequest(strings.Repeat("a", gatewayMaxBodyBytes+1)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(t, 3, *goodRelays)
+}
+
+func TestGateway_PickNode(t *testing.T) {
+	g := NewGateway(nil, 1, nil)
+	var nodes []nodesTypes.Validator
+	for i := 0; i < 3; i++ {
+		nodes = append(nodes, nodesTypes.Validator{Address: sdk.Address(crypto.Ed25519PrivateKey{}.GenPrivateKey().PublicKey().Address())})
+	}
+	g.recordRelay("0001", nodes[0].Address, 300*time.Millisecond, nil)
+	g.recordRelay("0001", nodes[1].Address, 100*time.Millisecond, nil)
+	g.recordRelay("0001", nodes[2].Address, 200*time.Millisecond, nil)
+	node, ok := g.pickNode(nodes, map[string]bool{})
+	require.True(t, ok)
+	assert.Equal(t, nodes[1].Address, node.Address)
+	// the fastest node failing hands over to the next fastest
+	g.recordRelay("0001", nodes[1].Address, 0, assert.AnError)
+	node, _ = g.pickNode(nodes, map[string]bool{})
+	assert.Equal(t, nodes[2].Address, node.Address)
+	node, _ = g.pickNode(nodes, map[string]bool{nodes[2].Address.String(): true, nodes[0].Address.String(): true})
+	assert.Equal(t, nodes[1].Address, node.Address)
+	_, ok = g.pickNode(nodes, map[string]bool{nodes[0].Address.String(): true, nodes[1].Address.String(): true, nodes[2].Address.String(): true})
+	assert.False(t, ok)
+	// the moving average follows the new samples
+	g.recordRelay("0001", nodes[0].Address, 0, nil)
+	assert.Equal(t, 210*time.Millisecond, g.nodes[nodes[0].Address.String()].Latency)
+}
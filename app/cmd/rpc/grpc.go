@@ -0,0 +1,248 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/pokt-network/pocket-core/app"
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// gogoCodec encodes the grpc messages with their gogo generated marshalers, the golang protobuf codec of grpc
+// doesn't know the gogo types
+type gogoCodec struct{}
+
+func (gogoCodec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(gogoproto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot marshal %T, not a protobuf message", v)
+	}
+	return gogoproto.Marshal(m)
+}
+
+func (gogoCodec) Unmarshal(data []byte, v interface{}) error {
+	m, ok := v.(gogoproto.Message)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %T, not a protobuf message", v)
+	}
+	return gogoproto.Unmarshal(data, m)
+}
+
+func (gogoCodec) Name() string {
+	return "proto"
+}
+
+// GRPCCodecOption is the dial option go clients need to call the pocket grpc services
+func GRPCCodecOption() grpc.DialOption {
+	return grpc.WithDefaultCallOptions(grpc.ForceCodec(gogoCodec{}))
+}
+
+// bearerCredentials sends the auth token of the node as a Bearer authorization with every call
+type bearerCredentials string
+
+func (c bearerCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(c)}, nil
+}
+
+func (c bearerCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// GRPCAuthOption is the dial option go clients need to send the auth token of the node with every call
+func GRPCAuthOption(token string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(bearerCredentials(token))
+}
+
+// NewGRPCServer returns a grpc server with the query services of auth, nodes, apps, gov and pocketcore, and the
+// service of the height, transaction broadcast and block subscription. The calls go through the rate limits and
+// concurrency caps of the rpc config, keyed by the full method name, and must carry the auth token of the node
+func NewGRPCServer(config sdk.PocketConfig, opts ...grpc.ServerOption) *grpc.Server {
+	l := NewLimiter(config, nil)
+	serverOpts := []grpc.ServerOption{
+		grpc.ForceServerCodec(gogoCodec{}),
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			release, err := l.admitGRPC(ctx, info.FullMethod)
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			release, err := l.admitGRPC(ss.Context(), info.FullMethod)
+			if err != nil {
+				return err
+			}
+			defer release()
+			return handler(srv, ss)
+		}),
+	}
+	if config.RPCMaxBodyBytes > 0 {
+		serverOpts = append(serverOpts, grpc.MaxRecvMsgSize(int(config.RPCMaxBodyBytes)))
+	}
+	srv := grpc.NewServer(append(serverOpts, opts...)...)
+	sdk.RegisterServiceServer(srv, &serviceServer{})
+	authTypes.RegisterQueryServer(srv, &authQueryServer{})
+	nodesTypes.RegisterQueryServer(srv, &nodesQueryServer{})
+	appsTypes.RegisterQueryServer(srv, &appsQueryServer{})
+	govTypes.RegisterQueryServer(srv, &govQueryServer{})
+	pocketTypes.RegisterQueryServer(srv, &pocketQueryServer{})
+	// the methods are the routes of the rejected requests metric
+	for service, info := range srv.GetServiceInfo() {
+		for _, method := range info.Methods {
+			l.routes["/"+service+"/"+method.Name] = struct{}{}
+		}
+	}
+	return srv
+}
+
+// admitGRPC applies the ip rate limit, the concurrency cap of the method and the auth token to a grpc call, release
+// frees the concurrency cap once the call is served
+func (l *Limiter) admitGRPC(ctx context.Context, method string) (release func(), err error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if l.ip != nil {
+		remoteAddr := ""
+		if p, ok := peer.FromContext(ctx); ok {
+			remoteAddr = p.Addr.String()
+		}
+		if ok, retry := l.ip.allow(l.forwardedClientIP(md.Get("x-forwarded-for"), remoteAddr), time.Now()); !ok {
+			l.countRejected(method, limitReasonIP)
+			return nil, status.Errorf(codes.ResourceExhausted, "too many requests from this ip, retry in %s", retry.Round(time.Millisecond))
+		}
+	}
+	release = func() {}
+	if sem := l.concurrencyCap(method); sem != nil {
+		select {
+		case sem <- struct{}{}:
+			release = func() { <-sem }
+		default:
+			l.countRejected(method, limitReasonConcurrency)
+			return nil, status.Error(codes.ResourceExhausted, "too many requests in flight for this method, retry later")
+		}
+	}
+	token := ""
+	if values := md.Get("authorization"); len(values) != 0 {
+		token = bearerToken(values[0])
+	}
+	if !app.ValidAuthToken(token) {
+		release()
+		return nil, status.Error(codes.Unauthenticated, "wrong authtoken")
+	}
+	return release, nil
+}
+
+// StartGRPC serves the grpc services on the port, over tls when the rpc has a certificate
+func StartGRPC(port string, config sdk.PocketConfig) {
+	var opts []grpc.ServerOption
+	tlsConfig, err := rpcTLSConfig(config, tls.NoClientCert)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if tlsConfig != nil {
+		cert, err := tls.LoadX509KeyPair(config.RPCTLSCertFile, config.RPCTLSKeyFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Fatal(NewGRPCServer(config, opts...).Serve(lis))
+}
+
+// grpcHeight is the queried height, zero for the latest
+func grpcHeight(height int64) int64 {
+	if height == 0 {
+		return app.PCA.BaseApp.LastBlockHeight()
+	}
+	return height
+}
+
+// grpcError maps the query errors to the invalid argument status, as the rest routes answer 400
+func grpcError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+func toProtoParams(params []app.SingleParamReturn) []sdk.Param {
+	res := make([]sdk.Param, len(params))
+	for i, p := range params {
+		res[i] = sdk.Param{Key: p.Key, Value: p.Value}
+	}
+	return res
+}
+
+type serviceServer struct {
+	sdk.UnimplementedServiceServer
+}
+
+func (s *serviceServer) Height(context.Context, *sdk.HeightRequest) (*sdk.HeightResponse, error) {
+	height, err := app.PCA.QueryHeight()
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &sdk.HeightResponse{Height: height}, nil
+}
+
+func (s *serviceServer) BroadcastTx(_ context.Context, req *sdk.BroadcastTxRequest) (*sdk.TxResponse, error) {
+	res, err := app.PCA.SendRawTx(req.Address, req.Tx)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &res, nil
+}
+
+// SubscribeBlocks streams the blocks from the requested height, zero for the next block, until the client goes away
+func (s *serviceServer) SubscribeBlocks(req *sdk.SubscribeBlocksRequest, stream sdk.Service_SubscribeBlocksServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	events := make(chan app.StreamEvent)
+	streamErr := make(chan error, 1)
+	go func() {
+		streamErr <- app.PCA.StreamEvents(ctx, app.EventFilter{Types: []string{app.StreamEventBlock}}, req.FromHeight, events)
+	}()
+	for {
+		select {
+		case e := <-events:
+			if e.Block == nil {
+				continue
+			}
+			err := stream.Send(&sdk.BlockEvent{
+				Height:          e.Height,
+				Hash:            e.Block.Hash,
+				Time:            e.Block.Time,
+				ProposerAddress: e.Block.ProposerAddress,
+				NumTxs:          int64(e.Block.NumTxs),
+			})
+			if err != nil {
+				return err
+			}
+		case err := <-streamErr:
+			if err != nil {
+				return grpcError(err)
+			}
+			return nil
+		}
+	}
+}
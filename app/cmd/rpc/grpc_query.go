@@ -0,0 +1,299 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/pokt-network/pocket-core/app"
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// the grpc query services mirror the /v1/query routes, a zero height queries the latest block
+
+type authQueryServer struct {
+	authTypes.UnimplementedQueryServer
+}
+
+func toProtoAccount(acc exported.Account) authTypes.ProtoBaseAccount {
+	p := authTypes.ProtoBaseAccount{Address: acc.GetAddress(), Coins: acc.GetCoins()}
+	if pk := acc.GetPubKey(); pk != nil {
+		p.PubKey = pk.RawBytes()
+	}
+	return p
+}
+
+func (s *authQueryServer) Account(_ context.Context, req *authTypes.QueryAccountRequest) (*authTypes.QueryAccountResponse, error) {
+	acc, err := app.PCA.QueryAccount(req.Address, grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if acc == nil || *acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account not found for %s", req.Address)
+	}
+	return &authTypes.QueryAccountResponse{Account: toProtoAccount(*acc)}, nil
+}
+
+func (s *authQueryServer) Accounts(_ context.Context, req *authTypes.QueryAccountsRequest) (*authTypes.QueryAccountsResponse, error) {
+	page, err := app.PCA.QueryAccounts(grpcHeight(req.Height), int(req.Page), int(req.PerPage))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &authTypes.QueryAccountsResponse{Page: int64(page.Page), TotalPages: int64(page.Total)}
+	if accs, ok := page.Result.([]exported.Account); ok {
+		res.Accounts = make([]authTypes.ProtoBaseAccount, len(accs))
+		for i, acc := range accs {
+			res.Accounts[i] = toProtoAccount(acc)
+		}
+	}
+	return res, nil
+}
+
+func (s *authQueryServer) Balance(_ context.Context, req *authTypes.QueryBalanceRequest) (*authTypes.QueryBalanceResponse, error) {
+	balance, err := app.PCA.QueryBalance(req.Address, grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &authTypes.QueryBalanceResponse{Balance: balance.String()}, nil
+}
+
+func (s *authQueryServer) Supply(_ context.Context, req *authTypes.QuerySupplyRequest) (*authTypes.QuerySupplyResponse, error) {
+	height := grpcHeight(req.Height)
+	nodesStake, total, err := app.PCA.QueryTotalNodeCoins(height)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	appsStaked, err := app.PCA.QueryTotalAppCoins(height)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	dao, err := app.PCA.QueryDaoBalance(height)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	totalStaked := nodesStake.Add(appsStaked).Add(dao)
+	return &authTypes.QuerySupplyResponse{
+		NodeStaked:    nodesStake.String(),
+		AppStaked:     appsStaked.String(),
+		Dao:           dao.String(),
+		TotalStaked:   totalStaked.String(),
+		TotalUnstaked: total.Sub(totalStaked).String(),
+		Total:         total.String(),
+	}, nil
+}
+
+func (s *authQueryServer) Params(_ context.Context, req *authTypes.QueryParamsRequest) (*authTypes.QueryParamsResponse, error) {
+	params, err := app.PCA.QueryAllParams(grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &authTypes.QueryParamsResponse{Params: toProtoParams(params.AuthParams)}, nil
+}
+
+type nodesQueryServer struct {
+	nodesTypes.UnimplementedQueryServer
+}
+
+func (s *nodesQueryServer) Node(_ context.Context, req *nodesTypes.QueryNodeRequest) (*nodesTypes.QueryNodeResponse, error) {
+	node, err := app.PCA.QueryNode(req.Address, grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &nodesTypes.QueryNodeResponse{Node: node.ToProto()}, nil
+}
+
+func (s *nodesQueryServer) Nodes(_ context.Context, req *nodesTypes.QueryNodesRequest) (*nodesTypes.QueryNodesResponse, error) {
+	page, err := app.PCA.QueryNodes(grpcHeight(req.Height), nodesTypes.QueryValidatorsParams{
+		StakingStatus: sdk.StakeStatus(req.StakingStatus),
+		JailedStatus:  int(req.JailedStatus),
+		Blockchain:    req.Blockchain,
+		Page:          int(req.Page),
+		Limit:         int(req.PerPage),
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &nodesTypes.QueryNodesResponse{Page: int64(page.Page), TotalPages: int64(page.Total)}
+	if nodes, ok := page.Result.([]nodesTypes.Validator); ok {
+		res.Nodes = make([]nodesTypes.ProtoValidator, len(nodes))
+		for i, node := range nodes {
+			res.Nodes[i] = node.ToProto()
+		}
+	}
+	return res, nil
+}
+
+func (s *nodesQueryServer) NodeUnbonding(_ context.Context, req *nodesTypes.QueryNodeRequest) (*nodesTypes.UnbondingEntries, error) {
+	entries, err := app.PCA.QueryNodeUnbonding(req.Address, grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &nodesTypes.UnbondingEntries{Entries: entries}, nil
+}
+
+func (s *nodesQueryServer) SigningInfos(_ context.Context, req *nodesTypes.QuerySigningInfosRequest) (*nodesTypes.QuerySigningInfosResponse, error) {
+	page, err := app.PCA.QuerySigningInfos(req.Address, grpcHeight(req.Height), int(req.Page), int(req.PerPage))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &nodesTypes.QuerySigningInfosResponse{Page: int64(page.Page), TotalPages: int64(page.Total)}
+	if infos, ok := page.Result.([]nodesTypes.ValidatorSigningInfo); ok {
+		res.SigningInfos = infos
+	}
+	return res, nil
+}
+
+func (s *nodesQueryServer) Params(_ context.Context, req *nodesTypes.QueryParamsRequest) (*nodesTypes.QueryParamsResponse, error) {
+	params, err := app.PCA.QueryAllParams(grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &nodesTypes.QueryParamsResponse{Params: toProtoParams(params.NodeParams)}, nil
+}
+
+type appsQueryServer struct {
+	appsTypes.UnimplementedQueryServer
+}
+
+func (s *appsQueryServer) App(_ context.Context, req *appsTypes.QueryAppRequest) (*appsTypes.QueryAppResponse, error) {
+	application, err := app.PCA.QueryApp(req.Address, grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &appsTypes.QueryAppResponse{App: application.ToProto()}, nil
+}
+
+func (s *appsQueryServer) Apps(_ context.Context, req *appsTypes.QueryAppsRequest) (*appsTypes.QueryAppsResponse, error) {
+	page, err := app.PCA.QueryApps(grpcHeight(req.Height), appsTypes.QueryApplicationsWithOpts{
+		Page:          int(req.Page),
+		Limit:         int(req.PerPage),
+		StakingStatus: sdk.StakeStatus(req.StakingStatus),
+		Blockchain:    req.Blockchain,
+	})
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &appsTypes.QueryAppsResponse{Page: int64(page.Page), TotalPages: int64(page.Total)}
+	if apps, ok := page.Result.(appsTypes.Applications); ok {
+		res.Apps = make([]appsTypes.ProtoApplication, len(apps))
+		for i, a := range apps {
+			res.Apps[i] = a.ToProto()
+		}
+	}
+	return res, nil
+}
+
+func (s *appsQueryServer) AppUnbonding(_ context.Context, req *appsTypes.QueryAppRequest) (*appsTypes.UnbondingEntries, error) {
+	entries, err := app.PCA.QueryAppUnbonding(req.Address, grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &appsTypes.UnbondingEntries{Entries: entries}, nil
+}
+
+func (s *appsQueryServer) Params(_ context.Context, req *appsTypes.QueryParamsRequest) (*appsTypes.QueryParamsResponse, error) {
+	params, err := app.PCA.QueryAllParams(grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &appsTypes.QueryParamsResponse{Params: toProtoParams(params.AppParams)}, nil
+}
+
+type govQueryServer struct {
+	govTypes.UnimplementedQueryServer
+}
+
+func (s *govQueryServer) DAOOwner(_ context.Context, req *govTypes.QueryHeightRequest) (*govTypes.QueryDAOOwnerResponse, error) {
+	owner, err := app.PCA.QueryDaoOwner(grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &govTypes.QueryDAOOwnerResponse{Address: owner}, nil
+}
+
+func (s *govQueryServer) ACL(_ context.Context, req *govTypes.QueryHeightRequest) (*govTypes.QueryACLResponse, error) {
+	acl, err := app.PCA.QueryACL(grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &govTypes.QueryACLResponse{Acl: acl}, nil
+}
+
+func (s *govQueryServer) Upgrade(_ context.Context, req *govTypes.QueryHeightRequest) (*govTypes.Upgrade, error) {
+	upgrade, err := app.PCA.QueryUpgrade(grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &upgrade, nil
+}
+
+func (s *govQueryServer) AllParams(_ context.Context, req *govTypes.QueryHeightRequest) (*govTypes.QueryAllParamsResponse, error) {
+	params, err := app.PCA.QueryAllParams(grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &govTypes.QueryAllParamsResponse{
+		AppParams:    toProtoParams(params.AppParams),
+		NodeParams:   toProtoParams(params.NodeParams),
+		PocketParams: toProtoParams(params.PocketParams),
+		GovParams:    toProtoParams(params.GovParams),
+		AuthParams:   toProtoParams(params.AuthParams),
+	}, nil
+}
+
+func (s *govQueryServer) Param(_ context.Context, req *govTypes.QueryParamRequest) (*sdk.Param, error) {
+	param, err := app.PCA.QueryParam(grpcHeight(req.Height), req.Key)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if param.Key == "" {
+		return nil, status.Errorf(codes.NotFound, "param not found for %s", req.Key)
+	}
+	return &sdk.Param{Key: param.Key, Value: param.Value}, nil
+}
+
+type pocketQueryServer struct {
+	pocketTypes.UnimplementedQueryServer
+}
+
+func (s *pocketQueryServer) SupportedChains(_ context.Context, req *pocketTypes.QueryHeightRequest) (*pocketTypes.QuerySupportedChainsResponse, error) {
+	chains, err := app.PCA.QueryPocketSupportedBlockchains(grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pocketTypes.QuerySupportedChainsResponse{Chains: chains}, nil
+}
+
+func (s *pocketQueryServer) Claim(_ context.Context, req *pocketTypes.QueryClaimRequest) (*pocketTypes.MsgClaim, error) {
+	claim, err := app.PCA.QueryClaim(req.Address, req.AppPubkey, req.Blockchain, req.ReceiptType, req.SessionBlockHeight, grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return claim, nil
+}
+
+func (s *pocketQueryServer) Claims(_ context.Context, req *pocketTypes.QueryClaimsRequest) (*pocketTypes.QueryClaimsResponse, error) {
+	page, err := app.PCA.QueryClaims(req.Address, grpcHeight(req.Height), int(req.Page), int(req.PerPage))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	res := &pocketTypes.QueryClaimsResponse{Page: int64(page.Page), TotalPages: int64(page.Total)}
+	if claims, ok := page.Result.([]pocketTypes.MsgClaim); ok {
+		res.Claims = claims
+	}
+	return res, nil
+}
+
+func (s *pocketQueryServer) Params(_ context.Context, req *pocketTypes.QueryHeightRequest) (*pocketTypes.QueryParamsResponse, error) {
+	params, err := app.PCA.QueryAllParams(grpcHeight(req.Height))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pocketTypes.QueryParamsResponse{Params: toProtoParams(params.PocketParams)}, nil
+}
@@ -0,0 +1,134 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestGRPCClient(t *testing.T, config sdk.PocketConfig, opts ...grpc.DialOption) *grpc.ClientConn {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := NewGRPCServer(config)
+	go func() { _ = srv.Serve(lis) }()
+	opts = append(opts, grpc.WithInsecure(), GRPCCodecOption(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }))
+	conn, err := grpc.Dial("bufnet", opts...)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+		srv.Stop()
+	})
+	return conn
+}
+
+func TestGogoCodec(t *testing.T) {
+	req := &nodesTypes.QueryNodesRequest{Height: 2, StakingStatus: 2, Blockchain: "0001", Page: 1, PerPage: 10}
+	bz, err := gogoCodec{}.Marshal(req)
+	require.Nil(t, err)
+	var res nodesTypes.QueryNodesRequest
+	require.Nil(t, gogoCodec{}.Unmarshal(bz, &res))
+	assert.Equal(t, *req, res)
+	_, err = gogoCodec{}.Marshal("foo")
+	assert.NotNil(t, err)
+}
+
+func TestGRPC_AuthAndLimits(t *testing.T) {
+	setTestAuthToken(t, "secret")
+	ctx := context.Background()
+	// the calls are rejected before they reach the query servers
+	_, err := sdk.NewServiceClient(newTestGRPCClient(t, sdk.PocketConfig{})).Height(ctx, &sdk.HeightRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = sdk.NewServiceClient(newTestGRPCClient(t, sdk.PocketConfig{}, GRPCAuthOption("wrong"))).Height(ctx, &sdk.HeightRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	conn := newTestGRPCClient(t, sdk.PocketConfig{RPCIPRate: 0.001, RPCIPBurst: 1})
+	_, err = sdk.NewServiceClient(conn).Height(ctx, &sdk.HeightRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = sdk.NewServiceClient(conn).Height(ctx, &sdk.HeightRequest{})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestGRPC_Query(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	defer cleanup()
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	defer stopCli()
+	<-evtChan // Wait for block
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	require.Nil(t, err)
+	setTestAuthToken(t, "secret")
+	conn := newTestGRPCClient(t, sdk.PocketConfig{}, GRPCAuthOption("secret"))
+	ctx := context.Background()
+
+	height, err := sdk.NewServiceClient(conn).Height(ctx, &sdk.HeightRequest{})
+	require.Nil(t, err)
+	assert.True(t, height.Height > 0)
+
+	node, err := nodesTypes.NewQueryClient(conn).Node(ctx, &nodesTypes.QueryNodeRequest{Address: cb.GetAddress().String()})
+	require.Nil(t, err)
+	assert.Equal(t, cb.GetAddress(), node.Node.Address)
+	nodes, err := nodesTypes.NewQueryClient(conn).Nodes(ctx, &nodesTypes.QueryNodesRequest{Height: height.Height, StakingStatus: int32(sdk.Staked)})
+	require.Nil(t, err)
+	assert.NotEmpty(t, nodes.Nodes)
+	assert.Equal(t, int64(1), nodes.Page)
+
+	account, err := authTypes.NewQueryClient(conn).Account(ctx, &authTypes.QueryAccountRequest{Address: cb.GetAddress().String()})
+	require.Nil(t, err)
+	assert.Equal(t, cb.GetAddress(), account.Account.Address)
+	balance, err := authTypes.NewQueryClient(conn).Balance(ctx, &authTypes.QueryBalanceRequest{Address: cb.GetAddress().String()})
+	require.Nil(t, err)
+	amount, ok := sdk.NewIntFromString(balance.Balance)
+	assert.True(t, ok)
+	assert.True(t, amount.IsPositive())
+	_, err = authTypes.NewQueryClient(conn).Account(ctx, &authTypes.QueryAccountRequest{Address: "foo"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	params, err := govTypes.NewQueryClient(conn).AllParams(ctx, &govTypes.QueryHeightRequest{})
+	require.Nil(t, err)
+	assert.NotEmpty(t, params.NodeParams)
+	param, err := govTypes.NewQueryClient(conn).Param(ctx, &govTypes.QueryParamRequest{Key: "pos/StakeMinimum"})
+	require.Nil(t, err)
+	assert.Equal(t, "pos/StakeMinimum", param.Key)
+	_, err = govTypes.NewQueryClient(conn).Param(ctx, &govTypes.QueryParamRequest{Key: "pos/Foo"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	chains, err := pocketTypes.NewQueryClient(conn).SupportedChains(ctx, &pocketTypes.QueryHeightRequest{})
+	require.Nil(t, err)
+	assert.NotEmpty(t, chains.Chains)
+}
+
+func TestGRPC_SubscribeBlocks(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	defer cleanup()
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	defer stopCli()
+	<-evtChan // Wait for block
+	setTestAuthToken(t, "secret")
+	conn := newTestGRPCClient(t, sdk.PocketConfig{}, GRPCAuthOption("secret"))
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	stream, err := sdk.NewServiceClient(conn).SubscribeBlocks(ctx, &sdk.SubscribeBlocksRequest{FromHeight: 1})
+	require.Nil(t, err)
+	block, err := stream.Recv()
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), block.Height)
+	assert.NotEmpty(t, block.Hash)
+	assert.False(t, block.Time.IsZero())
+}
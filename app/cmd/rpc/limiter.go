@@ -0,0 +1,306 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/prometheus"
+	sdk "github.com/pokt-network/pocket-core/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	stdPrometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	RelayPath    = "/v1/client/relay"
+	DispatchPath = "/v1/client/dispatch"
+
+	limitReasonIP          = "ip"
+	limitReasonApp         = "app"
+	limitReasonConcurrency = "concurrency"
+	limitReasonBodySize    = "body_size"
+	limitReasonBodyRead    = "body_read"
+)
+
+var (
+	limiterMetricsOnce sync.Once
+	rejectedRequests   metrics.Counter
+)
+
+// rejectedRequestsCounter is registered once, with the metrics served by the service metrics prometheus server
+func rejectedRequestsCounter() metrics.Counter {
+	limiterMetricsOnce.Do(func() {
+		rejectedRequests = prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: pocketTypes.ModuleName,
+			Subsystem: "rpc",
+			Name:      "rejected_requests",
+			Help:      "the number of rpc requests rejected by the rate limits, body size and concurrency caps",
+		}, []string{"route", "reason"})
+	})
+	return rejectedRequests
+}
+
+// tokenBucket refills rate tokens per second up to its burst, a request takes a token
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is a token bucket per key, kept in an lru so the idle keys are forgotten
+type rateLimiter struct {
+	rate    float64
+	burst   float64
+	mu      sync.Mutex
+	buckets *sdk.Cache
+}
+
+// newRateLimiter returns nil when the rate is not set
+func newRateLimiter(rate float64, burst, maxKeys int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+	if maxKeys <= 0 {
+		maxKeys = sdk.DefaultRPCMaxTrackedClients
+	}
+	return &rateLimiter{rate: rate, burst: float64(burst), buckets: sdk.NewCache(maxKeys)}
+}
+
+// allow takes a token of the key, or returns how long until the next token
+func (l *rateLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := &tokenBucket{tokens: l.burst, last: now}
+	if v, ok := l.buckets.Get(key); ok {
+		b = v.(*tokenBucket)
+		b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+		b.last = now
+	} else {
+		l.buckets.Add(key, b)
+	}
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// routeCap is a concurrency cap shared by the paths with its prefix
+type routeCap struct {
+	prefix string
+	sem    chan struct{}
+}
+
+// Limiter protects the rpc server with per ip and per application rate limits, per route concurrency caps and a max
+// request body size
+type Limiter struct {
+	ip                 *rateLimiter
+	app                *rateLimiter
+	paths              map[string]chan struct{} // concurrency caps by exact path
+	prefixes           []routeCap
+	maxBodyBytes       int64
+	trustXForwardedFor bool
+	trustedProxies     int                 // the proxies in front of the node, each appending to X-Forwarded-For
+	routes             map[string]struct{} // the known paths, used as metric labels
+}
+
+// NewLimiter returns the limiter of the config, for the routes served
+func NewLimiter(config sdk.PocketConfig, routes Routes) *Limiter {
+	l := &Limiter{
+		ip:                 newRateLimiter(config.RPCIPRate, config.RPCIPBurst, config.RPCMaxTrackedClients),
+		app:                newRateLimiter(config.RPCAppRelayRate, config.RPCAppRelayBurst, config.RPCMaxTrackedClients),
+		paths:              make(map[string]chan struct{}),
+		maxBodyBytes:       config.RPCMaxBodyBytes,
+		trustXForwardedFor: config.RPCTrustXForwardedFor,
+		trustedProxies:     config.RPCTrustedProxies,
+		routes:             make(map[string]struct{}),
+	}
+	if l.trustedProxies <= 0 {
+		l.trustedProxies = 1
+	}
+	for path, max := range config.RPCRouteConcurrency {
+		if max <= 0 {
+			continue
+		}
+		if strings.HasSuffix(path, "*") {
+			l.prefixes = append(l.prefixes, routeCap{prefix: strings.TrimSuffix(path, "*"), sem: make(chan struct{}, max)})
+			continue
+		}
+		l.paths[path] = make(chan struct{}, max)
+	}
+	// the event streams are long-lived, so they are always capped unless a cap is set for their path
+	if _, ok := l.paths[EventsPath]; !ok && config.RPCMaxEventStreams > 0 {
+		l.paths[EventsPath] = make(chan struct{}, config.RPCMaxEventStreams)
+	}
+	for _, r := range routes {
+		l.routes[r.Path] = struct{}{}
+	}
+	return l
+}
+
+// Handler applies the limits before the requests reach next
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := time.Now()
+		if l.ip != nil {
+			if ok, retry := l.ip.allow(l.clientIP(r), now); !ok {
+				l.reject(w, r, limitReasonIP, retry, "too many requests from this ip")
+				return
+			}
+		}
+		if l.maxBodyBytes > 0 {
+			if r.ContentLength > l.maxBodyBytes {
+				l.reject(w, r, limitReasonBodySize, 0, "the request body is too large")
+				return
+			}
+		}
+		if l.app != nil && r.Method == http.MethodPost && (r.URL.Path == RelayPath || r.URL.Path == DispatchPath) {
+			appPubKey, tooLarge, err := l.appPublicKey(r)
+			if err != nil {
+				l.reject(w, r, limitReasonBodyRead, 0, "unable to read the request body")
+				return
+			}
+			if tooLarge {
+				l.reject(w, r, limitReasonBodySize, 0, "the request body is too large")
+				return
+			}
+			if appPubKey != "" {
+				// the public key is read before the relay is validated, so anyone can send it: the bucket is the
+				// application's from this client ip only, a spoofed key can't use up the quota of the application
+				if ok, retry := l.app.allow(r.URL.Path+"/"+l.clientIP(r)+"/"+appPubKey, now); !ok {
+					l.reject(w, r, limitReasonApp, retry, "too many requests for this application")
+					return
+				}
+			}
+		} else if l.maxBodyBytes > 0 {
+			r.Body = http.MaxBytesReader(w, r.Body, l.maxBodyBytes)
+		}
+		if sem := l.concurrencyCap(r.URL.Path); sem != nil {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			default:
+				l.reject(w, r, limitReasonConcurrency, time.Second, "too many requests in flight for this route")
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientIP is the ip of the remote address, or behind trusted proxies the X-Forwarded-For ip appended by the first of
+// them. Every proxy appends the address of its peer, so the entries on the left are set by the client and can't be
+// trusted: the client ip is the entry of the trusted proxies counted from the right
+func (l *Limiter) clientIP(r *http.Request) string {
+	return l.forwardedClientIP(r.Header.Values("X-Forwarded-For"), r.RemoteAddr)
+}
+
+// forwardedClientIP is the client ip of the X-Forwarded-For header values and the remote address, see clientIP
+func (l *Limiter) forwardedClientIP(forwardedFor []string, remoteAddr string) string {
+	if l.trustXForwardedFor {
+		var forwarded []string
+		for _, header := range forwardedFor {
+			for _, ip := range strings.Split(header, ",") {
+				if ip = strings.TrimSpace(ip); ip != "" {
+					forwarded = append(forwarded, ip)
+				}
+			}
+		}
+		if len(forwarded) != 0 {
+			i := len(forwarded) - l.trustedProxies
+			if i < 0 {
+				// fewer entries than proxies, the leftmost was still appended by a trusted proxy
+				i = 0
+			}
+			return forwarded[i]
+		}
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+// appPublicKey reads the application public key of a relay or dispatch, and puts the body back for the handler.
+// tooLarge is true if the body is over the max body size
+func (l *Limiter) appPublicKey(r *http.Request) (appPubKey string, tooLarge bool, err error) {
+	var reader io.Reader
+	if l.maxBodyBytes > 0 {
+		// a byte more to tell a body too large
+		reader = io.LimitReader(r.Body, l.maxBodyBytes+1)
+	} else {
+		// the handlers read no more than that anyway
+		reader = io.LimitReader(r.Body, sdk.DefaultRPCMaxBodyBytes)
+	}
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", false, err
+	}
+	if l.maxBodyBytes > 0 && int64(len(body)) > l.maxBodyBytes {
+		return "", true, nil
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if r.URL.Path == DispatchPath {
+		var header pocketTypes.SessionHeader
+		_ = json.Unmarshal(body, &header)
+		return header.ApplicationPubKey, false, nil
+	}
+	var relay struct {
+		Proof struct {
+			Token struct {
+				ApplicationPublicKey string `json:"app_pub_key"`
+			} `json:"aat"`
+		} `json:"proof"`
+	}
+	_ = json.Unmarshal(body, &relay)
+	return relay.Proof.Token.ApplicationPublicKey, false, nil
+}
+
+// concurrencyCap returns the semaphore of the path, if it is capped
+func (l *Limiter) concurrencyCap(path string) chan struct{} {
+	if sem, ok := l.paths[path]; ok {
+		return sem
+	}
+	for _, c := range l.prefixes {
+		if strings.HasPrefix(path, c.prefix) {
+			return c.sem
+		}
+	}
+	return nil
+}
+
+// reject answers 429, 413 for a body too large or 400 for a body that can't be read, and counts the rejection
+func (l *Limiter) reject(w http.ResponseWriter, r *http.Request, reason string, retry time.Duration, msg string) {
+	l.countRejected(r.URL.Path, reason)
+	switch reason {
+	case limitReasonBodySize:
+		WriteErrorResponse(w, http.StatusRequestEntityTooLarge, msg)
+		return
+	case limitReasonBodyRead:
+		WriteErrorResponse(w, http.StatusBadRequest, msg)
+		return
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Max(1, math.Ceil(retry.Seconds())))))
+	WriteErrorResponse(w, http.StatusTooManyRequests, fmt.Sprintf("%s, retry later", msg))
+}
+
+// countRejected counts a rejected request of the route, the unknown routes share a label
+func (l *Limiter) countRejected(route, reason string) {
+	if _, ok := l.routes[route]; !ok {
+		route = "other"
+	}
+	rejectedRequestsCounter().With("route", route, "reason", reason).Add(1)
+}
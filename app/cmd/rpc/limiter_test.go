@@ -0,0 +1,139 @@
+package rpc
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	l := newRateLimiter(2, 3, 10)
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		ok, _ := l.allow("a", now)
+		assert.True(t, ok)
+	}
+	ok, retry := l.allow("a", now)
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, retry)
+	// other keys have their own bucket
+	ok, _ = l.allow("b", now)
+	assert.True(t, ok)
+	// refilled at the rate
+	ok, _ = l.allow("a", now.Add(500*time.Millisecond))
+	assert.True(t, ok)
+	ok, _ = l.allow("a", now.Add(500*time.Millisecond))
+	assert.False(t, ok)
+	assert.Nil(t, newRateLimiter(0, 10, 10))
+}
+
+func TestLimiter_Handler(t *testing.T) {
+	var body string
+	release := make(chan struct{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/query/block" {
+			<-release
+			return
+		}
+		bz, _ := ioutil.ReadAll(r.Body)
+		body = string(bz)
+	})
+	config := sdk.PocketConfig{
+		RPCIPRate:           1,
+		RPCIPBurst:          2,
+		RPCAppRelayRate:     1,
+		RPCAppRelayBurst:    1,
+		RPCRouteConcurrency: map[string]int{"/v1/query/*": 1},
+		RPCMaxBodyBytes:     100,
+	}
+	handler := NewLimiter(config, GetRoutes()).Handler(next)
+	send := func(ip, path, data string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", path, strings.NewReader(data))
+		req.RemoteAddr = ip + ":1234"
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+	// per ip
+	assert.Equal(t, http.StatusOK, send("1.1.1.1", "/v1", "").Code)
+	assert.Equal(t, http.StatusOK, send("1.1.1.1", "/v1", "").Code)
+	w := send("1.1.1.1", "/v1", "")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusOK, send("2.2.2.2", "/v1", "").Code)
+	// per application, the body still reaches the handler
+	relay := `{"proof":{"aat":{"app_pub_key":"abc"}}}`
+	assert.Equal(t, http.StatusOK, send("3.3.3.3", RelayPath, relay).Code)
+	assert.Equal(t, relay, body)
+	assert.Equal(t, http.StatusTooManyRequests, send("3.3.3.3", RelayPath, relay).Code)
+	assert.Equal(t, http.StatusOK, send("4.4.4.4", DispatchPath, `{"app_public_key":"abc"}`).Code)
+	// another client ip sending the public key of the application doesn't use up its quota
+	assert.Equal(t, http.StatusOK, send("9.9.9.9", RelayPath, relay).Code)
+	// body size
+	assert.Equal(t, http.StatusRequestEntityTooLarge, send("5.5.5.5", "/v1", strings.Repeat("a", 101)).Code)
+	// concurrency, shared across the prefix
+	done := make(chan int)
+	go func() { done <- send("6.6.6.6", "/v1/query/block", "").Code }()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, http.StatusTooManyRequests, send("7.7.7.7", "/v1/query/height", "").Code)
+	close(release)
+	assert.Equal(t, http.StatusOK, <-done)
+	assert.Equal(t, http.StatusOK, send("8.8.8.8", "/v1/query/height", "").Code)
+}
+
+func TestLimiter_ClientIP(t *testing.T) {
+	req := httptest.NewRequest("POST", "/v1", nil)
+	req.RemoteAddr = "10.0.0.1:5555"
+	// the client sets the leftmost entry, the proxy appends the address of the client
+	req.Header.Set("X-Forwarded-For", "6.6.6.6, 1.2.3.4")
+	assert.Equal(t, "10.0.0.1", NewLimiter(sdk.PocketConfig{}, nil).clientIP(req))
+	assert.Equal(t, "1.2.3.4", NewLimiter(sdk.PocketConfig{RPCTrustXForwardedFor: true}, nil).clientIP(req))
+	// behind two proxies, over separate headers
+	req.Header.Add("X-Forwarded-For", "10.0.0.2")
+	twoProxies := NewLimiter(sdk.PocketConfig{RPCTrustXForwardedFor: true, RPCTrustedProxies: 2}, nil)
+	assert.Equal(t, "1.2.3.4", twoProxies.clientIP(req))
+	req.Header.Set("X-Forwarded-For", "1.2.3.4")
+	assert.Equal(t, "1.2.3.4", twoProxies.clientIP(req))
+}
+
+func TestLimiter_BodyRead(t *testing.T) {
+	l := NewLimiter(sdk.PocketConfig{RPCAppRelayRate: 1, RPCMaxBodyBytes: 10}, nil)
+	handler := l.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", RelayPath, iotest.ErrReader(errors.New("reset"))))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	// the content length isn't always set
+	r := httptest.NewRequest("POST", RelayPath, strings.NewReader(strings.Repeat("a", 11)))
+	r.ContentLength = -1
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func TestLimiter_EventStreams(t *testing.T) {
+	release := make(chan struct{})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { <-release })
+	handler := NewLimiter(sdk.PocketConfig{RPCMaxEventStreams: 1}, StreamRoutes()).Handler(next)
+	send := func() int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", EventsPath, nil))
+		return w.Code
+	}
+	done := make(chan int)
+	go func() { done <- send() }()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, http.StatusTooManyRequests, send())
+	close(release)
+	assert.Equal(t, http.StatusOK, <-done)
+	// the route concurrency of the path takes precedence
+	l := NewLimiter(sdk.PocketConfig{RPCMaxEventStreams: 1, RPCRouteConcurrency: map[string]int{EventsPath: 5}}, nil)
+	assert.Equal(t, 5, cap(l.concurrencyCap(EventsPath)))
+}
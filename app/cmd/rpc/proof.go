@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pokt-network/pocket-core/app"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/verify"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// ProvedResponse is returned by the query routes when prove is set: the usual result of the route along with the
+// proof of the raw store value it was decoded from
+type ProvedResponse struct {
+	Result json.RawMessage   `json:"result"`
+	Proof  verify.StoreProof `json:"proof"`
+}
+
+// defaultQueryHeight returns the height used when none is provided, proofs are only available up to the provable height
+func defaultQueryHeight(prove bool) int64 {
+	if prove {
+		return app.PCA.ProvableHeight()
+	}
+	return app.PCA.BaseApp.LastBlockHeight()
+}
+
+func writeProvedResponse(w http.ResponseWriter, r *http.Request, result []byte, store string, key []byte, height int64) {
+	proof, err := app.PCA.QueryStoreProof(store, key, height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(ProvedResponse{Result: result, Proof: proof})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func claimProofKey(params QueryNodeReceiptParam) (store string, key []byte, err error) {
+	addr, err := sdk.AddressFromHex(params.Address)
+	if err != nil {
+		return
+	}
+	evidenceType, err := pocketTypes.EvidenceTypeFromString(params.ReceiptType)
+	if err != nil {
+		return
+	}
+	header := pocketTypes.SessionHeader{
+		ApplicationPubKey:  params.AppPubKey,
+		Chain:              params.Blockchain,
+		SessionBlockHeight: params.SBlockHeight,
+	}
+	return verify.ClaimKey(addr, header, evidenceType)
+}
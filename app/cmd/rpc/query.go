@@ -13,10 +13,12 @@ import (
 	"github.com/tendermint/tendermint/types"
 	"math/big"
 	"net/http"
+	"sort"
 	"strconv"
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/verify"
 	appTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
@@ -27,9 +29,7 @@ func Version(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 }
 
 func LocalNodes(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
-	value := r.URL.Query().Get("authtoken")
-	if value != app.AuthToken.Value {
-		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+	if !authorized(w, r) {
 		return
 	}
 	var localNodes []types4.PublicPocketNode
@@ -51,12 +51,41 @@ func LocalNodes(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	}
 }
 
+// LocalEvidence returns the evidence and proofs held by every local node, counted without flushing the evidence caches
+func LocalEvidence(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if !authorized(w, r) {
+		return
+	}
+	localEvidence := make([]types4.LocalEvidence, 0, len(types3.GlobalPocketNodes))
+	for _, node := range types3.GlobalPocketNodes {
+		if node == nil || node.EvidenceStore == nil {
+			continue
+		}
+		localEvidence = append(localEvidence, types4.LocalEvidence{
+			Address:            node.GetAddress().String(),
+			EvidenceStoreStats: types3.GetEvidenceStoreStats(node.EvidenceStore),
+		})
+	}
+	sort.Slice(localEvidence, func(i, j int) bool { return localEvidence[i].Address < localEvidence[j].Address })
+	j, err := json.Marshal(localEvidence)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	_, err = w.Write(j)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+	}
+}
+
 type HeightParams struct {
 	Height int64 `json:"height"`
 }
 type HeightAndKeyParams struct {
 	Height int64  `json:"height"`
 	Key    string `json:"key"`
+	Prove  bool   `json:"prove"`
 }
 
 type HashAndProveParams struct {
@@ -67,6 +96,7 @@ type HashAndProveParams struct {
 type HeightAndAddrParams struct {
 	Height  int64  `json:"height"`
 	Address string `json:"address"`
+	Prove   bool   `json:"prove"`
 }
 
 type HeightAndValidatorOptsParams struct {
@@ -458,7 +488,7 @@ func Balance(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = defaultQueryHeight(params.Prove)
 	}
 	balance, err := app.PCA.QueryBalance(params.Address, params.Height)
 	if err != nil {
@@ -470,6 +500,16 @@ func Balance(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		addr, err := sdk.AddressFromHex(params.Address)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		store, key := verify.AccountKey(addr)
+		writeProvedResponse(w, r, s, store, key, params.Height)
+		return
+	}
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
@@ -480,7 +520,7 @@ func Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = defaultQueryHeight(params.Prove)
 	}
 	res, err := app.PCA.QueryAccount(params.Address, params.Height)
 	if err != nil {
@@ -492,6 +532,16 @@ func Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		addr, err := sdk.AddressFromHex(params.Address)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		store, key := verify.AccountKey(addr)
+		writeProvedResponse(w, r, s, store, key, params.Height)
+		return
+	}
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
@@ -556,7 +606,7 @@ func Node(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = defaultQueryHeight(params.Prove)
 	}
 	res, err := app.PCA.QueryNode(params.Address, params.Height)
 	if err != nil {
@@ -568,6 +618,16 @@ func Node(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		addr, err := sdk.AddressFromHex(params.Address)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		store, key := verify.NodeKey(addr)
+		writeProvedResponse(w, r, j, store, key, params.Height)
+		return
+	}
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
@@ -611,21 +671,18 @@ func SecondUpgrade(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 }
 
 func Chains(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
-	value := r.URL.Query().Get("authtoken")
-	if value == app.AuthToken.Value {
+	if authorized(w, r) {
 		res, err := app.PCA.QueryHostedChains()
 		if err != nil {
 			WriteErrorResponse(w, 400, err.Error())
 			return
 		}
-		j, err := app.Codec().MarshalJSON(res)
+		j, err := app.Codec().MarshalJSON(redactedChains(res))
 		if err != nil {
 			WriteErrorResponse(w, 400, err.Error())
 			return
 		}
 		WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
-	} else {
-		WriteErrorResponse(w, 401, "wrong authtoken "+value)
 	}
 }
 
@@ -680,6 +737,7 @@ type QueryNodeReceiptParam struct {
 	SBlockHeight int64  `json:"session_block_height"`
 	Height       int64  `json:"height"`
 	ReceiptType  string `json:"receipt_type"`
+	Prove        bool   `json:"prove"`
 }
 
 func NodeClaim(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -689,7 +747,7 @@ func NodeClaim(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = defaultQueryHeight(params.Prove)
 	}
 	res, err := app.PCA.QueryClaim(params.Address, params.AppPubKey, params.Blockchain, params.ReceiptType, params.SBlockHeight, params.Height)
 	if err != nil {
@@ -701,6 +759,15 @@ func NodeClaim(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		store, key, err := claimProofKey(params)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		writeProvedResponse(w, r, j, store, key, params.Height)
+		return
+	}
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
@@ -765,7 +832,7 @@ func App(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = defaultQueryHeight(params.Prove)
 	}
 	res, err := app.PCA.QueryApp(params.Address, params.Height)
 	if err != nil {
@@ -777,6 +844,16 @@ func App(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		addr, err := sdk.AddressFromHex(params.Address)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		store, key := verify.AppKey(addr)
+		writeProvedResponse(w, r, j, store, key, params.Height)
+		return
+	}
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
@@ -990,7 +1067,7 @@ func Param(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		return
 	}
 	if params.Height == 0 {
-		params.Height = app.PCA.BaseApp.LastBlockHeight()
+		params.Height = defaultQueryHeight(params.Prove)
 	}
 	res, err := app.PCA.QueryParam(params.Height, params.Key)
 	if err != nil {
@@ -1002,6 +1079,11 @@ func Param(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if params.Prove {
+		store, key := verify.ParamKey(params.Key)
+		writeProvedResponse(w, r, j, store, key, params.Height)
+		return
+	}
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
@@ -1021,3 +1103,66 @@ func State(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	}
 	WriteRaw(w, res, r.URL.Path, r.Host)
 }
+
+func NodeUnbonding(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryNodeUnbonding(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteRaw(w, string(j), r.URL.Path, r.Host)
+}
+
+func AppUnbonding(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QueryAppUnbonding(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteRaw(w, string(j), r.URL.Path, r.Host)
+}
+
+type QuerySessionParams struct {
+	AppPubKey    string `json:"app_pubkey"`
+	Blockchain   string `json:"blockchain"`
+	SBlockHeight int64  `json:"session_block_height"`
+}
+
+func Session(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = QuerySessionParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.QuerySession(params.AppPubKey, params.Blockchain, params.SBlockHeight)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -15,6 +16,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/pocket-core/codec"
@@ -26,6 +28,7 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/verify"
 	"github.com/pokt-network/pocket-core/x/auth"
 	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/pokt-network/pocket-core/x/nodes"
@@ -472,6 +475,41 @@ func TestRPC_QueryAccount(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryAccountWithProof(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan
+	<-evtChan
+	<-evtChan
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	var params = HeightAndAddrParams{
+		Address: cb.GetAddress().String(),
+		Prove:   true,
+	}
+	q := newQueryRequest("account", newBody(params))
+	rec := httptest.NewRecorder()
+	Account(rec, q, httprouter.Params{})
+	resp := getJSONResponse(rec)
+	var proved ProvedResponse
+	assert.Nil(t, json.Unmarshal(resp, &proved))
+	assert.Regexp(t, "upokt", string(proved.Result))
+	height := proved.Proof.Height + 1
+	block, err := app.PCA.GetClient().Block(&height)
+	assert.Nil(t, err)
+	assert.Nil(t, proved.Proof.VerifyHeader(block.Block.Header))
+	store, key := verify.AccountKey(cb.GetAddress())
+	assert.Nil(t, proved.Proof.VerifyKey(store, key))
+	value, err := app.DecodeStoreProofValue(proved.Proof)
+	assert.Nil(t, err)
+	assert.NotNil(t, value)
+
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryAccounts(t *testing.T) {
 	codec.UpgradeHeight = 7000
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
@@ -1412,6 +1450,28 @@ func TestRPC_SimRelay(t *testing.T) {
 	assert.Equal(t, resp, expectedResponse)
 }
 
+func TestRPC_Events(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", "localhost:8081"+EventsPath+"?types=block&from_height=1", nil)
+	assert.Nil(t, err)
+	rec := httptest.NewRecorder()
+	Events(rec, req, httprouter.Params{})
+	resp := getResponse(rec)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Contains(t, resp, "id: 1\nevent: block\n")
+	assert.Contains(t, resp, "id: 2\nevent: block\n")
+	assert.NotContains(t, resp, "event: tx\n")
+
+	cleanup()
+	stopCli()
+}
+
 func newBody(params interface{}) io.Reader {
 	bz, err := json.Marshal(params)
 	if err != nil {
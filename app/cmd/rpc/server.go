@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -50,14 +51,48 @@ func StartRPC(port string, timeout int64, simulation, debug, allBlockTxs, hotRel
 		routes = append(routes, Route{Name: "UpdateChains", Method: "POST", Path: "/v1/private/updatechains", HandlerFunc: UpdateChains})
 	}
 
+	config := app.GlobalConfig.PocketConfig
+	routes = securePrivateRoutes(routes, config)
+	// the private routes get their own server when they have their own address
+	var privateRoutes Routes
+	if config.RPCPrivateAddr != "" {
+		routes, privateRoutes = splitPrivateRoutes(routes)
+	}
+	clientAuth := tls.VerifyClientCertIfGiven
+	if len(privateRoutes) != 0 {
+		clientAuth = tls.NoClientCert
+	}
+	tlsConfig, err := rpcTLSConfig(config, clientAuth)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(privateRoutes) != 0 {
+		privateTLSConfig, err := rpcTLSConfig(config, tls.RequireAndVerifyClientCert)
+		if err != nil {
+			log.Fatal(err)
+		}
+		privateSrv := &http.Server{
+			ReadTimeout:       30 * time.Second,
+			ReadHeaderTimeout: 20 * time.Second,
+			WriteTimeout:      60 * time.Second,
+			Addr:              config.RPCPrivateAddr,
+			Handler:           http.TimeoutHandler(Router(privateRoutes), time.Duration(timeout)*time.Millisecond, "Server Timeout Handling Request"),
+			TLSConfig:         privateTLSConfig,
+		}
+		go func() { log.Fatal(serveRPC(privateSrv, config)) }()
+	}
+
+	// rate limits, concurrency caps and body size of the public routes
+	limiter := NewLimiter(config, append(routes, StreamRoutes()...))
 	srv := &http.Server{
 		ReadTimeout:       30 * time.Second,
 		ReadHeaderTimeout: 20 * time.Second,
 		WriteTimeout:      60 * time.Second,
 		Addr:              ":" + port,
-		Handler:           http.TimeoutHandler(Router(routes), time.Duration(timeout)*time.Millisecond, "Server Timeout Handling Request"),
+		Handler:           limiter.Handler(streamRouter(http.TimeoutHandler(Router(routes), time.Duration(timeout)*time.Millisecond, "Server Timeout Handling Request"))),
+		TLSConfig:         tlsConfig,
 	}
-	log.Fatal(srv.ListenAndServe())
+	log.Fatal(serveRPC(srv, config))
 }
 
 func Router(routes Routes) *httprouter.Router {
@@ -68,6 +103,16 @@ func Router(routes Routes) *httprouter.Router {
 	return router
 }
 
+// streamRouter serves the long-lived stream routes directly and every other request through the given handler
+func streamRouter(next http.Handler) *httprouter.Router {
+	router := Router(StreamRoutes())
+	router.NotFound = next
+	router.HandleMethodNotAllowed = false
+	router.HandleOPTIONS = false
+	router.RedirectTrailingSlash = false
+	return router
+}
+
 func cors(w *http.ResponseWriter, r *http.Request) (isOptions bool) {
 	(*w).Header().Set("Access-Control-Allow-Origin", "*")
 	(*w).Header().Set("Access-Control-Allow-Methods", "POST")
@@ -102,6 +147,7 @@ func GetRoutes() Routes {
 		Route{Name: "QueryAllParams", Method: "POST", Path: "/v1/query/allparams", HandlerFunc: AllParams},
 		Route{Name: "QueryApp", Method: "POST", Path: "/v1/query/app", HandlerFunc: App},
 		Route{Name: "QueryAppParams", Method: "POST", Path: "/v1/query/appparams", HandlerFunc: AppParams},
+		Route{Name: "QueryAppUnbonding", Method: "POST", Path: "/v1/query/appunbonding", HandlerFunc: AppUnbonding},
 		Route{Name: "QueryApps", Method: "POST", Path: "/v1/query/apps", HandlerFunc: Apps},
 		Route{Name: "QueryBalance", Method: "POST", Path: "/v1/query/balance", HandlerFunc: Balance},
 		Route{Name: "QueryBlock", Method: "POST", Path: "/v1/query/block", HandlerFunc: Block},
@@ -112,9 +158,11 @@ func GetRoutes() Routes {
 		Route{Name: "QueryNodeClaim", Method: "POST", Path: "/v1/query/nodeclaim", HandlerFunc: NodeClaim},
 		Route{Name: "QueryNodeClaims", Method: "POST", Path: "/v1/query/nodeclaims", HandlerFunc: NodeClaims},
 		Route{Name: "QueryNodeParams", Method: "POST", Path: "/v1/query/nodeparams", HandlerFunc: NodeParams},
+		Route{Name: "QueryNodeUnbonding", Method: "POST", Path: "/v1/query/nodeunbonding", HandlerFunc: NodeUnbonding},
 		Route{Name: "QueryNodes", Method: "POST", Path: "/v1/query/nodes", HandlerFunc: Nodes},
 		Route{Name: "QueryParam", Method: "POST", Path: "/v1/query/param", HandlerFunc: Param},
 		Route{Name: "QueryPocketParams", Method: "POST", Path: "/v1/query/pocketparams", HandlerFunc: PocketParams},
+		Route{Name: "QuerySession", Method: "POST", Path: "/v1/query/session", HandlerFunc: Session},
 		Route{Name: "QueryState", Method: "POST", Path: "/v1/query/state", HandlerFunc: State},
 		Route{Name: "QuerySupply", Method: "POST", Path: "/v1/query/supply", HandlerFunc: Supply},
 		Route{Name: "QuerySupportedChains", Method: "POST", Path: "/v1/query/supportedchains", HandlerFunc: SupportedChains},
@@ -122,7 +170,9 @@ func GetRoutes() Routes {
 		Route{Name: "QueryUpgrade", Method: "POST", Path: "/v1/query/upgrade", HandlerFunc: Upgrade},
 		Route{Name: "QuerySigningInfo", Method: "POST", Path: "/v1/query/signinginfo", HandlerFunc: SigningInfo},
 		Route{Name: "LocalNodes", Method: "POST", Path: "/v1/private/nodes", HandlerFunc: LocalNodes},
+		Route{Name: "LocalEvidence", Method: "POST", Path: "/v1/private/evidence", HandlerFunc: LocalEvidence},
 		Route{Name: "QueryChains", Method: "POST", Path: "/v1/private/chains", HandlerFunc: Chains},
+		Route{Name: "RotateAuthToken", Method: "POST", Path: RotateAuthTokenPath, HandlerFunc: RotateAuthToken},
 		Route{Name: "QueryUnconfirmedTxs", Method: "POST", Path: "/v1/query/unconfirmedtxs", HandlerFunc: UnconfirmedTxs},
 		Route{Name: "QueryUnconfirmedTx", Method: "POST", Path: "/v1/query/unconfirmedtx", HandlerFunc: UnconfirmedTx},
 	}
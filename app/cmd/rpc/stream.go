@@ -0,0 +1,178 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+)
+
+const (
+	EventsPath = "/v1/events"
+	// keeps idle streams open through proxies and detects disconnected clients
+	eventsHeartbeatInterval = 15 * time.Second
+	// a client that stops reading fails the write instead of holding the stream
+	eventsWriteTimeout = 10 * time.Second
+	eventsBufferSize   = 100
+)
+
+// StreamRoutes are served outside of the rpc timeout handler, as the responses are long-lived
+func StreamRoutes() Routes {
+	return Routes{
+		Route{Name: "Events", Method: "GET", Path: EventsPath, HandlerFunc: Events},
+	}
+}
+
+// Events streams new blocks, indexed transactions and module events as Server-Sent-Events.
+// Query parameters: types, event_types (comma separated), signer, recipient, message_type, from_height.
+// The signer, recipient and message type only select transactions and their module events, and from_height can be at most
+// app.MaxStreamReplayHeights behind the latest height.
+// Every message id is the height of the event, so a reconnecting EventSource resumes through the Last-Event-ID header
+// (the last height is sent again in full, so delivery is at-least-once)
+// The streams open at once are capped by the limiter, see PocketConfig.RPCMaxEventStreams
+func Events(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	filter, fromHeight, err := eventsParams(r)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	latest, err := app.PCA.QueryHeight()
+	if err != nil {
+		WriteErrorResponse(w, 500, err.Error())
+		return
+	}
+	if _, err := app.StreamFromHeight(fromHeight, latest); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	var (
+		out   io.Writer
+		flush func() error
+		done  func()
+	)
+	if hj, ok := w.(http.Hijacker); ok {
+		// the server write timeout would otherwise close the stream
+		conn, buf, err := hj.Hijack()
+		if err != nil {
+			WriteErrorResponse(w, 500, err.Error())
+			return
+		}
+		_ = conn.SetDeadline(time.Time{})
+		// the request context is no longer canceled once hijacked: the client sends nothing more, so the read
+		// returns as soon as it disconnects
+		go func() {
+			_, _ = io.Copy(ioutil.Discard, conn)
+			cancel()
+		}()
+		_, _ = buf.WriteString("HTTP/1.1 200 OK\r\nContent-Type: text/event-stream\r\nCache-Control: no-cache\r\n" +
+			"Access-Control-Allow-Origin: *\r\nConnection: close\r\n\r\n")
+		out, done = buf, func() { _ = conn.Close() }
+		flush = func() error {
+			_ = conn.SetWriteDeadline(time.Now().Add(eventsWriteTimeout))
+			return buf.Flush()
+		}
+	} else {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			WriteErrorResponse(w, 500, "streaming is not supported")
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.WriteHeader(http.StatusOK)
+		out, flush, done = w, func() error { flusher.Flush(); return nil }, func() {}
+	}
+	defer done()
+	if err := flush(); err != nil {
+		return
+	}
+	events := make(chan app.StreamEvent, eventsBufferSize)
+	streamErr := make(chan error, 1)
+	go func() {
+		streamErr <- app.PCA.StreamEvents(ctx, filter, fromHeight, events)
+	}()
+	heartbeat := time.NewTicker(eventsHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		var err error
+		select {
+		case e := <-events:
+			err = writeStreamEvent(out, e)
+		case <-heartbeat.C:
+			_, err = io.WriteString(out, ": heartbeat\n\n")
+		case err = <-streamErr:
+			if err != nil {
+				_ = writeStreamError(out, err)
+				_ = flush()
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+		if err == nil {
+			err = flush()
+		}
+		if err != nil {
+			return // the client disconnected
+		}
+	}
+}
+
+func writeStreamEvent(w io.Writer, e app.StreamEvent) error {
+	j, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.Height, e.Type, j)
+	return err
+}
+
+func writeStreamError(w io.Writer, streamErr error) error {
+	j, err := json.Marshal(rpcError{Code: 500, Message: streamErr.Error()})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: error\ndata: %s\n\n", j)
+	return err
+}
+
+func eventsParams(r *http.Request) (filter app.EventFilter, fromHeight int64, err error) {
+	q := r.URL.Query()
+	filter = app.EventFilter{
+		Types:       splitParam(q.Get("types")),
+		Signer:      q.Get("signer"),
+		Recipient:   q.Get("recipient"),
+		MessageType: q.Get("message_type"),
+		EventTypes:  splitParam(q.Get("event_types")),
+	}
+	from := q.Get("from_height")
+	if from == "" {
+		from = r.Header.Get("Last-Event-ID")
+	}
+	if from != "" {
+		if fromHeight, err = strconv.ParseInt(from, 10, 64); err != nil {
+			return filter, 0, fmt.Errorf("invalid from_height %s: %s", from, err.Error())
+		}
+	}
+	return
+}
+
+func splitParam(s string) (res []string) {
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return
+}
@@ -0,0 +1,9 @@
+package types
+
+import pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+
+// LocalEvidence is the evidence store of a local node
+type LocalEvidence struct {
+	Address string `json:"address"`
+	pocketTypes.EvidenceStoreStats
+}
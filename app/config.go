@@ -2,6 +2,7 @@ package app
 
 import (
 	"bufio"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -63,7 +64,8 @@ var (
 	// global genesis type
 	GlobalGenesisType GenesisType
 	// current authToken for secured rpc calls
-	AuthToken sdk.AuthToken
+	AuthToken  sdk.AuthToken
+	authTokenL sync.RWMutex
 )
 
 type GenesisType int
@@ -367,6 +369,11 @@ func InitTendermint(keybase bool, chains *types.HostedBlockchains, logger log.Lo
 		log2.Fatal(err)
 	}
 	app.pocketKeeper.TmNode = local.New(tmNode)
+	if GlobalConfig.PocketConfig.RemoteSignerAddr != "" {
+		if err := CheckRemoteSignerValidators(tmNode.ConsensusState().GetState().Validators); err != nil {
+			log2.Fatal(err)
+		}
+	}
 	if err := tmNode.Start(); err != nil {
 		log2.Fatal(err)
 	}
@@ -375,6 +382,16 @@ func InitTendermint(keybase bool, chains *types.HostedBlockchains, logger log.Lo
 
 func InitKeyfiles(logger log.Logger) {
 
+	// the servicer keys stay in the remote signer, tendermint generates its own keyfiles
+	if GlobalConfig.PocketConfig.RemoteSignerAddr != "" {
+		err := InitRemoteSigners(logger)
+		if err != nil {
+			logger.Error("Failed to init the remote signer", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if GlobalConfig.PocketConfig.LeanPocket {
 		err := InitNodesLean(logger)
 		if err != nil {
@@ -471,6 +488,8 @@ func InitPocketCoreConfig(chains *types.HostedBlockchains, logger log.Logger) {
 }
 
 func ShutdownPocketCore() {
+	// no job writes the evidence once flushed
+	types.StopClaimScheduler()
 	types.FlushSessionCache()
 	types.StopServiceMetrics()
 }
@@ -484,17 +503,20 @@ func MustGetKeybase() kb.Keybase {
 	return keys
 }
 
-// get the global keybase
+// get the global keybase, holding at least one key able to sign
 func GetKeybase() (kb.Keybase, error) {
 	keys := kb.New(GlobalConfig.PocketConfig.KeybaseName, GlobalConfig.PocketConfig.DataDir)
 	kps, err := keys.List()
 	if err != nil {
 		return nil, err
 	}
-	if len(kps) == 0 {
-		return nil, UninitializedKeybaseError
+	// watch-only keys can't sign, at least one key pair must hold its private key
+	for _, kp := range kps {
+		if !kp.IsWatchOnly() {
+			return keys, nil
+		}
 	}
-	return keys, nil
+	return nil, UninitializedKeybaseError
 }
 
 func loadPKFromFile(path string) (privval.FilePVKey, string) {
@@ -689,6 +711,11 @@ func HotReloadChains(chains *types.HostedBlockchains) {
 				}
 				m[chain.ID] = chain
 			}
+			// keep serving with the current chains until the file is fixed
+			if err := validateHostedChainsConfig(m); err != nil {
+				log2.Println(fmt.Sprintf("not reloading %s: %s", GlobalConfig.PocketConfig.ChainsName, err.Error()))
+				continue
+			}
 			chains.L.Lock()
 			chains.M = m
 			chains.L.Unlock()
@@ -696,6 +723,16 @@ func HotReloadChains(chains *types.HostedBlockchains) {
 	}()
 }
 
+// validateHostedChainsConfig validates the optional settings of every hosted chain
+func validateHostedChainsConfig(m map[string]types.HostedBlockchain) error {
+	for _, chain := range m {
+		if err := chain.ValidateConfig(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // get the hosted chains variable
 func NewHostedChains(generate bool) *types.HostedBlockchains {
 	// create the chains path
@@ -739,6 +776,9 @@ func NewHostedChains(generate bool) *types.HostedBlockchains {
 		}
 		m[chain.ID] = chain
 	}
+	if err := validateHostedChainsConfig(m); err != nil {
+		log2.Fatal(NewInvalidChainsError(err))
+	}
 	// return the map
 	return &types.HostedBlockchains{
 		M: m,
@@ -777,6 +817,9 @@ func generateChainsJson(chainsPath string) *types.HostedBlockchains {
 		}
 		m[chain.ID] = chain
 	}
+	if err := validateHostedChainsConfig(m); err != nil {
+		log2.Fatal(NewInvalidChainsError(err))
+	}
 	// return the map
 	return &types.HostedBlockchains{M: m, L: sync.RWMutex{}}
 }
@@ -1089,38 +1132,75 @@ func InitAuthToken(generateToken bool) {
 		//new: if config is set to false use existing auth.json and do not generate
 		//User should make sure file exist, else execution will end with error ("cannot open/create auth token json file:"...)
 		t := GetAuthTokenFromFile()
-		AuthToken = t
+		setAuthToken(t)
 	}
 }
 
 func GenerateToken() {
-	var t = sdk.AuthToken{
+	t := newAuthToken()
+	if err := writeAuthToken(t); err != nil {
+		log2.Fatalf(err.Error())
+	}
+	setAuthToken(t)
+}
+
+// RotateAuthToken replaces the auth token of the secured rpc calls without a restart, the previous token stops working
+func RotateAuthToken() (sdk.AuthToken, error) {
+	t := newAuthToken()
+	if err := writeAuthToken(t); err != nil {
+		return sdk.AuthToken{}, err
+	}
+	setAuthToken(t)
+	return t, nil
+}
+
+// GetAuthToken returns the current auth token of the secured rpc calls
+func GetAuthToken() sdk.AuthToken {
+	authTokenL.RLock()
+	defer authTokenL.RUnlock()
+	return AuthToken
+}
+
+// ValidAuthToken compares the value with the current auth token in constant time
+func ValidAuthToken(value string) bool {
+	t := GetAuthToken()
+	if t.Value == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(value), []byte(t.Value)) == 1
+}
+
+func setAuthToken(t sdk.AuthToken) {
+	authTokenL.Lock()
+	defer authTokenL.Unlock()
+	AuthToken = t
+}
+
+func newAuthToken() sdk.AuthToken {
+	return sdk.AuthToken{
 		Value:  rand.Str(25),
 		Issued: time.Now(),
 	}
+}
+
+// writeAuthToken saves the token in auth.json, read by the cli for the secured rpc calls
+func writeAuthToken(t sdk.AuthToken) error {
 	datadir := GlobalConfig.PocketConfig.DataDir
 	configFilepath := datadir + FS + sdk.ConfigDirName + FS + sdk.AuthFileName
-
-	var jsonFile *os.File
-	defer jsonFile.Close()
-
-	jsonFile, err := os.OpenFile(configFilepath, os.O_RDWR|os.O_CREATE, os.ModePerm)
+	b, err := json.MarshalIndent(t, "", "    ")
 	if err != nil {
-		log2.Fatalf("cannot open/create auth token json file: " + err.Error())
+		return fmt.Errorf("cannot marshal auth token into json: %s", err.Error())
 	}
-	err = jsonFile.Truncate(0)
-
-	b, err := json.MarshalIndent(t, "", "    ")
+	jsonFile, err := os.OpenFile(configFilepath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
 	if err != nil {
-		log2.Fatalf("cannot marshal auth token into json: " + err.Error())
+		return fmt.Errorf("cannot open/create auth token json file: %s", err.Error())
 	}
+	defer jsonFile.Close()
 	// write to the file
-	_, err = jsonFile.Write(b)
-	if err != nil {
-		log2.Fatalf("cannot write auth token to json file: " + err.Error())
+	if _, err = jsonFile.Write(b); err != nil {
+		return fmt.Errorf("cannot write auth token to json file: %s", err.Error())
 	}
-
-	AuthToken = t
+	return nil
 }
 
 func GetAuthTokenFromFile() sdk.AuthToken {
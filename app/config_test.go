@@ -1,6 +1,8 @@
 package app
 
 import (
+	"github.com/pokt-network/pocket-core/crypto"
+	kb "github.com/pokt-network/pocket-core/crypto/keys"
 	"github.com/pokt-network/pocket-core/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -13,3 +15,23 @@ func TestDefaultConfig(t *testing.T) {
 	assert.EqualValues(t, types.DefaultTxIndexer, c.TendermintConfig.TxIndex.Indexer)
 	assert.EqualValues(t, types.DefaultTxIndexTags, c.TendermintConfig.TxIndex.IndexKeys)
 }
+
+func TestGetKeybase_WatchOnly(t *testing.T) {
+	pocketConfig := GlobalConfig.PocketConfig
+	defer func() { GlobalConfig.PocketConfig = pocketConfig }()
+	GlobalConfig.PocketConfig.DataDir = t.TempDir()
+	GlobalConfig.PocketConfig.KeybaseName = "watch-only-keybase"
+	keys := kb.New(GlobalConfig.PocketConfig.KeybaseName, GlobalConfig.PocketConfig.DataDir)
+	_, err := GetKeybase()
+	assert.Equal(t, UninitializedKeybaseError, err)
+	// a watch-only key can't sign
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	_, err = keys.AddWatchOnly(types.Address(pk.Address()), pk, "output")
+	assert.Nil(t, err)
+	_, err = GetKeybase()
+	assert.Equal(t, UninitializedKeybaseError, err)
+	_, err = keys.Create("test")
+	assert.Nil(t, err)
+	_, err = GetKeybase()
+	assert.Nil(t, err)
+}
@@ -0,0 +1,324 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/privval"
+)
+
+const (
+	DefaultDevnetChainID          = "pocket-devnet"
+	DefaultDevnetBlocksPerSession = 4
+	DefaultDevnetBasePort         = 27000
+	DefaultDevnetMockPort         = 28000
+	DevnetManifestName            = "devnet.json"
+	devnetPortsPerNode            = 10
+	devnetMaxMockBodyBytes        = 1 << 20
+)
+
+// DevnetOptions describes the local network generated by GenerateDevnet
+type DevnetOptions struct {
+	Dir              string
+	Nodes            int
+	Apps             int
+	Chains           []string
+	BlocksPerSession int64
+	BasePort         int // the ports of node i start at BasePort + 10*i
+	MockPort         int // the mock of chain j listens on MockPort + j
+}
+
+// Devnet is the manifest of a generated local network, written to devnet.json in its directory
+type Devnet struct {
+	ChainID string        `json:"chain_id"`
+	Nodes   []DevnetNode  `json:"nodes"`
+	Apps    []DevnetApp   `json:"apps"`
+	Chains  []DevnetChain `json:"chains"`
+}
+
+// DevnetNode is a validator of the devnet, with its own datadir
+type DevnetNode struct {
+	DataDir string `json:"datadir"`
+	Address string `json:"address"`
+	NodeID  string `json:"node_id"`
+	P2PAddr string `json:"p2p_addr"`
+	RPCURL  string `json:"rpc_url"`
+}
+
+// DevnetApp is a staked application of the devnet, its key can be imported or used by a gateway
+type DevnetApp struct {
+	Address    string `json:"address"`
+	PublicKey  string `json:"public_key"`
+	PrivateKey string `json:"priv_key"`
+}
+
+// DevnetChain is a relay chain of the devnet, served by a mock blockchain
+type DevnetChain struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+// GenerateDevnet writes the genesis, keys, configs and chains of a local network of staked validators and
+// applications, each node in <dir>/node<i>, the nodes relaying to the mock blockchains served by ServeDevnetMocks
+func GenerateDevnet(opts DevnetOptions) (Devnet, error) {
+	if opts.Nodes < 1 {
+		return Devnet{}, fmt.Errorf("a devnet needs at least 1 node")
+	}
+	if opts.Apps < 0 {
+		return Devnet{}, fmt.Errorf("invalid number of apps: %d", opts.Apps)
+	}
+	if len(opts.Chains) == 0 {
+		return Devnet{}, fmt.Errorf("a devnet needs at least 1 chain")
+	}
+	for _, chain := range opts.Chains {
+		if err := types.NetworkIdentifierVerification(chain); err != nil {
+			return Devnet{}, fmt.Errorf("invalid chain %s: %s", chain, err.Error())
+		}
+	}
+	if opts.BlocksPerSession <= 0 {
+		opts.BlocksPerSession = DefaultDevnetBlocksPerSession
+	}
+	dir, err := filepath.Abs(opts.Dir)
+	if err != nil {
+		return Devnet{}, err
+	}
+	if _, err := os.Stat(filepath.Join(dir, DevnetManifestName)); err == nil {
+		return Devnet{}, fmt.Errorf("a devnet already exists in %s", dir)
+	}
+	devnet := Devnet{ChainID: DefaultDevnetChainID}
+	hostedChains := make([]types.HostedBlockchain, len(opts.Chains))
+	for j, chain := range opts.Chains {
+		mockURL := "http://127.0.0.1:" + strconv.Itoa(opts.MockPort+j)
+		devnet.Chains = append(devnet.Chains, DevnetChain{ID: chain, URL: mockURL})
+		hostedChains[j] = types.HostedBlockchain{ID: chain, URL: mockURL}
+	}
+	// the node keys are both the validator and the p2p keys, as in InitKeyfiles
+	nodeKeys := make([]crypto.PrivateKey, opts.Nodes)
+	validators := make([]genesisValidator, opts.Nodes)
+	for i := range nodeKeys {
+		nodeKeys[i] = crypto.GenerateEd25519PrivKey()
+		port := opts.BasePort + devnetPortsPerNode*i
+		nodeKey := p2p.NodeKey{PrivKey: nodeKeys[i].PrivKey()}
+		node := DevnetNode{
+			DataDir: filepath.Join(dir, "node"+strconv.Itoa(i)),
+			Address: sdk.Address(nodeKeys[i].PublicKey().Address()).String(),
+			NodeID:  string(nodeKey.ID()),
+			P2PAddr: "127.0.0.1:" + strconv.Itoa(port),
+			RPCURL:  "http://127.0.0.1:" + strconv.Itoa(port+2),
+		}
+		devnet.Nodes = append(devnet.Nodes, node)
+		validators[i] = genesisValidator{PublicKey: nodeKeys[i].PublicKey(), ServiceURL: node.RPCURL}
+	}
+	appKeys := make([]crypto.PublicKey, opts.Apps)
+	for i := range appKeys {
+		pk := crypto.GenerateEd25519PrivKey()
+		appKeys[i] = pk.PublicKey()
+		devnet.Apps = append(devnet.Apps, DevnetApp{
+			Address:    sdk.Address(pk.PublicKey().Address()).String(),
+			PublicKey:  pk.PublicKey().RawString(),
+			PrivateKey: pk.RawString(),
+		})
+	}
+	sessionNodeCount := types.DefaultSessionNodeCount
+	if int64(opts.Nodes) < sessionNodeCount {
+		sessionNodeCount = int64(opts.Nodes)
+	}
+	genesis := newGenesisState(genesisSpec{
+		ChainID:          devnet.ChainID,
+		Owner:            nodeKeys[0].PublicKey(),
+		Validators:       validators,
+		Applications:     appKeys,
+		Chains:           opts.Chains,
+		Balance:          sdk.NewInt(100000000000),
+		SessionNodeCount: sessionNodeCount,
+		BlocksPerSession: opts.BlocksPerSession,
+	})
+	for i, node := range devnet.Nodes {
+		var peers []string
+		for k, peer := range devnet.Nodes {
+			if k != i {
+				peers = append(peers, peer.NodeID+"@"+peer.P2PAddr)
+			}
+		}
+		var gatewayKey string
+		if opts.Apps > 0 {
+			gatewayKey = devnet.Apps[i%opts.Apps].PrivateKey
+		}
+		err := writeDevnetNode(node, nodeKeys[i], opts.BasePort+devnetPortsPerNode*i, strings.Join(peers, ","), genesis, hostedChains, gatewayKey)
+		if err != nil {
+			return Devnet{}, err
+		}
+	}
+	return devnet, writeDevnetFile(filepath.Join(dir, DevnetManifestName), devnet)
+}
+
+// writeDevnetNode writes the datadir of a devnet node
+func writeDevnetNode(node DevnetNode, key crypto.PrivateKey, port int, peers string, genesis []byte, chains []types.HostedBlockchain, gatewayKey string) error {
+	configDir := filepath.Join(node.DataDir, sdk.ConfigDirName)
+	if err := os.MkdirAll(configDir, os.ModePerm); err != nil {
+		return err
+	}
+	c := sdk.DefaultConfig(node.DataDir)
+	c.TendermintConfig.Moniker = filepath.Base(node.DataDir)
+	c.TendermintConfig.P2P.ListenAddress = sdk.DefaultListenAddr + strconv.Itoa(port)
+	c.TendermintConfig.P2P.PersistentPeers = peers
+	c.TendermintConfig.RPC.ListenAddress = sdk.DefaultListenAddr + strconv.Itoa(port+1)
+	c.TendermintConfig.Instrumentation.PrometheusListenAddr = ":" + strconv.Itoa(port+6)
+	c.PocketConfig.TendermintURI = "tcp://localhost:" + strconv.Itoa(port+1)
+	c.PocketConfig.RPCPort = strconv.Itoa(port + 2)
+	c.PocketConfig.RemoteCLIURL = "http://localhost:" + strconv.Itoa(port+2)
+	c.PocketConfig.GatewayPort = strconv.Itoa(port + 3)
+	c.PocketConfig.GRPCPort = strconv.Itoa(port + 4)
+	c.PocketConfig.PrometheusAddr = strconv.Itoa(port + 5)
+	// seconds long blocks, so the sessions, claims and proofs go by in minutes
+	cc := c.TendermintConfig.Consensus
+	cc.TimeoutPropose = 3 * time.Second
+	cc.TimeoutProposeDelta = 500 * time.Millisecond
+	cc.TimeoutPrevote = time.Second
+	cc.TimeoutPrevoteDelta = 500 * time.Millisecond
+	cc.TimeoutPrecommit = time.Second
+	cc.TimeoutPrecommitDelta = 500 * time.Millisecond
+	cc.TimeoutCommit = 5 * time.Second
+	cc.CreateEmptyBlocksInterval = 0
+	cc.PeerGossipSleepDuration = 100 * time.Millisecond
+	cc.PeerQueryMaj23SleepDuration = 2 * time.Second
+	bz, err := json.MarshalIndent(c, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(configDir, sdk.ConfigFileName), bz, 0600); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(configDir, c.PocketConfig.GenesisName), genesis, 0600); err != nil {
+		return err
+	}
+	if err := writeDevnetFile(filepath.Join(configDir, c.PocketConfig.ChainsName), chains); err != nil {
+		return err
+	}
+	// the tendermint key files, amino encoded
+	files := map[string]interface{}{
+		c.TendermintConfig.PrivValidatorKey: privval.FilePVKey{
+			Address: key.PubKey().Address(),
+			PubKey:  key.PubKey(),
+			PrivKey: key.PrivKey(),
+		},
+		c.TendermintConfig.PrivValidatorState: privval.FilePVLastSignState{},
+		c.TendermintConfig.NodeKey:            p2p.NodeKey{PrivKey: key.PrivKey()},
+	}
+	for name, file := range files {
+		bz, err := Codec().MarshalJSONIndent(file, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(node.DataDir, name), bz, 0600); err != nil {
+			return err
+		}
+	}
+	if gatewayKey == "" {
+		return nil
+	}
+	return writeDevnetFile(c.PocketConfig.GetGatewayKeyFilePath(), map[string]string{"priv_key": gatewayKey})
+}
+
+func writeDevnetFile(path string, v interface{}) error {
+	bz, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, 0600)
+}
+
+// ReadDevnet reads the manifest of the devnet generated in dir
+func ReadDevnet(dir string) (Devnet, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, DevnetManifestName))
+	if err != nil {
+		return Devnet{}, err
+	}
+	var devnet Devnet
+	if err := json.Unmarshal(bz, &devnet); err != nil {
+		return Devnet{}, fmt.Errorf("an error occurred unmarshalling the devnet manifest: %s", err.Error())
+	}
+	return devnet, nil
+}
+
+// ServeDevnetMocks serves a mock blockchain for every chain of the devnet, until the servers are closed
+func ServeDevnetMocks(devnet Devnet) ([]*http.Server, error) {
+	var servers []*http.Server
+	for _, chain := range devnet.Chains {
+		u, err := url.Parse(chain.URL)
+		if err != nil {
+			return servers, err
+		}
+		l, err := net.Listen("tcp", u.Host)
+		if err != nil {
+			return servers, fmt.Errorf("cannot serve the mock of chain %s: %s", chain.ID, err.Error())
+		}
+		srv := &http.Server{Handler: NewMockChainHandler(chain.ID)}
+		go func() { _ = srv.Serve(l) }()
+		servers = append(servers, srv)
+	}
+	return servers, nil
+}
+
+// mockRPCRequest is a json rpc request to the mock blockchain
+type mockRPCRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// mockRPCResponse is the json rpc response of the mock blockchain
+type mockRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  string          `json:"result"`
+}
+
+// NewMockChainHandler returns a mock blockchain answering every request with a result derived from the chain and
+// the request only, so the servicers of a session return the same responses
+func NewMockChainHandler(chainID string) http.Handler {
+	result := func(parts ...string) string {
+		h := sha256.Sum256([]byte(chainID + "/" + strings.Join(parts, "/")))
+		return "0x" + hex.EncodeToString(h[:8])
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, devnetMaxMockBodyBytes))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		var request mockRPCRequest
+		var batch []mockRPCRequest
+		var res interface{}
+		switch {
+		case json.Unmarshal(body, &request) == nil && request.Method != "":
+			res = mockRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: result(request.Method, string(request.Params))}
+		case json.Unmarshal(body, &batch) == nil && len(batch) != 0:
+			responses := make([]mockRPCResponse, len(batch))
+			for i, request := range batch {
+				responses[i] = mockRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: result(request.Method, string(request.Params))}
+			}
+			res = responses
+		default:
+			// rest chains are answered by method, path and body
+			res = map[string]string{"result": result(r.Method, r.URL.Path, string(body))}
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/privval"
+)
+
+func TestGenerateDevnet(t *testing.T) {
+	MakeCodec()
+	dir := t.TempDir()
+	opts := DevnetOptions{Dir: dir, Nodes: 3, Apps: 2, Chains: []string{"0001", "0021"}, BasePort: 27000, MockPort: 28000}
+	devnet, err := GenerateDevnet(opts)
+	require.Nil(t, err)
+	require.Len(t, devnet.Nodes, 3)
+	require.Len(t, devnet.Apps, 2)
+	assert.Equal(t, []DevnetChain{{ID: "0001", URL: "http://127.0.0.1:28000"}, {ID: "0021", URL: "http://127.0.0.1:28001"}}, devnet.Chains)
+	read, err := ReadDevnet(dir)
+	require.Nil(t, err)
+	assert.Equal(t, devnet, read)
+	// not overwritten
+	_, err = GenerateDevnet(opts)
+	assert.NotNil(t, err)
+	for i, node := range devnet.Nodes {
+		bz, err := os.ReadFile(filepath.Join(node.DataDir, sdk.ConfigDirName, sdk.ConfigFileName))
+		require.Nil(t, err)
+		var c sdk.Config
+		require.Nil(t, json.Unmarshal(bz, &c))
+		assert.Equal(t, node.DataDir, c.PocketConfig.DataDir)
+		assert.Equal(t, strings.TrimPrefix(node.RPCURL, "http://127.0.0.1:"), c.PocketConfig.RPCPort)
+		peers := strings.Split(c.TendermintConfig.P2P.PersistentPeers, ",")
+		assert.Len(t, peers, 2)
+		assert.NotContains(t, peers, node.NodeID+"@"+node.P2PAddr)
+		// the validator key is the key of the node address
+		bz, err = os.ReadFile(filepath.Join(node.DataDir, c.TendermintConfig.PrivValidatorKey))
+		require.Nil(t, err)
+		var pvKey privval.FilePVKey
+		require.Nil(t, Codec().UnmarshalJSON(bz, &pvKey))
+		assert.Equal(t, node.Address, strings.ToLower(pvKey.Address.String()))
+		// the chains point to the mocks
+		bz, err = os.ReadFile(filepath.Join(node.DataDir, sdk.ConfigDirName, c.PocketConfig.ChainsName))
+		require.Nil(t, err)
+		var chains []types.HostedBlockchain
+		require.Nil(t, json.Unmarshal(bz, &chains))
+		assert.Len(t, chains, 2)
+		assert.Equal(t, devnet.Chains[1].URL, chains[1].URL)
+		// the gateway relays for the apps in turn
+		bz, err = os.ReadFile(c.PocketConfig.GetGatewayKeyFilePath())
+		require.Nil(t, err)
+		assert.Contains(t, string(bz), devnet.Apps[i%2].PrivateKey)
+	}
+	// every node shares the genesis of the staked validators and apps
+	genesis := GenesisStateFromFile(Codec(), filepath.Join(devnet.Nodes[0].DataDir, sdk.ConfigDirName, sdk.DefaultGenesisName))
+	var posGenesis nodesTypes.GenesisState
+	require.Nil(t, Codec().UnmarshalJSON(genesis[nodesTypes.ModuleName], &posGenesis))
+	assert.Len(t, posGenesis.Validators, 3)
+	assert.Equal(t, int64(DefaultDevnetBlocksPerSession), posGenesis.Params.SessionBlockFrequency)
+	assert.Equal(t, []string{"0001", "0021"}, posGenesis.Validators[0].Chains)
+	assert.Equal(t, devnet.Nodes[0].RPCURL, posGenesis.Validators[0].ServiceURL)
+	var pocketGenesis types.GenesisState
+	require.Nil(t, Codec().UnmarshalJSON(genesis[types.ModuleName], &pocketGenesis))
+	assert.Equal(t, int64(3), pocketGenesis.Params.SessionNodeCount)
+}
+
+func TestMockChainHandler(t *testing.T) {
+	srv := httptest.NewServer(NewMockChainHandler("0021"))
+	defer srv.Close()
+	other := httptest.NewServer(NewMockChainHandler("0001"))
+	defer other.Close()
+	post := func(url, body string) string {
+		res, err := srv.Client().Post(url, "application/json", strings.NewReader(body))
+		require.Nil(t, err)
+		defer res.Body.Close()
+		var v interface{}
+		require.Nil(t, json.NewDecoder(res.Body).Decode(&v))
+		bz, _ := json.Marshal(v)
+		return string(bz)
+	}
+	req := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`
+	res := post(srv.URL, req)
+	assert.Contains(t, res, `"id":1`)
+	assert.Contains(t, res, `"result":"0x`)
+	// deterministic by chain and request
+	assert.Equal(t, res, post(srv.URL, req))
+	assert.NotEqual(t, res, post(other.URL, req))
+	assert.NotEqual(t, res, post(srv.URL, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`))
+	// batches and rest requests
+	batch := post(srv.URL, "["+req+","+strings.Replace(req, `"id":1`, `"id":2`, 1)+"]")
+	assert.Equal(t, 2, strings.Count(batch, `"result"`))
+	assert.Contains(t, post(srv.URL+"/v1/height", `{}`), `"result":"0x`)
+}
@@ -0,0 +1,240 @@
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
+	"github.com/tendermint/tendermint/rpc/client/local"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	StreamEventBlock  = "block"
+	StreamEventTx     = "tx"
+	StreamEventModule = "event"
+
+	// the phase of the block a module event was emitted in
+	EventPhaseBeginBlock = "begin_block"
+	EventPhaseDeliverTx  = "deliver_tx"
+	EventPhaseEndBlock   = "end_block"
+
+	// fallback for a missed new block notification from the event bus
+	streamPollInterval = 5 * time.Second
+	// MaxStreamReplayHeights bounds how far behind the latest height a stream can start, as every height replayed
+	// loads its block and block results
+	MaxStreamReplayHeights = 1000
+)
+
+var streamSubscriberCount uint64
+
+// EventFilter selects which stream events are sent to a subscriber, empty fields match everything.
+// The signer, recipient and message type select transactions: when any is set, only the tx events and the module
+// events of the matching transactions are sent, as blocks and begin/end block events have no transaction
+type EventFilter struct {
+	Types       []string `json:"types"`        // any of block, tx, event
+	Signer      string   `json:"signer"`       // hex address of the transaction signer
+	Recipient   string   `json:"recipient"`    // hex address of the transaction recipient
+	MessageType string   `json:"message_type"` // the transaction message type e.g. send, stake_validator, claim
+	EventTypes  []string `json:"event_types"`  // the module event types e.g. claim, proof, stake, jail, slash, param_change, upgrade
+}
+
+// StreamEvent is a single item of the event stream
+type StreamEvent struct {
+	Type   string           `json:"type"`
+	Height int64            `json:"height"`
+	Block  *BlockEventData  `json:"block,omitempty"`
+	Tx     *TxEventData     `json:"tx,omitempty"`
+	Event  *ModuleEventData `json:"event,omitempty"`
+}
+
+type BlockEventData struct {
+	Hash            string    `json:"hash"`
+	Time            time.Time `json:"time"`
+	ProposerAddress string    `json:"proposer_address"`
+	NumTxs          int       `json:"num_txs"`
+}
+
+type TxEventData struct {
+	Hash        string `json:"hash"`
+	Index       uint32 `json:"index"`
+	Signer      string `json:"signer"`
+	Recipient   string `json:"recipient"`
+	MessageType string `json:"message_type"`
+	Code        uint32 `json:"code"`
+	Codespace   string `json:"codespace"`
+}
+
+type ModuleEventData struct {
+	Phase  string          `json:"phase"`
+	TxHash string          `json:"tx_hash,omitempty"`
+	Event  sdk.StringEvent `json:"event"`
+}
+
+func (f EventFilter) matchType(t string) bool {
+	return len(f.Types) == 0 || containsFold(f.Types, t)
+}
+
+// txOnly is true when the filter selects transactions
+func (f EventFilter) txOnly() bool {
+	return f.Signer != "" || f.Recipient != "" || f.MessageType != ""
+}
+
+func (f EventFilter) matchTx(tx *TxEventData) bool {
+	return (f.Signer == "" || strings.EqualFold(f.Signer, tx.Signer)) &&
+		(f.Recipient == "" || strings.EqualFold(f.Recipient, tx.Recipient)) &&
+		(f.MessageType == "" || f.MessageType == tx.MessageType)
+}
+
+func (f EventFilter) matchEvent(e abci.Event) bool {
+	return len(f.EventTypes) == 0 || containsFold(f.EventTypes, e.Type)
+}
+
+func containsFold(s []string, v string) bool {
+	for _, item := range s {
+		if strings.EqualFold(item, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// StreamEvents sends every event matching the filter to out, starting at fromHeight (zero for the next block),
+// until the context is cancelled. New blocks are picked up from the tendermint event bus and replayed from
+// the block results, so a reconnecting client resumes without gaps by passing the last height it processed + 1
+func (app PocketCoreApp) StreamEvents(ctx context.Context, filter EventFilter, fromHeight int64, out chan<- StreamEvent) error {
+	latest, err := app.QueryHeight()
+	if err != nil {
+		return err
+	}
+	if fromHeight, err = StreamFromHeight(fromHeight, latest); err != nil {
+		return err
+	}
+	// the local client only subscribes while started, so the node event bus is used directly
+	var newBlocks <-chan tmpubsub.Message
+	if localClient, ok := app.GetClient().(*local.Local); ok && localClient.EventBus != nil {
+		subscriber := fmt.Sprintf("pocket-stream-%d", atomic.AddUint64(&streamSubscriberCount, 1))
+		sub, err := localClient.EventBus.Subscribe(ctx, subscriber, tmtypes.EventQueryNewBlock, 10)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = localClient.EventBus.Unsubscribe(context.Background(), subscriber, tmtypes.EventQueryNewBlock)
+		}()
+		newBlocks = sub.Out()
+	}
+	ticker := time.NewTicker(streamPollInterval)
+	defer ticker.Stop()
+	next := fromHeight
+	for {
+		// catch up to the latest committed height
+		for ; next <= latest; next++ {
+			if err := app.streamHeight(ctx, next, filter, out); err != nil {
+				return err
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-newBlocks:
+		case <-ticker.C:
+		}
+		if latest, err = app.QueryHeight(); err != nil {
+			return err
+		}
+	}
+}
+
+// StreamFromHeight returns the first height streamed (the next block when fromHeight is zero), rejecting a height
+// after the next block or more than MaxStreamReplayHeights behind the latest height
+func StreamFromHeight(fromHeight, latest int64) (int64, error) {
+	if fromHeight <= 0 {
+		return latest + 1, nil
+	}
+	if fromHeight > latest+1 {
+		return 0, fmt.Errorf("cannot stream from height %d, the latest height is %d", fromHeight, latest)
+	}
+	if fromHeight < latest+1-MaxStreamReplayHeights {
+		return 0, fmt.Errorf("cannot stream from height %d, at most %d heights behind the latest height %d are replayed", fromHeight, MaxStreamReplayHeights, latest)
+	}
+	return fromHeight, nil
+}
+
+// streamHeight sends the block, transaction and module events of a single height
+func (app PocketCoreApp) streamHeight(ctx context.Context, height int64, filter EventFilter, out chan<- StreamEvent) error {
+	tmClient := app.GetClient()
+	block, err := tmClient.Block(&height)
+	if err != nil {
+		return err
+	}
+	results, err := tmClient.BlockResults(&height)
+	if err != nil {
+		return err
+	}
+	var events []StreamEvent
+	if filter.matchType(StreamEventBlock) && !filter.txOnly() {
+		events = append(events, StreamEvent{Type: StreamEventBlock, Height: height, Block: &BlockEventData{
+			Hash:            block.BlockID.Hash.String(),
+			Time:            block.Block.Time,
+			ProposerAddress: block.Block.ProposerAddress.String(),
+			NumTxs:          len(block.Block.Txs),
+		}})
+	}
+	events = append(events, moduleEvents(height, EventPhaseBeginBlock, "", results.BeginBlockEvents, filter)...)
+	for i, res := range results.TxsResults {
+		if i >= len(block.Block.Txs) {
+			break
+		}
+		tx := &TxEventData{
+			Hash:        hex.EncodeToString(block.Block.Txs[i].Hash()),
+			Index:       uint32(i),
+			Signer:      hex.EncodeToString(res.Signer),
+			Recipient:   hex.EncodeToString(res.Recipient),
+			MessageType: res.MessageType,
+			Code:        res.Code,
+			Codespace:   res.Codespace,
+		}
+		if !filter.matchTx(tx) {
+			continue
+		}
+		if filter.matchType(StreamEventTx) {
+			events = append(events, StreamEvent{Type: StreamEventTx, Height: height, Tx: tx})
+		}
+		events = append(events, moduleEvents(height, EventPhaseDeliverTx, tx.Hash, res.Events, filter)...)
+	}
+	events = append(events, moduleEvents(height, EventPhaseEndBlock, "", results.EndBlockEvents, filter)...)
+	for _, e := range events {
+		select {
+		case out <- e:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	return nil
+}
+
+func moduleEvents(height int64, phase, txHash string, events []abci.Event, filter EventFilter) (res []StreamEvent) {
+	if !filter.matchType(StreamEventModule) || (txHash == "" && filter.txOnly()) {
+		return nil
+	}
+	for _, e := range events {
+		if e.Type == sdk.EventTypeMessage || !filter.matchEvent(e) {
+			continue // the generic message events duplicate the tx data
+		}
+		res = append(res, StreamEvent{Type: StreamEventModule, Height: height, Event: &ModuleEventData{
+			Phase:  phase,
+			TxHash: txHash,
+			Event:  sdk.StringifyEvent(e),
+		}})
+	}
+	return
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestStreamFromHeight(t *testing.T) {
+	from, err := StreamFromHeight(0, 5000)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5001), from)
+	from, err = StreamFromHeight(5001-MaxStreamReplayHeights, 5000)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5001-MaxStreamReplayHeights), from)
+	_, err = StreamFromHeight(5000-MaxStreamReplayHeights, 5000)
+	assert.NotNil(t, err)
+	_, err = StreamFromHeight(1, 5000)
+	assert.NotNil(t, err)
+	_, err = StreamFromHeight(5002, 5000)
+	assert.NotNil(t, err)
+}
+
+func TestModuleEvents_TxFilter(t *testing.T) {
+	events := []abci.Event{{Type: "claim"}}
+	assert.Len(t, moduleEvents(1, EventPhaseEndBlock, "", events, EventFilter{}), 1)
+	// the begin/end block events have no transaction to select
+	filter := EventFilter{Signer: "abcd"}
+	assert.Empty(t, moduleEvents(1, EventPhaseEndBlock, "", events, filter))
+	assert.Len(t, moduleEvents(1, EventPhaseDeliverTx, "hash", events, filter), 1)
+}
@@ -15426,7 +15426,38 @@ func newDefaultGenesisState() []byte {
 	if err != nil {
 		log.Fatal(err)
 	}
-	pubKey := cb.PublicKey
+	// the coinbase is the only account, validator and application
+	return newGenesisState(genesisSpec{
+		ChainID:          "pocket-test",
+		Owner:            cb.PublicKey,
+		Validators:       []genesisValidator{{PublicKey: cb.PublicKey, ServiceURL: sdk.PlaceholderServiceURL}},
+		Applications:     []crypto.PublicKey{cb.PublicKey},
+		Chains:           []string{sdk.PlaceholderHash},
+		Balance:          sdk.NewInt(1000000),
+		SessionNodeCount: 1,
+	})
+}
+
+// genesisSpec describes the accounts, stakes and params of a generated genesis
+type genesisSpec struct {
+	ChainID          string
+	Owner            crypto.PublicKey // the dao owner and the owner of every param
+	Validators       []genesisValidator
+	Applications     []crypto.PublicKey
+	Chains           []string   // the chains staked for and supported
+	Balance          sdk.BigInt // the coins of the account of every validator and application
+	SessionNodeCount int64
+	BlocksPerSession int64 // zero keeps the default
+}
+
+// genesisValidator is a validator staked in the generated genesis
+type genesisValidator struct {
+	PublicKey  crypto.PublicKey
+	ServiceURL string
+}
+
+// newGenesisState builds the genesis file of the spec over the default genesis of the modules
+func newGenesisState(spec genesisSpec) []byte {
 	defaultGenesis := module.NewBasicManager(
 		apps.AppModuleBasic{},
 		auth.AppModuleBasic{},
@@ -15434,60 +15465,81 @@ func newDefaultGenesisState() []byte {
 		nodes.AppModuleBasic{},
 		pocket.AppModuleBasic{},
 	).DefaultGenesis()
-	// setup account genesis
+	// setup account genesis, one account per key
 	rawAuth := defaultGenesis[auth.ModuleName]
 	var accountGenesis auth.GenesisState
 	types.ModuleCdc.MustUnmarshalJSON(rawAuth, &accountGenesis)
-	accountGenesis.Accounts = append(accountGenesis.Accounts, &auth.BaseAccount{
-		Address: cb.GetAddress(),
-		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(1000000))),
-		PubKey:  pubKey,
-	})
+	funded := make(map[string]bool)
+	fund := func(pubKey crypto.PublicKey) {
+		if funded[pubKey.RawString()] {
+			return
+		}
+		funded[pubKey.RawString()] = true
+		accountGenesis.Accounts = append(accountGenesis.Accounts, &auth.BaseAccount{
+			Address: sdk.Address(pubKey.Address()),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, spec.Balance)),
+			PubKey:  pubKey,
+		})
+	}
+	for _, v := range spec.Validators {
+		fund(v.PublicKey)
+	}
+	for _, a := range spec.Applications {
+		fund(a)
+	}
 	res := Codec().MustMarshalJSON(accountGenesis)
 	defaultGenesis[auth.ModuleName] = res
-	// set address as application too
+	// setup the applications
 	rawApps := defaultGenesis[appsTypes.ModuleName]
 	var appsGenesis appsTypes.GenesisState
 	types.ModuleCdc.MustUnmarshalJSON(rawApps, &appsGenesis)
-	appsGenesis.Applications = append(appsGenesis.Applications, appsTypes.Application{
-		Address:                 cb.GetAddress(),
-		PublicKey:               cb.PublicKey,
-		Jailed:                  false,
-		Status:                  2,
-		Chains:                  []string{sdk.PlaceholderHash},
-		StakedTokens:            sdk.NewInt(10000000000000),
-		MaxRelays:               sdk.NewInt(10000000000000),
-		UnstakingCompletionTime: time.Time{},
-	})
+	for _, a := range spec.Applications {
+		appsGenesis.Applications = append(appsGenesis.Applications, appsTypes.Application{
+			Address:                 sdk.Address(a.Address()),
+			PublicKey:               a,
+			Jailed:                  false,
+			Status:                  2,
+			Chains:                  spec.Chains,
+			StakedTokens:            sdk.NewInt(10000000000000),
+			MaxRelays:               sdk.NewInt(10000000000000),
+			UnstakingCompletionTime: time.Time{},
+		})
+	}
 	res = Codec().MustMarshalJSON(appsGenesis)
 	defaultGenesis[appsTypes.ModuleName] = res
 	// set default governance in genesis
 	rawPocket := defaultGenesis[types.ModuleName]
 	var pocketGenesis types.GenesisState
 	types.ModuleCdc.MustUnmarshalJSON(rawPocket, &pocketGenesis)
-	pocketGenesis.Params.SessionNodeCount = 1
+	pocketGenesis.Params.SessionNodeCount = spec.SessionNodeCount
+	pocketGenesis.Params.SupportedBlockchains = spec.Chains
 	res = Codec().MustMarshalJSON(pocketGenesis)
 	defaultGenesis[types.ModuleName] = res
 	// setup pos genesis
 	rawPOS := defaultGenesis[nodesTypes.ModuleName]
 	var posGenesisState nodesTypes.GenesisState
 	types.ModuleCdc.MustUnmarshalJSON(rawPOS, &posGenesisState)
-	posGenesisState.Validators = append(posGenesisState.Validators,
-		nodesTypes.Validator{Address: sdk.Address(pubKey.Address()),
-			PublicKey:    pubKey,
-			Status:       sdk.Staked,
-			Chains:       []string{sdk.PlaceholderHash},
-			ServiceURL:   sdk.PlaceholderServiceURL,
-			StakedTokens: sdk.NewInt(10000000)})
+	for _, v := range spec.Validators {
+		posGenesisState.Validators = append(posGenesisState.Validators,
+			nodesTypes.Validator{Address: sdk.Address(v.PublicKey.Address()),
+				PublicKey:    v.PublicKey,
+				Status:       sdk.Staked,
+				Chains:       spec.Chains,
+				ServiceURL:   v.ServiceURL,
+				StakedTokens: sdk.NewInt(10000000)})
+	}
+	if spec.BlocksPerSession > 0 {
+		posGenesisState.Params.SessionBlockFrequency = spec.BlocksPerSession
+	}
 	res = types.ModuleCdc.MustMarshalJSON(posGenesisState)
 	defaultGenesis[nodesTypes.ModuleName] = res
 	// set default governance in genesis
 	var govGenesisState govTypes.GenesisState
 	rawGov := defaultGenesis[govTypes.ModuleName]
 	Codec().MustUnmarshalJSON(rawGov, &govGenesisState)
-	mACL := createDummyACL(pubKey)
+	mACL := createDummyACL(spec.Owner)
 	govGenesisState.Params.ACL = mACL
-	govGenesisState.Params.DAOOwner = sdk.Address(pubKey.Address())
+	govGenesisState.Params.DAOOwner = sdk.Address(spec.Owner.Address())
 	govGenesisState.Params.Upgrade = govTypes.NewUpgrade(0, "0")
 	res4 := Codec().MustMarshalJSON(govGenesisState)
 	defaultGenesis[govTypes.ModuleName] = res4
@@ -15495,7 +15547,7 @@ func newDefaultGenesisState() []byte {
 	j, _ := types.ModuleCdc.MarshalJSONIndent(defaultGenesis, "", "    ")
 	j, _ = types.ModuleCdc.MarshalJSONIndent(tmType.GenesisDoc{
 		GenesisTime: time.Now(),
-		ChainID:     "pocket-test",
+		ChainID:     spec.ChainID,
 		ConsensusParams: &tmType.ConsensusParams{
 			Block: tmType.BlockParams{
 				MaxBytes:   15000,
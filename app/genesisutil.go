@@ -0,0 +1,407 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/types/module"
+	apps "github.com/pokt-network/pocket-core/x/apps"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
+	"github.com/pokt-network/pocket-core/x/gov"
+	"github.com/pokt-network/pocket-core/x/nodes"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocket "github.com/pokt-network/pocket-core/x/pocketcore"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	tmType "github.com/tendermint/tendermint/types"
+)
+
+// GenesisFile is a genesis document along with its app state by module, as edited by the genesis tools
+type GenesisFile struct {
+	Doc   *tmType.GenesisDoc
+	State GenesisState
+}
+
+// ReadGenesisFile reads the genesis document at path
+func ReadGenesisFile(path string) (GenesisFile, error) {
+	doc, err := tmType.GenesisDocFromFile(path)
+	if err != nil {
+		return GenesisFile{}, err
+	}
+	var state GenesisState
+	if err := Codec().UnmarshalJSON(doc.AppState, &state); err != nil {
+		return GenesisFile{}, fmt.Errorf("an error occurred unmarshalling the app state of %s: %s", path, err.Error())
+	}
+	return GenesisFile{Doc: doc, State: state}, nil
+}
+
+// Write validates the genesis and replaces the file at path with it, so an edit never leaves an invalid
+// or half written genesis behind
+func (g GenesisFile) Write(path string) error {
+	if errs := g.Validate(); len(errs) != 0 {
+		return fmt.Errorf("the edited genesis is invalid, %s was not changed: %s", path, errs[0].Error())
+	}
+	appState, err := Codec().MarshalJSONIndent(g.State, "", "    ")
+	if err != nil {
+		return err
+	}
+	doc := *g.Doc
+	doc.AppState = appState
+	bz, err := Codec().MarshalJSONIndent(doc, "", "    ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(bz); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// genesisModules are the modules of the app state
+func genesisModules() module.BasicManager {
+	return module.NewBasicManager(
+		apps.AppModuleBasic{},
+		auth.AppModuleBasic{},
+		gov.AppModuleBasic{},
+		nodes.AppModuleBasic{},
+		pocket.AppModuleBasic{},
+	)
+}
+
+// Validate runs the genesis validation of every module, then checks the modules agree with each other:
+// the accounts are unique, the staked pools hold the staked tokens and the supply is the coins of the accounts.
+// Returns every problem found
+func (g GenesisFile) Validate() (errs []error) {
+	for name, m := range genesisModules() {
+		bz := g.State[name]
+		if name == auth.ModuleName && bz != nil {
+			var err error
+			if bz, err = withoutModuleAccounts(bz); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s", name, err.Error()))
+				continue
+			}
+		}
+		if err := validateModuleGenesis(m, bz); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", name, err.Error()))
+		}
+	}
+	if len(errs) != 0 {
+		// the cross module checks need every module to unmarshal
+		return errs
+	}
+	authGenesis, posGenesis, appsGenesis := g.auth(), g.nodes(), g.apps()
+	coins := sdk.NewCoins()
+	accounts := make(map[string]exported.Account, len(authGenesis.Accounts))
+	for _, acc := range authGenesis.Accounts {
+		if _, found := accounts[acc.GetAddress().String()]; found {
+			errs = append(errs, fmt.Errorf("auth: duplicate account %s", acc.GetAddress()))
+		}
+		accounts[acc.GetAddress().String()] = acc
+		coins = coins.Add(acc.GetCoins())
+	}
+	// the staked pools must hold the staked tokens, if they are in the genesis
+	nodesStaked := sdk.ZeroInt()
+	for _, v := range posGenesis.Validators {
+		if v.IsStaked() {
+			nodesStaked = nodesStaked.Add(v.StakedTokens)
+		}
+	}
+	for _, entry := range posGenesis.UnbondingEntries {
+		nodesStaked = nodesStaked.Add(entry.Amount)
+	}
+	appsStaked := sdk.ZeroInt()
+	for _, a := range appsGenesis.Applications {
+		if a.IsStaked() {
+			appsStaked = appsStaked.Add(a.StakedTokens)
+		}
+	}
+	for _, entry := range appsGenesis.UnbondingEntries {
+		appsStaked = appsStaked.Add(entry.Amount)
+	}
+	denom := posGenesis.Params.StakeDenom
+	for pool, staked := range map[string]sdk.BigInt{nodesTypes.StakedPoolName: nodesStaked, appsTypes.StakedPoolName: appsStaked} {
+		acc, found := accounts[auth.NewModuleAddress(pool).String()]
+		if !found || acc.GetCoins().IsZero() {
+			continue
+		}
+		if !acc.GetCoins().AmountOf(denom).Equal(staked) {
+			errs = append(errs, fmt.Errorf("the %s holds %s%s but %s%s are staked", pool, acc.GetCoins().AmountOf(denom), denom, staked, denom))
+		}
+	}
+	// the supply is computed from the accounts if not provided, compared as strings as IsEqual panics on other denoms
+	if !authGenesis.Supply.Empty() && authGenesis.Supply.Sort().String() != coins.Sort().String() {
+		errs = append(errs, fmt.Errorf("auth: the supply %s is not the %s of the accounts", authGenesis.Supply, coins))
+	}
+	return errs
+}
+
+// withoutModuleAccounts returns the auth genesis without its module accounts: they have no key in an exported genesis,
+// which the auth validation of the accounts refuses
+func withoutModuleAccounts(bz json.RawMessage) (json.RawMessage, error) {
+	var gs auth.GenesisState
+	if err := Codec().UnmarshalJSON(bz, &gs); err != nil {
+		return nil, err
+	}
+	accounts := gs.Accounts[:0]
+	for _, acc := range gs.Accounts {
+		if _, ok := acc.(exported.ModuleAccountI); !ok {
+			accounts = append(accounts, acc)
+		}
+	}
+	gs.Accounts = accounts
+	return Codec().MarshalJSON(gs)
+}
+
+// validateModuleGenesis validates the module genesis, a module panicking on the genesis fails it
+func validateModuleGenesis(m module.AppModuleBasic, bz json.RawMessage) (err error) {
+	if bz == nil {
+		return fmt.Errorf("missing from the app state")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return m.ValidateGenesis(bz)
+}
+
+func (g GenesisFile) auth() (gs auth.GenesisState) {
+	Codec().MustUnmarshalJSON(g.State[auth.ModuleName], &gs)
+	return
+}
+
+func (g GenesisFile) nodes() (gs nodesTypes.GenesisState) {
+	types.ModuleCdc.MustUnmarshalJSON(g.State[nodesTypes.ModuleName], &gs)
+	return
+}
+
+func (g GenesisFile) apps() (gs appsTypes.GenesisState) {
+	types.ModuleCdc.MustUnmarshalJSON(g.State[appsTypes.ModuleName], &gs)
+	return
+}
+
+// AddAccount adds the account of the public key with the coins
+func (g GenesisFile) AddAccount(pubKey crypto.PublicKey, amount sdk.BigInt) error {
+	authGenesis := g.auth()
+	address := sdk.Address(pubKey.Address())
+	for _, acc := range authGenesis.Accounts {
+		if acc.GetAddress().Equals(address) {
+			return fmt.Errorf("the account %s is already in the genesis", address)
+		}
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(g.nodes().Params.StakeDenom, amount))
+	authGenesis.Accounts = append(authGenesis.Accounts, &auth.BaseAccount{Address: address, Coins: coins, PubKey: pubKey})
+	if !authGenesis.Supply.Empty() {
+		authGenesis.Supply = authGenesis.Supply.Add(coins)
+	}
+	g.State[auth.ModuleName] = Codec().MustMarshalJSON(authGenesis)
+	return nil
+}
+
+// AddValidator stakes the validator of the public key with the tokens
+func (g GenesisFile) AddValidator(pubKey crypto.PublicKey, chains []string, serviceURL string, tokens sdk.BigInt, output sdk.Address) error {
+	for _, chain := range chains {
+		if err := types.NetworkIdentifierVerification(chain); err != nil {
+			return err
+		}
+	}
+	posGenesis := g.nodes()
+	address := sdk.Address(pubKey.Address())
+	for _, v := range posGenesis.Validators {
+		if v.Address.Equals(address) {
+			return fmt.Errorf("the validator %s is already in the genesis", address)
+		}
+	}
+	posGenesis.Validators = append(posGenesis.Validators, nodesTypes.NewValidator(address, pubKey, chains, serviceURL, tokens, output))
+	g.State[nodesTypes.ModuleName] = types.ModuleCdc.MustMarshalJSON(posGenesis)
+	g.addStakedTokens(nodesTypes.StakedPoolName, sdk.NewCoin(posGenesis.Params.StakeDenom, tokens))
+	return nil
+}
+
+// AddApp stakes the application of the public key with the tokens
+func (g GenesisFile) AddApp(pubKey crypto.PublicKey, chains []string, tokens sdk.BigInt) error {
+	appsGenesis := g.apps()
+	address := sdk.Address(pubKey.Address())
+	for _, a := range appsGenesis.Applications {
+		if a.Address.Equals(address) {
+			return fmt.Errorf("the application %s is already in the genesis", address)
+		}
+	}
+	// the max relays are computed from the tokens at init
+	appsGenesis.Applications = append(appsGenesis.Applications, appsTypes.NewApplication(address, pubKey, chains, tokens))
+	g.State[appsTypes.ModuleName] = types.ModuleCdc.MustMarshalJSON(appsGenesis)
+	g.addStakedTokens(appsTypes.StakedPoolName, sdk.NewCoin(g.nodes().Params.StakeDenom, tokens))
+	return nil
+}
+
+// addStakedTokens keeps the staked pool and the supply in step with a new stake, if they are in the genesis:
+// otherwise they are computed at init
+func (g GenesisFile) addStakedTokens(pool string, staked sdk.Coin) {
+	authGenesis := g.auth()
+	poolAddress := auth.NewModuleAddress(pool)
+	for _, acc := range authGenesis.Accounts {
+		if !acc.GetAddress().Equals(poolAddress) || acc.GetCoins().IsZero() {
+			continue
+		}
+		_ = acc.SetCoins(acc.GetCoins().Add(sdk.NewCoins(staked)))
+		if !authGenesis.Supply.Empty() {
+			authGenesis.Supply = authGenesis.Supply.Add(sdk.NewCoins(staked))
+		}
+		g.State[auth.ModuleName] = Codec().MustMarshalJSON(authGenesis)
+		return
+	}
+}
+
+// GenesisDifference is a value that differs between two genesis files, at a path of the genesis json
+type GenesisDifference struct {
+	Path  string      `json:"path"`
+	Left  interface{} `json:"left"`  // nil if only in the right genesis
+	Right interface{} `json:"right"` // nil if only in the left genesis
+}
+
+// DiffGenesis compares the genesis files module by module, the document fields under "genesis".
+// The accounts, validators and applications are matched by address rather than by position
+func DiffGenesis(left, right GenesisFile) ([]GenesisDifference, error) {
+	var diffs []GenesisDifference
+	docs := make([]interface{}, 2)
+	for i, g := range []GenesisFile{left, right} {
+		doc := *g.Doc
+		doc.AppState = nil
+		bz, err := Codec().MarshalJSON(doc)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(bz, &docs[i]); err != nil {
+			return nil, err
+		}
+		delete(docs[i].(map[string]interface{}), "app_state")
+	}
+	diffs = diffJSON("genesis", docs[0], docs[1], diffs)
+	modules := make(map[string]bool)
+	for name := range left.State {
+		modules[name] = true
+	}
+	for name := range right.State {
+		modules[name] = true
+	}
+	for _, name := range sortedKeys(modules) {
+		var l, r interface{}
+		if bz, ok := left.State[name]; ok {
+			if err := json.Unmarshal(bz, &l); err != nil {
+				return nil, err
+			}
+		}
+		if bz, ok := right.State[name]; ok {
+			if err := json.Unmarshal(bz, &r); err != nil {
+				return nil, err
+			}
+		}
+		diffs = diffJSON(name, l, r, diffs)
+	}
+	return diffs, nil
+}
+
+func diffJSON(path string, l, r interface{}, diffs []GenesisDifference) []GenesisDifference {
+	if reflect.DeepEqual(l, r) {
+		return diffs
+	}
+	switch lv := l.(type) {
+	case map[string]interface{}:
+		rv, ok := r.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make(map[string]bool)
+		for k := range lv {
+			keys[k] = true
+		}
+		for k := range rv {
+			keys[k] = true
+		}
+		for _, k := range sortedKeys(keys) {
+			diffs = diffJSON(path+"."+k, lv[k], rv[k], diffs)
+		}
+		return diffs
+	case []interface{}:
+		rv, ok := r.([]interface{})
+		if !ok {
+			break
+		}
+		lm, lok := byAddress(lv)
+		rm, rok := byAddress(rv)
+		if lok && rok {
+			keys := make(map[string]bool)
+			for k := range lm {
+				keys[k] = true
+			}
+			for k := range rm {
+				keys[k] = true
+			}
+			for _, k := range sortedKeys(keys) {
+				diffs = diffJSON(path+"["+k+"]", lm[k], rm[k], diffs)
+			}
+			return diffs
+		}
+		for i := 0; i < len(lv) || i < len(rv); i++ {
+			var le, re interface{}
+			if i < len(lv) {
+				le = lv[i]
+			}
+			if i < len(rv) {
+				re = rv[i]
+			}
+			diffs = diffJSON(path+"["+strconv.Itoa(i)+"]", le, re, diffs)
+		}
+		return diffs
+	}
+	return append(diffs, GenesisDifference{Path: path, Left: l, Right: r})
+}
+
+// byAddress indexes the elements by their address, the address of an account is in its value
+func byAddress(elems []interface{}) (map[string]interface{}, bool) {
+	m := make(map[string]interface{}, len(elems))
+	for _, e := range elems {
+		obj, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok := obj["value"].(map[string]interface{}); ok {
+			obj = value
+		}
+		address, ok := obj["address"].(string)
+		if !ok || address == "" {
+			return nil, false
+		}
+		if _, found := m[address]; found {
+			return nil, false
+		}
+		m[address] = e
+	}
+	return m, len(m) != 0
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
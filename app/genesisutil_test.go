@@ -0,0 +1,111 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisFile(t *testing.T) {
+	MakeCodec()
+	dir := t.TempDir()
+	for name, genesis := range map[string]string{"mainnet": mainnetGenesis, "testnet": testnetGenesis} {
+		path := filepath.Join(dir, name+".json")
+		require.Nil(t, os.WriteFile(path, []byte(genesis), 0600))
+		g, err := ReadGenesisFile(path)
+		require.Nil(t, err)
+		assert.Empty(t, g.Validate(), name)
+	}
+	owner := crypto.GenerateEd25519PrivKey().PublicKey()
+	path := filepath.Join(dir, "genesis.json")
+	require.Nil(t, os.WriteFile(path, newGenesisState(genesisSpec{
+		ChainID:          "test",
+		Owner:            owner,
+		Validators:       []genesisValidator{{PublicKey: owner, ServiceURL: sdk.PlaceholderServiceURL}},
+		Applications:     []crypto.PublicKey{owner},
+		Chains:           []string{"0001"},
+		Balance:          sdk.NewInt(1000000),
+		SessionNodeCount: 1,
+	}), 0600))
+	original, err := ReadGenesisFile(path)
+	require.Nil(t, err)
+	require.Empty(t, original.Validate())
+
+	// edit
+	g, err := ReadGenesisFile(path)
+	require.Nil(t, err)
+	account, validator, application := crypto.GenerateEd25519PrivKey().PublicKey(), crypto.GenerateEd25519PrivKey().PublicKey(), crypto.GenerateEd25519PrivKey().PublicKey()
+	require.Nil(t, g.AddAccount(account, sdk.NewInt(10)))
+	assert.NotNil(t, g.AddAccount(account, sdk.NewInt(10)))
+	require.Nil(t, g.AddValidator(validator, []string{"0001"}, "https://node.test:443", sdk.NewInt(20000000000), nil))
+	assert.NotNil(t, g.AddValidator(validator, []string{"0001"}, "https://node.test:443", sdk.NewInt(20000000000), nil))
+	assert.NotNil(t, g.AddValidator(account, []string{"not a chain"}, "https://node.test:443", sdk.NewInt(20000000000), nil))
+	require.Nil(t, g.AddApp(application, []string{"0001"}, sdk.NewInt(20000000000)))
+	assert.NotNil(t, g.AddApp(application, []string{"0001"}, sdk.NewInt(20000000000)))
+	require.Nil(t, g.Write(path))
+	g, err = ReadGenesisFile(path)
+	require.Nil(t, err)
+	assert.Empty(t, g.Validate())
+	assert.Len(t, g.auth().Accounts, 2)
+	assert.Len(t, g.nodes().Validators, 2)
+	assert.Len(t, g.apps().Applications, 2)
+
+	// an invalid edit leaves the file as is
+	require.Nil(t, g.AddValidator(account, []string{"0001"}, "https://node.test:443", sdk.NewInt(1), nil))
+	assert.NotEmpty(t, g.Validate())
+	assert.NotNil(t, g.Write(path))
+	g, err = ReadGenesisFile(path)
+	require.Nil(t, err)
+	assert.Len(t, g.nodes().Validators, 2)
+
+	// the pools and the supply, once in the genesis, move with the stakes
+	authGenesis := g.auth()
+	pool := authTypes.NewEmptyModuleAccount(nodesTypes.StakedPoolName, auth.Burner, auth.Minter, auth.Staking)
+	require.Nil(t, pool.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(20010000000)))))
+	authGenesis.Accounts = append(authGenesis.Accounts, pool)
+	authGenesis.Supply = sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(20011000010)))
+	g.State[auth.ModuleName] = Codec().MustMarshalJSON(authGenesis)
+	require.Empty(t, g.Validate())
+	// the module accounts are only exempted from the key check of the genesis file validation
+	assert.NotNil(t, validateModuleGenesis(auth.AppModuleBasic{}, g.State[auth.ModuleName]))
+	assert.NotPanics(t, func() { _ = authTypes.ValidateGenesis(authGenesis) })
+	require.Nil(t, g.AddValidator(crypto.GenerateEd25519PrivKey().PublicKey(), []string{"0001"}, "https://node.test:443", sdk.NewInt(20000000000), nil))
+	require.Nil(t, g.AddAccount(crypto.GenerateEd25519PrivKey().PublicKey(), sdk.NewInt(10)))
+	assert.Empty(t, g.Validate())
+	authGenesis = g.auth()
+	authGenesis.Supply = sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(1)))
+	g.State[auth.ModuleName] = Codec().MustMarshalJSON(authGenesis)
+	assert.Len(t, g.Validate(), 1)
+	posGenesis := g.nodes()
+	posGenesis.Validators[0].StakedTokens = posGenesis.Validators[0].StakedTokens.AddRaw(1)
+	g.State[nodesTypes.ModuleName] = Codec().MustMarshalJSON(posGenesis)
+	assert.Len(t, g.Validate(), 2)
+
+	// the diff matches by address
+	g, err = ReadGenesisFile(path)
+	require.Nil(t, err)
+	diffs, err := DiffGenesis(original, g)
+	require.Nil(t, err)
+	paths := make(map[string]GenesisDifference)
+	for _, d := range diffs {
+		paths[d.Path] = d
+	}
+	assert.Len(t, paths, 3)
+	d, ok := paths["pos.validators["+sdk.Address(validator.Address()).String()+"]"]
+	require.True(t, ok)
+	assert.Nil(t, d.Left)
+	assert.NotNil(t, d.Right)
+	assert.Contains(t, paths, "auth.accounts["+sdk.Address(account.Address()).String()+"]")
+	assert.Contains(t, paths, "application.applications["+sdk.Address(application.Address()).String()+"]")
+	diffs, err = DiffGenesis(g, g)
+	require.Nil(t, err)
+	assert.Empty(t, diffs)
+}
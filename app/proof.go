@@ -0,0 +1,99 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/verify"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// ProvableHeight returns the latest height that can be queried with a proof, as the app hash of a height is only
+// committed in the header of the following block
+func (app PocketCoreApp) ProvableHeight() int64 {
+	return app.LastBlockHeight() - 1
+}
+
+// QueryStoreProof returns the raw value of a key in a substore at a height, along with the iavl and multistore proof
+// ops linking it to the app hash of that height
+func (app PocketCoreApp) QueryStoreProof(storeName string, key []byte, height int64) (res verify.StoreProof, err error) {
+	if height <= 1 {
+		return res, errors.New("cannot query with proof when height <= 1; please provide a valid height")
+	}
+	meta := app.BlockStore().LoadBlockMeta(height + 1)
+	if meta == nil {
+		return res, fmt.Errorf("the app hash of height %d is not committed yet, the latest provable height is %d", height, app.ProvableHeight())
+	}
+	q := app.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", storeName),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if q.Code != 0 {
+		return res, errors.New(q.Log)
+	}
+	if q.Proof == nil {
+		return res, fmt.Errorf("no proof returned for %s/%X at height %d", storeName, key, height)
+	}
+	return verify.StoreProof{
+		Height:  height,
+		Store:   storeName,
+		Key:     key,
+		Value:   q.Value,
+		Proof:   q.Proof,
+		AppHash: meta.Header.AppHash,
+	}, nil
+}
+
+// DecodeStoreProofValue decodes the raw value of a store proof the same way the keepers do, so a light client can use
+// the verified value instead of trusting the result returned alongside the proof
+func DecodeStoreProofValue(proof verify.StoreProof) (interface{}, error) {
+	if len(proof.Value) == 0 {
+		return nil, nil
+	}
+	return decodeStoreValue(proof.Store, proof.Value, proof.Height)
+}
+
+// decodeStoreValue decodes the raw value of an account, a validator, an application, a claim or a param stored in a
+// substore at a height
+func decodeStoreValue(store string, value []byte, height int64) (interface{}, error) {
+	cdc := Codec()
+	switch store {
+	case authTypes.StoreKey:
+		var ba authTypes.BaseAccount
+		if err := cdc.UnmarshalBinaryBare(value, &ba, height); err == nil {
+			return &ba, nil
+		}
+		var ma authTypes.ModuleAccount
+		err := cdc.UnmarshalBinaryBare(value, &ma, height)
+		return &ma, err
+	case nodesTypes.StoreKey:
+		if cdc.IsAfterNonCustodialUpgrade(height) {
+			var val nodesTypes.Validator
+			err := cdc.UnmarshalBinaryLengthPrefixed(value, &val, height)
+			return val, err
+		}
+		var val nodesTypes.LegacyValidator
+		err := cdc.UnmarshalBinaryLengthPrefixed(value, &val, height)
+		return val.ToValidator(), err
+	case appsTypes.StoreKey:
+		var application appsTypes.Application
+		err := cdc.UnmarshalBinaryLengthPrefixed(value, &application, height)
+		return application, err
+	case pocketTypes.StoreKey:
+		var claim pocketTypes.MsgClaim
+		err := cdc.UnmarshalBinaryBare(value, &claim, height)
+		return claim, err
+	case sdk.ParamsKey.Name():
+		return json.RawMessage(value), nil
+	default:
+		return nil, fmt.Errorf("unsupported store for decoding: %s", store)
+	}
+}
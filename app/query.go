@@ -249,6 +249,18 @@ func (app PocketCoreApp) QueryNode(addr string, height int64) (res nodesTypes.Va
 	return
 }
 
+func (app PocketCoreApp) QueryNodeUnbonding(addr string, height int64) (res []nodesTypes.UnbondingEntry, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.nodesKeeper.GetUnbondingEntries(ctx, a), nil
+}
+
 func (app PocketCoreApp) QueryNodeParams(height int64) (res nodesTypes.Params, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -262,6 +274,9 @@ func (app PocketCoreApp) QueryHostedChains() (res map[string]pocketTypes.HostedB
 }
 
 func (app PocketCoreApp) SetHostedChains(req map[string]pocketTypes.HostedBlockchain) (res map[string]pocketTypes.HostedBlockchain, err error) {
+	if err := validateHostedChainsConfig(req); err != nil {
+		return nil, err
+	}
 	return app.pocketKeeper.SetHostedBlockchains(req).M, nil
 }
 
@@ -460,6 +475,18 @@ func (app PocketCoreApp) QueryApp(addr string, height int64) (res appsTypes.Appl
 	return
 }
 
+func (app PocketCoreApp) QueryAppUnbonding(addr string, height int64) (res []appsTypes.UnbondingEntry, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.appsKeeper.GetUnbondingEntries(ctx, a), nil
+}
+
 func (app PocketCoreApp) QueryTotalAppCoins(height int64) (staked sdk.BigInt, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -575,6 +602,23 @@ func (app PocketCoreApp) HandleDispatch(header pocketTypes.SessionHeader) (res *
 	return app.pocketKeeper.HandleDispatch(ctx, header)
 }
 
+func (app PocketCoreApp) QuerySession(appPubKey, chain string, sessionBlockHeight int64) (res *pocketTypes.SessionCalculation, err error) {
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return nil, err
+	}
+	header := pocketTypes.SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              chain,
+		SessionBlockHeight: sessionBlockHeight,
+	}
+	res, er := app.pocketKeeper.CalculateSession(ctx, header)
+	if er != nil {
+		return nil, er
+	}
+	return res, nil
+}
+
 func (app PocketCoreApp) HandleRelay(r pocketTypes.Relay) (res *pocketTypes.RelayResponse, dispatch *pocketTypes.DispatchResponse, err error) {
 	ctx, err := app.NewContext(app.LastBlockHeight())
 
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const DefaultReindexBatchSize = 1000
+
+// ReindexProgress is called after every batch written by ReindexTransactions
+type ReindexProgress func(height, latestHeight int64, indexed int)
+
+// ReindexTransactions rebuilds the transaction indexer entries for the blocks in [from, to] using the blockstore
+// and the stored abci responses. A checkpoint is written atomically with every batch, so if from is not
+// provided (<= 0) an interrupted reindex resumes from the height after the last checkpoint.
+// NOTE: the node must not be running, as the databases are opened directly
+func ReindexTransactions(config sdk.Config, from, to int64, batchSize int, progress ReindexProgress) error {
+	if batchSize <= 0 {
+		batchSize = DefaultReindexBatchSize
+	}
+	blockStore, _, blockStoreDB, stateDB, err := state.BlocksAndStateFromDB(&config.TendermintConfig, state.DefaultDBProvider)
+	if err != nil {
+		return fmt.Errorf("error loading the blockstore: %s", err.Error())
+	}
+	defer blockStoreDB.Close()
+	defer stateDB.Close()
+	txDB, err := OpenTxIndexerDB(config)
+	if err != nil {
+		return fmt.Errorf("error loading the transaction indexer database: %s", err.Error())
+	}
+	defer txDB.Close()
+	txIndexer := sdk.NewTransactionIndexer(txDB)
+	// resume from the checkpoint if no explicit starting height
+	if from <= 0 {
+		checkpoint, err := txIndexer.ReindexCheckpoint()
+		if err != nil {
+			return err
+		}
+		from = checkpoint + 1
+	}
+	if from < blockStore.Base() {
+		from = blockStore.Base()
+	}
+	latestHeight := blockStore.Height()
+	if to <= 0 || to > latestHeight {
+		to = latestHeight
+	}
+	if from > to {
+		return fmt.Errorf("invalid reindex range: from %d to %d", from, to)
+	}
+	err = reindexBlocks(from, to, batchSize, func(height int64) ([]*tmTypes.TxResult, error) {
+		return blockTxResults(blockStore.LoadBlock(height), stateDB, height)
+	}, txIndexer, progress)
+	if err != nil {
+		return err
+	}
+	return txIndexer.ClearReindexCheckpoint()
+}
+
+// reindexBlocks indexes the results of the blocks in [from, to], written once at least batchSize results are pending
+func reindexBlocks(from, to int64, batchSize int, blockResults func(height int64) ([]*tmTypes.TxResult, error), txIndexer *sdk.TransactionIndexer, progress ReindexProgress) error {
+	var pending []*tmTypes.TxResult
+	for height := from; height <= to; height++ {
+		results, err := blockResults(height)
+		if err != nil {
+			return err
+		}
+		pending = append(pending, results...)
+		// only write at block boundaries so the checkpoint always marks a fully indexed height
+		if len(pending) >= batchSize || height == to {
+			if err := txIndexer.AddBatchWithCheckpoint(&txindex.Batch{Ops: pending}, height); err != nil {
+				return fmt.Errorf("error writing the reindex batch at height %d: %s", height, err.Error())
+			}
+			if progress != nil {
+				progress(height, to, len(pending))
+			}
+			pending = nil
+		}
+	}
+	return nil
+}
+
+// blockTxResults reconstructs the indexable transaction results of a block from the stored abci responses,
+// decoding each transaction to fill in any signer, recipient or message type the responses lack
+func blockTxResults(block *tmTypes.Block, stateDB dbm.DB, height int64) ([]*tmTypes.TxResult, error) {
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found in the blockstore", height)
+	}
+	if len(block.Txs) == 0 {
+		return nil, nil
+	}
+	abciResponses, err := state.LoadABCIResponses(stateDB, height)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the abci responses at height %d: %s", height, err.Error())
+	}
+	if len(abciResponses.DeliverTx) != len(block.Txs) {
+		return nil, fmt.Errorf("mismatched abci responses at height %d: %d txs and %d responses", height, len(block.Txs), len(abciResponses.DeliverTx))
+	}
+	results := make([]*tmTypes.TxResult, len(block.Txs))
+	for i, tx := range block.Txs {
+		res := abciResponses.DeliverTx[i]
+		fillDeliverTxFromTx(res, tx, height)
+		results[i] = &tmTypes.TxResult{
+			Height: height,
+			Index:  uint32(i),
+			Tx:     tx,
+			Result: *res,
+		}
+	}
+	return results, nil
+}
+
+// fillDeliverTxFromTx mirrors the signer, recipient and message type derivation of the baseapp deliverTx
+func fillDeliverTxFromTx(res *abci.ResponseDeliverTx, tx tmTypes.Tx, height int64) {
+	if res.Signer != nil && res.MessageType != "" {
+		return
+	}
+	stdTx, err := UnmarshalTx(tx, height)
+	if err != nil {
+		return // undecodable transactions are indexed as is, same as deliverTx
+	}
+	msg := stdTx.GetMsg()
+	if res.MessageType == "" {
+		res.MessageType = msg.Type()
+	}
+	if res.Recipient == nil {
+		res.Recipient = msg.GetRecipient()
+	}
+	if res.Signer == nil {
+		if pk := stdTx.GetSignature().PublicKey; pk != nil && msg.Type() != appsTypes.MsgAppStakeName {
+			res.Signer = sdk.Address(pk.Address())
+			return
+		}
+		if signers := msg.GetSigners(); len(signers) >= 1 {
+			res.Signer = signers[0]
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestReindexBlocks(t *testing.T) {
+	txsPerBlock := map[int64]int{1: 3, 2: 3, 3: 5, 4: 2}
+	blockResults := func(height int64) ([]*tmTypes.TxResult, error) {
+		results := make([]*tmTypes.TxResult, txsPerBlock[height])
+		for i := range results {
+			results[i] = &tmTypes.TxResult{
+				Height: height,
+				Index:  uint32(i),
+				Tx:     tmTypes.Tx(fmt.Sprintf("tx %d %d", height, i)),
+				Result: abci.ResponseDeliverTx{Signer: sdk.Address([]byte("signer"))},
+			}
+		}
+		return results, nil
+	}
+	indexer := sdk.NewTransactionIndexer(dbm.NewMemDB())
+	type write struct {
+		height  int64
+		indexed int
+	}
+	var writes []write
+	err := reindexBlocks(1, 4, 4, blockResults, indexer, func(height, latestHeight int64, indexed int) {
+		checkpoint, err := indexer.ReindexCheckpoint()
+		require.Nil(t, err)
+		assert.Equal(t, height, checkpoint)
+		writes = append(writes, write{height, indexed})
+	})
+	require.Nil(t, err)
+	// batches end at block boundaries, a block larger than the batch size is written whole
+	assert.Equal(t, []write{{2, 6}, {3, 5}, {4, 2}}, writes)
+	for height, txs := range txsPerBlock {
+		for i := 0; i < txs; i++ {
+			res, err := indexer.Get(tmTypes.Tx(fmt.Sprintf("tx %d %d", height, i)).Hash())
+			require.Nil(t, err)
+			require.NotNil(t, res)
+			assert.Equal(t, height, res.Height)
+			assert.Equal(t, uint32(i), res.Index)
+		}
+	}
+}
@@ -0,0 +1,482 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	rpcTypes "github.com/pokt-network/pocket-core/app/cmd/rpc/types"
+	"github.com/pokt-network/pocket-core/client"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/keeper"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+const (
+	DefaultRelayBenchConcurrency = 10
+	DefaultRelayBenchCount       = 1000
+	DefaultRelayBenchPayload     = `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`
+	// MaxRelayBenchRate is the highest rate the ticker of the relays can start them at, one per nanosecond
+	MaxRelayBenchRate = float64(time.Second)
+
+	relayBenchHeightPath     = "/v1/query/height"
+	relayBenchNodeParamsPath = "/v1/query/nodeparams"
+	relayBenchNodePath       = "/v1/query/node"
+	relayBenchEvidencePath   = "/v1/private/evidence"
+)
+
+// RelayBenchOptions of a relay load test against a pocket node
+type RelayBenchOptions struct {
+	NodeURL     string              // the node the sessions are dispatched by and the chain state is queried from
+	Chain       string              // the relay chain of the session
+	AppKey      crypto.PrivateKey   // the staked application, nil generates a synthetic one that no servicer knows
+	Servicer    string              // the address of the only servicer relayed to, required by a synthetic application
+	Concurrency int                 // the relays in flight at once
+	Rate        float64             // the relays started per second across the workers, 0 doesn't limit them
+	Count       int                 // the relays sent, 0 sends relays until the duration is over
+	Duration    time.Duration       // the length of the test when no count is set
+	Payload     pocketTypes.Payload // the request relayed to the chain
+	BlockTime   time.Duration       // the expected time between blocks, used to expire the sessions
+	Timeout     time.Duration       // the timeout of every request
+	PrivateURL  string              // the private rpc of the node, defaults to the node url
+	AuthToken   string              // the auth token of the private rpc, the evidence growth is not measured without it
+}
+
+// RelayBenchReport is the outcome of a relay load test
+type RelayBenchReport struct {
+	Application string                    `json:"application"` // the address of the application
+	Synthetic   bool                      `json:"synthetic"`   // the application was generated for the test
+	Session     pocketTypes.SessionHeader `json:"session"`     // the first session relayed for
+	Servicers   []string                  `json:"servicers"`   // the addresses of the servicers relayed to
+	Sent        int                       `json:"sent"`        // the relays answered or failed, the ones in flight at the end of the duration are left out
+	Succeeded   int                       `json:"succeeded"`
+	Elapsed     time.Duration             `json:"elapsed"`
+	Latency     RelayBenchLatency         `json:"latency"`  // of the relays answered by the servicers
+	Errors      []RelayBenchError         `json:"errors"`   // by type, the most frequent first
+	Evidence    []rpcTypes.LocalEvidence  `json:"evidence"` // the evidence stored by the local nodes during the test
+}
+
+// RelayBenchLatency is the distribution of the relay round trips
+type RelayBenchLatency struct {
+	P50  time.Duration `json:"p50"`
+	P90  time.Duration `json:"p90"`
+	P99  time.Duration `json:"p99"`
+	Max  time.Duration `json:"max"`
+	Mean time.Duration `json:"mean"`
+}
+
+// RelayBenchError counts the failed relays of a type: a pocket error codespace and code, an http status, a transport
+// failure or a wrong servicer signature
+type RelayBenchError struct {
+	Type    string `json:"type"`
+	Count   int    `json:"count"`
+	Example string `json:"example"` // the message of the first error of the type
+}
+
+// RelaysPerSecond is the throughput of the test
+func (r RelayBenchReport) RelaysPerSecond() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+	return float64(r.Sent) / r.Elapsed.Seconds()
+}
+
+// relayBench is a running relay load test
+type relayBench struct {
+	opts       RelayBenchOptions
+	aat        pocketTypes.AAT
+	client     *client.Client
+	httpClient *http.Client
+	session    *client.Session // the session of a synthetic application, never dispatched
+	mu         sync.Mutex
+	latencies  []time.Duration
+	failures   map[string]*RelayBenchError
+	servicers  map[string]struct{}
+	sent       int
+	succeeded  int
+}
+
+// RunRelayBench signs relays with a new client key and an AAT of the application, and sends them to the session
+// servicers at the configured concurrency and rate. The evidence stores of the node are counted before and after the
+// test when the auth token is set
+func RunRelayBench(ctx context.Context, opts RelayBenchOptions) (RelayBenchReport, error) {
+	if opts.NodeURL == "" || opts.Chain == "" {
+		return RelayBenchReport{}, fmt.Errorf("the node url and the chain are needed")
+	}
+	if !(opts.Rate >= 0 && opts.Rate <= MaxRelayBenchRate) {
+		return RelayBenchReport{}, fmt.Errorf("the rate must be between 0 and %g relays per second", MaxRelayBenchRate)
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultRelayBenchConcurrency
+	}
+	if opts.Count <= 0 && opts.Duration <= 0 {
+		opts.Count = DefaultRelayBenchCount
+	}
+	if opts.Payload.Data == "" {
+		opts.Payload = pocketTypes.Payload{Data: DefaultRelayBenchPayload}
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = client.DefaultTimeout
+	}
+	if opts.PrivateURL == "" {
+		opts.PrivateURL = opts.NodeURL
+	}
+	report := RelayBenchReport{Synthetic: opts.AppKey == nil}
+	if report.Synthetic {
+		if opts.Servicer == "" {
+			return report, fmt.Errorf("a synthetic application is in no session, the servicer to relay to is needed")
+		}
+		opts.AppKey = crypto.GenerateEd25519PrivKey()
+	}
+	clientKey := crypto.GenerateEd25519PrivKey()
+	aat, sdkErr := keeper.AATGeneration(opts.AppKey.PublicKey().RawString(), clientKey.PublicKey().RawString(), opts.AppKey)
+	if sdkErr != nil {
+		return report, sdkErr
+	}
+	c, err := client.NewClient(client.Config{
+		DispatchURLs: []string{opts.NodeURL},
+		AAT:          aat,
+		ClientKey:    clientKey,
+		BlockTime:    opts.BlockTime,
+		Timeout:      opts.Timeout,
+	})
+	if err != nil {
+		return report, err
+	}
+	b := &relayBench{
+		opts:       opts,
+		aat:        aat,
+		client:     c,
+		httpClient: &http.Client{Timeout: opts.Timeout},
+		failures:   make(map[string]*RelayBenchError),
+		servicers:  make(map[string]struct{}),
+	}
+	report.Application = sdk.Address(opts.AppKey.PublicKey().Address()).String()
+	var session *client.Session
+	if report.Synthetic {
+		session, err = b.syntheticSession(ctx)
+		b.session = session
+	} else {
+		session, err = c.Dispatch(ctx, opts.Chain)
+	}
+	if err != nil {
+		return report, err
+	}
+	report.Session = session.Header
+	var before []rpcTypes.LocalEvidence
+	if opts.AuthToken != "" {
+		if before, err = b.evidence(ctx); err != nil {
+			return report, err
+		}
+	}
+	start := time.Now()
+	b.run(ctx)
+	report.Elapsed = time.Since(start)
+	if opts.AuthToken != "" {
+		after, err := b.evidence(ctx)
+		if err != nil {
+			return report, err
+		}
+		report.Evidence = evidenceGrowth(before, after)
+	}
+	report.Sent, report.Succeeded = b.sent, b.succeeded
+	report.Latency = latencyOf(b.latencies)
+	for _, e := range b.failures {
+		report.Errors = append(report.Errors, *e)
+	}
+	sort.Slice(report.Errors, func(i, j int) bool {
+		if report.Errors[i].Count != report.Errors[j].Count {
+			return report.Errors[i].Count > report.Errors[j].Count
+		}
+		return report.Errors[i].Type < report.Errors[j].Type
+	})
+	for s := range b.servicers {
+		report.Servicers = append(report.Servicers, s)
+	}
+	sort.Strings(report.Servicers)
+	return report, nil
+}
+
+// run starts the relays at the configured rate, the relays still in flight at the end of the duration are dropped
+func (b *relayBench) run(ctx context.Context) {
+	if b.opts.Count <= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.opts.Duration)
+		defer cancel()
+	}
+	jobs := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < b.opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range jobs {
+				b.relay(ctx)
+			}
+		}()
+	}
+	var tick <-chan time.Time
+	if b.opts.Rate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / b.opts.Rate))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+loop:
+	for started := 0; b.opts.Count <= 0 || started < b.opts.Count; started++ {
+		if tick != nil {
+			select {
+			case <-ctx.Done():
+				break loop
+			case <-tick:
+			}
+		}
+		select {
+		case <-ctx.Done():
+			break loop
+		case jobs <- struct{}{}:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// relay sends a single relay to the next servicer of the session, a new session is dispatched when the servicer asks
+// for it
+func (b *relayBench) relay(ctx context.Context) {
+	session := b.session
+	if session == nil {
+		var err error
+		if session, err = b.client.Session(ctx, b.opts.Chain); err != nil {
+			b.record(0, err)
+			return
+		}
+	}
+	node, err := b.servicer(session)
+	if err != nil {
+		b.record(0, err)
+		return
+	}
+	relay, err := b.client.NewRelay(session, node, b.opts.Payload)
+	if err != nil {
+		b.record(0, err)
+		return
+	}
+	start := time.Now()
+	_, err = b.client.SendRelay(ctx, node, relay)
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		// cut off at the end of the test
+		return
+	}
+	b.record(time.Since(start), err)
+	var rErr *client.RelayError
+	if b.session == nil && errors.As(err, &rErr) && rErr.WarrantsDispatch() {
+		_, _ = b.client.Redispatch(ctx, b.opts.Chain, rErr)
+	}
+	b.mu.Lock()
+	b.servicers[node.Address.String()] = struct{}{}
+	b.mu.Unlock()
+}
+
+// servicer returns the next node of the session, or the configured servicer if it is in the session
+func (b *relayBench) servicer(session *client.Session) (nodesTypes.Validator, error) {
+	if b.opts.Servicer == "" {
+		return session.NextNode()
+	}
+	for _, node := range session.Nodes {
+		if strings.EqualFold(node.Address.String(), b.opts.Servicer) {
+			return node, nil
+		}
+	}
+	return nodesTypes.Validator{}, fmt.Errorf("the servicer %s is not in the session", b.opts.Servicer)
+}
+
+// record adds the outcome of a relay, the latency of the relays not sent is 0 and not recorded
+func (b *relayBench) record(latency time.Duration, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sent++
+	if latency > 0 {
+		b.latencies = append(b.latencies, latency)
+	}
+	if err == nil {
+		b.succeeded++
+		return
+	}
+	t, msg := relayBenchErrorType(err)
+	e, ok := b.failures[t]
+	if !ok {
+		e = &RelayBenchError{Type: t, Example: msg}
+		b.failures[t] = e
+	}
+	e.Count++
+}
+
+// relayBenchErrorType classifies a relay error, and returns its message
+func relayBenchErrorType(err error) (string, string) {
+	var rErr *client.RelayError
+	switch {
+	case errors.As(err, &rErr):
+		if sdkErr, ok := rErr.Err.(sdk.Error); ok {
+			return fmt.Sprintf("%s/%d", sdkErr.Codespace(), sdkErr.Code()), fmt.Sprintf("%v", sdkErr)
+		}
+		return fmt.Sprintf("http/%d", rErr.Status), rErr.Error()
+	case errors.Is(err, client.ErrInvalidServicerSignature):
+		return "signature", err.Error()
+	default:
+		return "transport", err.Error()
+	}
+}
+
+// syntheticSession builds the current session of a synthetic application for the configured servicer, the session
+// nodes reject its relays once they look the application up
+func (b *relayBench) syntheticSession(ctx context.Context) (*client.Session, error) {
+	var height struct {
+		Height int64 `json:"height"`
+	}
+	bz, err := b.query(ctx, relayBenchHeightPath, map[string]interface{}{"height": 0})
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, &height); err != nil {
+		return nil, err
+	}
+	var params nodesTypes.Params
+	if bz, err = b.query(ctx, relayBenchNodeParamsPath, map[string]interface{}{"height": 0}); err != nil {
+		return nil, err
+	}
+	if err := Codec().UnmarshalJSON(bz, &params); err != nil {
+		return nil, err
+	}
+	var node nodesTypes.Validator
+	if bz, err = b.query(ctx, relayBenchNodePath, map[string]interface{}{"address": b.opts.Servicer, "height": 0}); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, &node); err != nil {
+		return nil, err
+	}
+	return &client.Session{
+		Header: pocketTypes.SessionHeader{
+			ApplicationPubKey:  b.aat.ApplicationPublicKey,
+			Chain:              b.opts.Chain,
+			SessionBlockHeight: sessionBlockHeight(height.Height, params.SessionBlockFrequency),
+		},
+		Nodes:        []nodesTypes.Validator{node},
+		BlockHeight:  height.Height,
+		DispatchedAt: time.Now(),
+	}, nil
+}
+
+// sessionBlockHeight is the first block of the session of the height
+func sessionBlockHeight(height, blocksPerSession int64) int64 {
+	if blocksPerSession <= 0 {
+		return height
+	}
+	if height%blocksPerSession == 0 {
+		return height - blocksPerSession + 1
+	}
+	return (height/blocksPerSession)*blocksPerSession + 1
+}
+
+// evidence returns the evidence stores of the local nodes of the private rpc
+func (b *relayBench) evidence(ctx context.Context) (res []rpcTypes.LocalEvidence, err error) {
+	bz, err := b.post(ctx, strings.TrimRight(b.opts.PrivateURL, "/")+relayBenchEvidencePath, map[string]interface{}{}, b.opts.AuthToken)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(bz, &res)
+	return
+}
+
+// query asks the node for the chain state
+func (b *relayBench) query(ctx context.Context, path string, params interface{}) ([]byte, error) {
+	return b.post(ctx, strings.TrimRight(b.opts.NodeURL, "/")+path, params, "")
+}
+
+// post sends the json body with the auth token, if any, and returns the body of a successful response
+func (b *relayBench) post(ctx context.Context, url string, body interface{}, token string) ([]byte, error) {
+	bz, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(bz))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	bz, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s failed with status %d: %s", url, resp.StatusCode, string(bz))
+	}
+	return bz, nil
+}
+
+// evidenceGrowth is the evidence the local nodes stored between both counts
+func evidenceGrowth(before, after []rpcTypes.LocalEvidence) []rpcTypes.LocalEvidence {
+	previous := make(map[string]pocketTypes.EvidenceStoreStats, len(before))
+	for _, e := range before {
+		previous[e.Address] = e.EvidenceStoreStats
+	}
+	growth := make([]rpcTypes.LocalEvidence, 0, len(after))
+	for _, e := range after {
+		p := previous[e.Address]
+		growth = append(growth, rpcTypes.LocalEvidence{
+			Address: e.Address,
+			EvidenceStoreStats: pocketTypes.EvidenceStoreStats{
+				Evidences:  e.Evidences - p.Evidences,
+				Sealed:     e.Sealed - p.Sealed,
+				Relays:     e.Relays - p.Relays,
+				Challenges: e.Challenges - p.Challenges,
+			},
+		})
+	}
+	return growth
+}
+
+// latencyOf computes the percentiles of the latencies
+func latencyOf(latencies []time.Duration) (l RelayBenchLatency) {
+	if len(latencies) == 0 {
+		return
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	percentile := func(p int) time.Duration {
+		i := (len(sorted)*p+99)/100 - 1
+		if i < 0 {
+			i = 0
+		}
+		return sorted[i]
+	}
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	return RelayBenchLatency{
+		P50:  percentile(50),
+		P90:  percentile(90),
+		P99:  percentile(99),
+		Max:  sorted[len(sorted)-1],
+		Mean: total / time.Duration(len(sorted)),
+	}
+}
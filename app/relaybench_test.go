@@ -0,0 +1,158 @@
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	rpcTypes "github.com/pokt-network/pocket-core/app/cmd/rpc/types"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesExported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// benchNode is a pocket node that services the relays of a single staked application
+type benchNode struct {
+	key    crypto.PrivateKey
+	appKey crypto.PrivateKey
+	server *httptest.Server
+	relays int64
+}
+
+func newBenchNode(t *testing.T) *benchNode {
+	n := &benchNode{key: crypto.GenerateEd25519PrivKey(), appKey: crypto.GenerateEd25519PrivKey()}
+	n.server = httptest.NewServer(n)
+	t.Cleanup(n.server.Close)
+	return n
+}
+
+func (n *benchNode) validator() nodesTypes.Validator {
+	return nodesTypes.Validator{
+		Address:      sdk.Address(n.key.PublicKey().Address()),
+		PublicKey:    n.key.PublicKey(),
+		Status:       sdk.Staked,
+		Chains:       []string{"0001"},
+		ServiceURL:   n.server.URL,
+		StakedTokens: sdk.NewInt(1000000),
+	}
+}
+
+func (n *benchNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/v1/client/dispatch":
+		res := pocketTypes.DispatchResponse{BlockHeight: 9}
+		res.Session.SessionHeader = pocketTypes.SessionHeader{ApplicationPubKey: n.appKey.PublicKey().RawString(), Chain: "0001", SessionBlockHeight: 9}
+		res.Session.SessionNodes = []nodesExported.ValidatorI{n.validator()}
+		_ = json.NewEncoder(w).Encode(res)
+	case "/v1/client/relay":
+		var relay pocketTypes.Relay
+		if err := json.NewDecoder(r.Body).Decode(&relay); err != nil {
+			w.WriteHeader(400)
+			return
+		}
+		if relay.Proof.Token.ApplicationPublicKey != n.appKey.PublicKey().RawString() {
+			bz, _ := json.Marshal(map[string]interface{}{"error": pocketTypes.NewAppNotFoundError(pocketTypes.ModuleName)})
+			w.WriteHeader(400)
+			_, _ = w.Write(bz)
+			return
+		}
+		atomic.AddInt64(&n.relays, 1)
+		resp := pocketTypes.RelayResponse{Response: "0x1", Proof: relay.Proof}
+		sig, _ := n.key.Sign(resp.Hash())
+		_ = json.NewEncoder(w).Encode(map[string]string{"signature": hex.EncodeToString(sig), "response": "0x1"})
+	case "/v1/query/height":
+		_, _ = w.Write([]byte(`{"height":10}`))
+	case "/v1/query/nodeparams":
+		_, _ = w.Write(Codec().MustMarshalJSON(nodesTypes.Params{SessionBlockFrequency: 4}))
+	case "/v1/query/node":
+		_ = json.NewEncoder(w).Encode(n.validator())
+	case "/v1/private/evidence":
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(401)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]rpcTypes.LocalEvidence{{
+			Address:            n.validator().Address.String(),
+			EvidenceStoreStats: pocketTypes.EvidenceStoreStats{Evidences: 1, Relays: atomic.LoadInt64(&n.relays)},
+		}})
+	default:
+		w.WriteHeader(404)
+	}
+}
+
+func TestRunRelayBench(t *testing.T) {
+	MakeCodec()
+	n := newBenchNode(t)
+	report, err := RunRelayBench(context.Background(), RelayBenchOptions{
+		NodeURL:     n.server.URL,
+		Chain:       "0001",
+		AppKey:      n.appKey,
+		Concurrency: 4,
+		Count:       40,
+		AuthToken:   "token",
+	})
+	require.Nil(t, err)
+	assert.False(t, report.Synthetic)
+	assert.Equal(t, 40, report.Sent)
+	assert.Equal(t, 40, report.Succeeded)
+	assert.Empty(t, report.Errors)
+	assert.Equal(t, int64(9), report.Session.SessionBlockHeight)
+	assert.Equal(t, []string{n.validator().Address.String()}, report.Servicers)
+	assert.True(t, report.Latency.P50 > 0 && report.Latency.P50 <= report.Latency.P99 && report.Latency.P99 <= report.Latency.Max)
+	require.Len(t, report.Evidence, 1)
+	assert.Equal(t, int64(40), report.Evidence[0].Relays)
+	assert.Equal(t, int64(0), report.Evidence[0].Evidences)
+
+	// a synthetic application is rejected by the servicer, for a session built from the chain state
+	report, err = RunRelayBench(context.Background(), RelayBenchOptions{
+		NodeURL:  n.server.URL,
+		Chain:    "0001",
+		Servicer: n.validator().Address.String(),
+		Duration: 200 * time.Millisecond,
+		Rate:     50,
+	})
+	require.Nil(t, err)
+	assert.True(t, report.Synthetic)
+	assert.Equal(t, int64(9), report.Session.SessionBlockHeight)
+	assert.NotZero(t, report.Sent)
+	assert.Zero(t, report.Succeeded)
+	require.Len(t, report.Errors, 1)
+	assert.Equal(t, "pocketcore/45", report.Errors[0].Type)
+	assert.Equal(t, report.Sent, report.Errors[0].Count)
+	assert.LessOrEqual(t, report.Sent, 11)
+	assert.Empty(t, report.Evidence)
+
+	_, err = RunRelayBench(context.Background(), RelayBenchOptions{NodeURL: n.server.URL, Chain: "0001"})
+	assert.NotNil(t, err)
+	// the ticker can't start the relays faster than one per nanosecond
+	_, err = RunRelayBench(context.Background(), RelayBenchOptions{NodeURL: n.server.URL, Chain: "0001", AppKey: n.appKey, Rate: 2e9})
+	assert.NotNil(t, err)
+	_, err = RunRelayBench(context.Background(), RelayBenchOptions{NodeURL: n.server.URL, Chain: "0001", AppKey: n.appKey, Rate: -1})
+	assert.NotNil(t, err)
+}
+
+func TestLatencyOf(t *testing.T) {
+	assert.Equal(t, RelayBenchLatency{}, latencyOf(nil))
+	var latencies []time.Duration
+	for i := 100; i >= 1; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	l := latencyOf(latencies)
+	assert.Equal(t, 50*time.Millisecond, l.P50)
+	assert.Equal(t, 90*time.Millisecond, l.P90)
+	assert.Equal(t, 99*time.Millisecond, l.P99)
+	assert.Equal(t, 100*time.Millisecond, l.Max)
+	assert.Equal(t, 50500*time.Microsecond, l.Mean)
+	assert.Equal(t, 100*time.Millisecond, latencies[0])
+	assert.Equal(t, int64(1), sessionBlockHeight(4, 4))
+	assert.Equal(t, int64(9), sessionBlockHeight(10, 4))
+}
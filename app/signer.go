@@ -0,0 +1,571 @@
+package app
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// The remote signer protocol: net/rpc calls with the json codec, over mutual tls on a unix socket or tcp.
+// The node first asks for the public keys of the signer, then sends a SignerRequest for each signature.
+const (
+	signerService          = "Signer"
+	signerPublicKeysMethod = signerService + ".PublicKeys"
+	signerSignMethod       = signerService + ".Sign"
+	signerRelaySessions    = 8 // session heights the relay replay protection remembers
+	signerKindRelay        = "relay"
+	signerKindClaim        = "claim"
+	signerKindProof        = "proof"
+	signerKindTx           = "tx"
+	signerAuditFileMode    = 0600
+	signerUnixAddrPrefix   = "unix://"
+	signerTCPAddrPrefix    = "tcp://"
+	signerDialTimeout      = 5 * time.Second
+)
+
+// SignerRequest asks the remote signer to sign with one of its keys, either a relay response or the sign bytes
+// of a claim or proof transaction
+type SignerRequest struct {
+	PublicKey string            `json:"public_key"`
+	Relay     *pc.RelayResponse `json:"relay,omitempty"`
+	Tx        []byte            `json:"tx,omitempty"`
+}
+
+// SignerResponse is the signature of a SignerRequest
+type SignerResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// SignerAuditEntry is a line of the audit log of the signer, written for every claim, proof and refused request
+type SignerAuditEntry struct {
+	Time    time.Time `json:"time"`
+	Peer    string    `json:"peer"`
+	Address string    `json:"address"`
+	Kind    string    `json:"kind"`
+	Session string    `json:"session,omitempty"` // the session of a claim or proof
+	Hash    string    `json:"hash"`
+	Signed  bool      `json:"signed"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// SignerTLSConfig returns the mutual tls config of the signer or of its clients: the peer must present a certificate
+// signed by the ca. The host name of the peer is not checked, the ca is what identifies it
+func SignerTLSConfig(certFile, keyFile, caFile string, server bool) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" || caFile == "" {
+		return nil, errors.New("the remote signer needs a certificate, its key and the ca of the peer")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load the remote signer certificate: %s", err.Error())
+	}
+	bz, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the remote signer ca file: %s", err.Error())
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("no certificate found in the remote signer ca file %s", caFile)
+	}
+	c := &tls.Config{MinVersion: tls.VersionTLS12, Certificates: []tls.Certificate{cert}}
+	if server {
+		c.ClientCAs = pool
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+		return c, nil
+	}
+	c.InsecureSkipVerify = true // verified against the ca below, without the host name
+	c.VerifyConnection = func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("the remote signer presented no certificate")
+		}
+		intermediates := x509.NewCertPool()
+		for _, c := range cs.PeerCertificates[1:] {
+			intermediates.AddCert(c)
+		}
+		_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{Roots: pool, Intermediates: intermediates})
+		return err
+	}
+	return c, nil
+}
+
+// signerNetwork splits a unix:// or tcp:// signer address into the network and address to dial or listen on
+func signerNetwork(addr string) (network, address string, err error) {
+	switch {
+	case strings.HasPrefix(addr, signerUnixAddrPrefix):
+		return "unix", strings.TrimPrefix(addr, signerUnixAddrPrefix), nil
+	case strings.HasPrefix(addr, signerTCPAddrPrefix):
+		return "tcp", strings.TrimPrefix(addr, signerTCPAddrPrefix), nil
+	default:
+		return "", "", fmt.Errorf("the remote signer address %s must start with unix:// or tcp://", addr)
+	}
+}
+
+// ListenSigner listens for the nodes on the unix:// or tcp:// address, over tls
+func ListenSigner(addr string, tlsConfig *tls.Config) (net.Listener, error) {
+	network, address, err := signerNetwork(addr)
+	if err != nil {
+		return nil, err
+	}
+	if network == "unix" {
+		// a socket left by a previous run
+		_ = os.Remove(address)
+	}
+	lis, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return tls.NewListener(lis, tlsConfig), nil
+}
+
+// SignerClient is the connection of a node to its remote signer, redialed when it breaks
+type SignerClient struct {
+	addr      string
+	tlsConfig *tls.Config
+	timeout   time.Duration
+	mu        sync.Mutex
+	client    *rpc.Client
+}
+
+// NewSignerClient returns the client of the signer at the unix:// or tcp:// address, it dials on the first call
+func NewSignerClient(addr string, tlsConfig *tls.Config, timeout time.Duration) *SignerClient {
+	return &SignerClient{addr: addr, tlsConfig: tlsConfig, timeout: timeout}
+}
+
+func (c *SignerClient) conn() (*rpc.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.client != nil {
+		return c.client, nil
+	}
+	network, address, err := signerNetwork(c.addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: signerDialTimeout}, network, address, c.tlsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot reach the remote signer: %s", err.Error())
+	}
+	c.client = jsonrpc.NewClient(conn)
+	return c.client, nil
+}
+
+// reset drops a broken connection, so the next call dials again
+func (c *SignerClient) reset(client *rpc.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.client == client {
+		_ = c.client.Close()
+		c.client = nil
+	}
+}
+
+func (c *SignerClient) call(method string, args interface{}, reply interface{}) error {
+	client, err := c.conn()
+	if err != nil {
+		return err
+	}
+	call := client.Go(method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		err = call.Error
+	case <-time.After(c.timeout):
+		err = fmt.Errorf("the remote signer did not answer within %s", c.timeout)
+	}
+	if _, refused := err.(rpc.ServerError); err != nil && !refused {
+		c.reset(client)
+	}
+	return err
+}
+
+// Close closes the connection to the signer
+func (c *SignerClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.client == nil {
+		return nil
+	}
+	err := c.client.Close()
+	c.client = nil
+	return err
+}
+
+// Signers returns a signer for each key of the remote signer
+func (c *SignerClient) Signers() ([]pc.Signer, error) {
+	var keys []string
+	if err := c.call(signerPublicKeysMethod, struct{}{}, &keys); err != nil {
+		return nil, err
+	}
+	signers := make([]pc.Signer, len(keys))
+	for i, k := range keys {
+		pk, err := crypto.NewPublicKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("the remote signer returned an invalid public key %s: %s", k, err.Error())
+		}
+		signers[i] = RemoteSigner{client: c, publicKey: pk}
+	}
+	return signers, nil
+}
+
+var _ pc.Signer = RemoteSigner{}
+
+// RemoteSigner signs with a key of the remote signer
+type RemoteSigner struct {
+	client    *SignerClient
+	publicKey crypto.PublicKey
+}
+
+func (s RemoteSigner) PublicKey() crypto.PublicKey {
+	return s.publicKey
+}
+
+// Sign signs the sign bytes of a claim or proof transaction, the signer refuses anything else
+func (s RemoteSigner) Sign(msg []byte) ([]byte, error) {
+	return s.sign(SignerRequest{PublicKey: s.publicKey.RawString(), Tx: msg})
+}
+
+func (s RemoteSigner) SignRelayResponse(resp pc.RelayResponse) ([]byte, error) {
+	return s.sign(SignerRequest{PublicKey: s.publicKey.RawString(), Relay: &resp})
+}
+
+func (s RemoteSigner) sign(req SignerRequest) ([]byte, error) {
+	var res SignerResponse
+	if err := s.client.call(signerSignMethod, req, &res); err != nil {
+		return nil, err
+	}
+	if !s.publicKey.VerifyBytes(remoteSignBytes(req), res.Signature) {
+		return nil, errors.New("the remote signer returned an invalid signature")
+	}
+	return res.Signature, nil
+}
+
+// remoteSignBytes are the bytes signed for the request
+func remoteSignBytes(req SignerRequest) []byte {
+	if req.Relay != nil {
+		return req.Relay.Hash()
+	}
+	return req.Tx
+}
+
+// InitRemoteSigners adds a pocket node for each key of the configured remote signer
+func InitRemoteSigners(logger log.Logger) error {
+	c := GlobalConfig.PocketConfig
+	tlsConfig, err := SignerTLSConfig(c.RemoteSignerCertFile, c.RemoteSignerKeyFile, c.RemoteSignerCAFile, false)
+	if err != nil {
+		return err
+	}
+	client := NewSignerClient(c.RemoteSignerAddr, tlsConfig, time.Duration(c.RemoteSignerTimeout)*time.Millisecond)
+	signers, err := client.Signers()
+	if err != nil {
+		return err
+	}
+	if len(signers) == 0 {
+		return errors.New("the remote signer has no key")
+	}
+	for _, s := range signers {
+		pc.AddPocketNodeSigner(s, logger)
+	}
+	return nil
+}
+
+// CheckRemoteSignerValidators refuses the servicers of the remote signer that are in the validator set. The consensus
+// key of the node is its own generated priv_val_key, not the servicer key, so such a validator would miss its blocks and
+// be jailed, while serving the key to tendermint would leave the double sign protection to the remote signer
+func CheckRemoteSignerValidators(validators *tmTypes.ValidatorSet) error {
+	for _, node := range pc.GlobalPocketNodes {
+		if address := node.GetAddress(); validators.HasAddress(address) {
+			return fmt.Errorf("the servicer %s of the remote signer is in the validator set, a validator can't use a remote signer", address)
+		}
+	}
+	return nil
+}
+
+// SignerServer holds the servicer keys and signs for the nodes. A claim or proof is signed only once per session, for
+// the chain id of the network and the minimum fee: signing again is allowed only for the same sign bytes, so a relay
+// host can't get other transactions signed. A relay proof is answered only once
+type SignerServer struct {
+	keys          map[string]crypto.PrivateKey  // by hex public key
+	chainID       string                        // the chain id of the network of the nodes
+	feeMultiplier int64                         // the fee multiplier of the claims and proofs on the network
+	claims        map[string]string             // the sign bytes hash of the signed claims, by session
+	proofs        map[string]string             // the sign bytes hash of the signed proofs, by session
+	relays        map[int64]map[string]struct{} // signed relay proofs, by session height
+	mu            sync.Mutex
+	audit         *os.File
+	logger        log.Logger
+}
+
+// NewSignerServer returns the signer of the keys for the chain id, signing the claims and proofs with the fee of the
+// message times the fee multiplier only. The audit log is appended to and restores the signed claims and proofs of a
+// previous run
+func NewSignerServer(keys []crypto.PrivateKey, chainID string, feeMultiplier int64, auditLogPath string, logger log.Logger) (*SignerServer, error) {
+	if chainID == "" {
+		return nil, errors.New("the remote signer needs the chain id of the network")
+	}
+	if feeMultiplier <= 0 {
+		return nil, fmt.Errorf("invalid fee multiplier %d", feeMultiplier)
+	}
+	s := &SignerServer{
+		keys:          make(map[string]crypto.PrivateKey),
+		chainID:       chainID,
+		feeMultiplier: feeMultiplier,
+		claims:        make(map[string]string),
+		proofs:        make(map[string]string),
+		relays:        make(map[int64]map[string]struct{}),
+		logger:        logger,
+	}
+	for _, k := range keys {
+		s.keys[k.PublicKey().RawString()] = k
+	}
+	if err := s.restore(auditLogPath); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(auditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, signerAuditFileMode)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open the signer audit log: %s", err.Error())
+	}
+	s.audit = f
+	return s, nil
+}
+
+// restore reloads the claims and proofs signed before from the audit log
+func (s *SignerServer) restore(auditLogPath string) error {
+	f, err := os.Open(auditLogPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot read the signer audit log: %s", err.Error())
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e SignerAuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return fmt.Errorf("corrupt line in the signer audit log: %s", err.Error())
+		}
+		if !e.Signed {
+			continue
+		}
+		switch e.Kind {
+		case signerKindClaim:
+			s.claims[e.Session] = e.Hash
+		case signerKindProof:
+			s.proofs[e.Session] = e.Hash
+		}
+	}
+	return scanner.Err()
+}
+
+// Serve answers the nodes connecting on the listener until it is closed
+func (s *SignerServer) Serve(lis net.Listener) error {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+func (s *SignerServer) serveConn(conn net.Conn) {
+	defer conn.Close()
+	peer := conn.RemoteAddr().String()
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		if err := tlsConn.Handshake(); err != nil {
+			s.logger.Error(fmt.Sprintf("remote signer handshake with %s failed: %s", peer, err.Error()))
+			return
+		}
+		if certs := tlsConn.ConnectionState().PeerCertificates; len(certs) != 0 {
+			peer = certs[0].Subject.CommonName + "@" + peer
+		}
+	}
+	s.logger.Info("remote signer connection from " + peer)
+	srv := rpc.NewServer()
+	if err := srv.RegisterName(signerService, &signerConn{server: s, peer: peer}); err != nil {
+		s.logger.Error(err.Error())
+		return
+	}
+	srv.ServeCodec(jsonrpc.NewServerCodec(conn))
+}
+
+// Close closes the audit log
+func (s *SignerServer) Close() error {
+	return s.audit.Close()
+}
+
+// signerConn is the rpc service of a connected node
+type signerConn struct {
+	server *SignerServer
+	peer   string
+}
+
+func (c *signerConn) PublicKeys(_ struct{}, res *[]string) error {
+	keys := make([]string, 0, len(c.server.keys))
+	for k := range c.server.keys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	*res = keys
+	return nil
+}
+
+func (c *signerConn) Sign(req SignerRequest, res *SignerResponse) error {
+	sig, err := c.server.Sign(c.peer, req)
+	if err != nil {
+		return err
+	}
+	res.Signature = sig
+	return nil
+}
+
+// Sign checks the request against the double sign and replay protections, signs it and writes the audit log
+func (s *SignerServer) Sign(peer string, req SignerRequest) ([]byte, error) {
+	key, ok := s.keys[req.PublicKey]
+	if !ok {
+		return nil, fmt.Errorf("the signer has no key %s", req.PublicKey)
+	}
+	e := SignerAuditEntry{Time: time.Now().UTC(), Peer: peer, Address: sdk.GetAddress(key.PublicKey()).String()}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var record func()
+	var err error
+	if req.Relay != nil {
+		record, err = s.checkRelay(key, *req.Relay, &e)
+	} else {
+		record, err = s.checkTx(key, req.Tx, &e)
+	}
+	var sig []byte
+	if err == nil {
+		sig, err = key.Sign(remoteSignBytes(req))
+	}
+	if err != nil {
+		e.Error = err.Error()
+		s.writeAudit(e)
+		return nil, err
+	}
+	e.Signed = true
+	// the claims and proofs are logged before the signature is returned, so a restart never forgets them
+	if e.Kind != signerKindRelay {
+		if err := s.writeAudit(e); err != nil {
+			return nil, err
+		}
+	}
+	record()
+	return sig, nil
+}
+
+func (s *SignerServer) checkRelay(key crypto.PrivateKey, resp pc.RelayResponse, e *SignerAuditEntry) (func(), error) {
+	e.Kind = signerKindRelay
+	e.Hash = resp.Proof.HashString()
+	if resp.Proof.ServicerPubKey != key.PublicKey().RawString() {
+		return nil, errors.New("the relay proof is for another servicer")
+	}
+	height := resp.Proof.SessionBlockHeight
+	if _, replayed := s.relays[height][e.Hash]; replayed {
+		return nil, errors.New("the relay proof was already signed for")
+	}
+	return func() {
+		if s.relays[height] == nil {
+			s.relays[height] = make(map[string]struct{})
+			s.pruneRelays()
+		}
+		s.relays[height][e.Hash] = struct{}{}
+	}, nil
+}
+
+// pruneRelays forgets the relays of the oldest sessions, their proofs cannot be claimed anymore
+func (s *SignerServer) pruneRelays() {
+	for len(s.relays) > signerRelaySessions {
+		oldest := int64(-1)
+		for h := range s.relays {
+			if oldest == -1 || h < oldest {
+				oldest = h
+			}
+		}
+		delete(s.relays, oldest)
+	}
+}
+
+func (s *SignerServer) checkTx(key crypto.PrivateKey, signBytes []byte, e *SignerAuditEntry) (func(), error) {
+	e.Kind = signerKindTx
+	var doc struct {
+		ChainID string          `json:"chain_id"`
+		Fee     sdk.Coins       `json:"fee"`
+		Msg     json.RawMessage `json:"msg"`
+	}
+	if err := json.Unmarshal(signBytes, &doc); err != nil {
+		return nil, fmt.Errorf("the sign bytes are not a transaction: %s", err.Error())
+	}
+	// the whole sign bytes, with the entropy, fee and memo, are what is signed once
+	h := sha256.Sum256(signBytes)
+	e.Hash = hex.EncodeToString(h[:])
+	var msg sdk.Msg
+	if err := pc.ModuleCdc.UnmarshalJSON(doc.Msg, &msg); err != nil {
+		return nil, fmt.Errorf("the signer only signs claims and proofs: %s", err.Error())
+	}
+	if doc.ChainID != s.chainID {
+		return nil, fmt.Errorf("the transaction is for the chain %s, not %s", doc.ChainID, s.chainID)
+	}
+	fee := sdk.NewCoin(sdk.DefaultStakeDenom, msg.GetFee().Mul(sdk.NewInt(s.feeMultiplier)))
+	if len(doc.Fee) != 1 || doc.Fee[0].String() != fee.String() {
+		return nil, fmt.Errorf("the transaction fee %s is not the fee %s of the %s", doc.Fee, fee, msg.Type())
+	}
+	address := sdk.GetAddress(key.PublicKey())
+	var signed map[string]string
+	switch m := msg.(type) {
+	case pc.MsgClaim:
+		e.Kind, signed = signerKindClaim, s.claims
+		if !m.FromAddress.Equals(address) {
+			return nil, errors.New("the claim is from another address")
+		}
+		e.Session = signerSession(address, m.SessionHeader, m.EvidenceType)
+	case pc.MsgProof:
+		e.Kind, signed = signerKindProof, s.proofs
+		if m.Leaf == nil || !m.Leaf.GetSigner().Equals(address) {
+			return nil, errors.New("the proof is for another servicer")
+		}
+		e.Session = signerSession(address, m.Leaf.SessionHeader(), m.EvidenceType)
+	default:
+		return nil, fmt.Errorf("the signer only signs claims and proofs, not %s", msg.Type())
+	}
+	// only a retry of the same sign bytes is signed again, which gives the same signature
+	if prev, ok := signed[e.Session]; ok && prev != e.Hash {
+		return nil, fmt.Errorf("a %s was already signed for this session", e.Kind)
+	}
+	return func() { signed[e.Session] = e.Hash }, nil
+}
+
+func signerSession(address sdk.Address, header pc.SessionHeader, evidenceType pc.EvidenceType) string {
+	return fmt.Sprintf("%s/%s/%d", address.String(), header.HashString(), evidenceType)
+}
+
+func (s *SignerServer) writeAudit(e SignerAuditEntry) error {
+	bz, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err := s.audit.Write(append(bz, '\n')); err != nil {
+		s.logger.Error("cannot write the signer audit log: " + err.Error())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package app
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// writeTestCert writes a certificate signed by the parent, or a ca when the parent is nil, and returns its files
+func writeTestCert(t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (certFile, keyFile string, cert *x509.Certificate, key *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+	cert, err = x509.ParseCertificate(der)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+	dir := t.TempDir()
+	certFile, keyFile = filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	require.Nil(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.Nil(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile, cert, key
+}
+
+func testClaimSignBytes(t *testing.T, address sdk.Address, header pc.SessionHeader, totalProofs int64) []byte {
+	return testClaimTxSignBytes(t, "test", 1, pc.ClaimFee, address, header, totalProofs)
+}
+
+func testClaimTxSignBytes(t *testing.T, chainID string, entropy, fee int64, address sdk.Address, header pc.SessionHeader, totalProofs int64) []byte {
+	msg := &pc.MsgClaim{
+		SessionHeader: header,
+		MerkleRoot:    pc.HashRange{Hash: []byte("root"), Range: pc.Range{Upper: 10}},
+		TotalProofs:   totalProofs,
+		FromAddress:   address,
+		EvidenceType:  pc.RelayEvidence,
+	}
+	bz, err := auth.StdSignBytes(chainID, entropy, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee))), msg, "")
+	require.Nil(t, err)
+	return bz
+}
+
+func TestRemoteSigner(t *testing.T) {
+	MakeCodec()
+	caFile, _, ca, caKey := writeTestCert(t, "ca", nil, nil)
+	signerCert, signerKey, _, _ := writeTestCert(t, "signer", ca, caKey)
+	nodeCert, nodeKey, _, _ := writeTestCert(t, "node", ca, caKey)
+	key := crypto.GenerateEd25519PrivKey()
+	address := sdk.GetAddress(key.PublicKey())
+	auditLog := filepath.Join(t.TempDir(), "audit.log")
+
+	serverTLS, err := SignerTLSConfig(signerCert, signerKey, caFile, true)
+	require.Nil(t, err)
+	start := func() (addr string, stop func()) {
+		s, err := NewSignerServer([]crypto.PrivateKey{key}, "test", 1, auditLog, log.NewNopLogger())
+		require.Nil(t, err)
+		lis, err := ListenSigner("tcp://127.0.0.1:0", serverTLS)
+		require.Nil(t, err)
+		go func() { _ = s.Serve(lis) }()
+		return "tcp://" + lis.Addr().String(), func() {
+			_ = lis.Close()
+			_ = s.Close()
+		}
+	}
+	connect := func(addr string) pc.Signer {
+		clientTLS, err := SignerTLSConfig(nodeCert, nodeKey, caFile, false)
+		require.Nil(t, err)
+		c := NewSignerClient(addr, clientTLS, 5*time.Second)
+		t.Cleanup(func() { _ = c.Close() })
+		signers, err := c.Signers()
+		require.Nil(t, err)
+		require.Len(t, signers, 1)
+		return signers[0]
+	}
+	addr, stop := start()
+	signer := connect(addr)
+	assert.Equal(t, key.PublicKey().RawString(), signer.PublicKey().RawString())
+
+	// relays are answered once
+	resp := pc.RelayResponse{
+		Response: "foo",
+		Proof: pc.RelayProof{
+			RequestHash:        "hash",
+			Entropy:            1,
+			SessionBlockHeight: 1,
+			ServicerPubKey:     key.PublicKey().RawString(),
+			Blockchain:         "0001",
+		},
+	}
+	sig, err := signer.SignRelayResponse(resp)
+	require.Nil(t, err)
+	assert.True(t, key.PublicKey().VerifyBytes(resp.Hash(), sig))
+	_, err = signer.SignRelayResponse(resp)
+	assert.NotNil(t, err)
+	other := resp
+	other.Proof.ServicerPubKey = crypto.GenerateEd25519PrivKey().PublicKey().RawString()
+	_, err = signer.SignRelayResponse(other)
+	assert.NotNil(t, err)
+
+	// only the claims of the chain with the minimum fee are signed
+	header := pc.SessionHeader{ApplicationPubKey: crypto.GenerateEd25519PrivKey().PublicKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	_, err = signer.Sign(testClaimTxSignBytes(t, "other", 1, pc.ClaimFee, address, header, 5))
+	assert.NotNil(t, err)
+	_, err = signer.Sign(testClaimTxSignBytes(t, "test", 1, 100*pc.ClaimFee, address, header, 5))
+	assert.NotNil(t, err)
+	// a claim is signed again only when it is the same sign bytes, not with a new entropy
+	claim := testClaimSignBytes(t, address, header, 5)
+	sig, err = signer.Sign(claim)
+	require.Nil(t, err)
+	assert.True(t, key.PublicKey().VerifyBytes(claim, sig))
+	_, err = signer.Sign(testClaimSignBytes(t, address, header, 5))
+	assert.Nil(t, err)
+	_, err = signer.Sign(testClaimTxSignBytes(t, "test", 2, pc.ClaimFee, address, header, 5))
+	assert.NotNil(t, err)
+	_, err = signer.Sign(testClaimSignBytes(t, address, header, 6))
+	assert.NotNil(t, err)
+	_, err = signer.Sign(testClaimSignBytes(t, sdk.GetAddress(crypto.GenerateEd25519PrivKey().PublicKey()), header, 5))
+	assert.NotNil(t, err)
+
+	// anything else is refused
+	send := &nodesTypes.MsgSend{FromAddress: address, ToAddress: address, Amount: sdk.NewInt(1)}
+	sendBz, err := auth.StdSignBytes("test", 1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(10000))), send, "")
+	require.Nil(t, err)
+	_, err = signer.Sign(sendBz)
+	assert.NotNil(t, err)
+
+	// the audit log restores the signed claims
+	stop()
+	addr, stop = start()
+	defer stop()
+	signer = connect(addr)
+	_, err = signer.Sign(testClaimSignBytes(t, address, header, 6))
+	assert.NotNil(t, err)
+	_, err = signer.Sign(testClaimSignBytes(t, address, header, 5))
+	assert.Nil(t, err)
+
+	// the nodes must present a certificate of the ca
+	_, _, otherCA, otherCAKey := writeTestCert(t, "other", nil, nil)
+	strangerCert, strangerKey, _, _ := writeTestCert(t, "stranger", otherCA, otherCAKey)
+	strangerTLS, err := SignerTLSConfig(strangerCert, strangerKey, caFile, false)
+	require.Nil(t, err)
+	_, err = NewSignerClient(addr, strangerTLS, 5*time.Second).Signers()
+	assert.NotNil(t, err)
+}
+
+func TestCheckRemoteSignerValidators(t *testing.T) {
+	previous := pc.GlobalPocketNodes
+	pc.GlobalPocketNodes = map[string]*pc.PocketNode{}
+	defer func() { pc.GlobalPocketNodes = previous }()
+	servicer := crypto.GenerateEd25519PrivKey()
+	pc.AddPocketNode(servicer, log.NewNopLogger())
+	validator := tmTypes.NewValidator(crypto.GenerateEd25519PrivKey().PublicKey().PubKey(), 10)
+	assert.Nil(t, CheckRemoteSignerValidators(tmTypes.NewValidatorSet([]*tmTypes.Validator{validator})))
+	servicerValidator := tmTypes.NewValidator(servicer.PublicKey().PubKey(), 10)
+	assert.NotNil(t, CheckRemoteSignerValidators(tmTypes.NewValidatorSet([]*tmTypes.Validator{validator, servicerValidator})))
+}
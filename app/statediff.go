@@ -0,0 +1,233 @@
+package app
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// stateDiffModule is the part of a substore decoded by the state diff: the keys under the prefix are entries, the
+// other keys of the substore index or cache them and are only counted
+type stateDiffModule struct {
+	name   string
+	store  string
+	prefix []byte
+}
+
+// stateDiffModules are the modules of the state diff, in the order they are printed
+var stateDiffModules = []stateDiffModule{
+	{name: "accounts", store: authTypes.StoreKey, prefix: authTypes.AddressStoreKeyPrefix},
+	{name: "validators", store: nodesTypes.StoreKey, prefix: nodesTypes.AllValidatorsKey},
+	{name: "apps", store: appsTypes.StoreKey, prefix: appsTypes.AllApplicationsKey},
+	{name: "params", store: sdk.ParamsKey.Name()},
+	{name: "claims", store: pocketTypes.StoreKey, prefix: pocketTypes.ClaimKey},
+}
+
+// StateDiffModuleNames are the modules a state diff can be restricted to
+func StateDiffModuleNames() []string {
+	names := make([]string, len(stateDiffModules))
+	for i, m := range stateDiffModules {
+		names[i] = m.name
+	}
+	return names
+}
+
+// StateDiff is the decoded difference of the state between two heights, module by module
+type StateDiff struct {
+	HeightA int64             `json:"height_a"`
+	HeightB int64             `json:"height_b"`
+	Modules []ModuleStateDiff `json:"modules"`
+}
+
+// ModuleStateDiff are the entries of a module that differ between the two heights
+type ModuleStateDiff struct {
+	Module  string        `json:"module"`
+	Changes []StateChange `json:"changes"`
+	Other   int           `json:"other_keys"` // the other keys of the substore that differ, indexes and caches of the entries
+}
+
+// StateChange is an entry of a module that differs between the two heights: added, removed or changed
+type StateChange struct {
+	Key    string              `json:"key"`              // the address of an account, a validator or an application, the name of a param, the address and header hash of a claim
+	Left   interface{}         `json:"left"`             // the entry at height a, nil if added
+	Right  interface{}         `json:"right"`            // the entry at height b, nil if removed
+	Fields []GenesisDifference `json:"fields,omitempty"` // the fields of a changed entry that differ
+}
+
+// Change names the change of the entry
+func (c StateChange) Change() string {
+	switch {
+	case c.Left == nil:
+		return "added"
+	case c.Right == nil:
+		return "removed"
+	default:
+		return "changed"
+	}
+}
+
+// StateDiff opens the state at both heights and walks the substores of the modules (every module if none is given)
+// to decode the entries that differ
+func (app *PocketCoreApp) StateDiff(heightA, heightB int64, modules []string) (StateDiff, error) {
+	ctxA, err := app.NewContext(heightA)
+	if err != nil {
+		return StateDiff{}, fmt.Errorf("cannot load the state at height %d: %s", heightA, err)
+	}
+	ctxB, err := app.NewContext(heightB)
+	if err != nil {
+		return StateDiff{}, fmt.Errorf("cannot load the state at height %d: %s", heightB, err)
+	}
+	storeKeys := make(map[string]sdk.StoreKey, len(app.Keys)+1)
+	for name, key := range app.Keys {
+		storeKeys[name] = key
+	}
+	storeKeys[sdk.ParamsKey.Name()] = sdk.ParamsKey
+	return diffState(ctxA.MultiStore(), ctxB.MultiStore(), storeKeys, heightA, heightB, modules)
+}
+
+// diffState walks the substores of the modules in both multistores, in key order
+func diffState(a, b sdk.MultiStore, storeKeys map[string]sdk.StoreKey, heightA, heightB int64, modules []string) (StateDiff, error) {
+	selected := make(map[string]bool, len(modules))
+	for _, name := range modules {
+		found := false
+		for _, m := range stateDiffModules {
+			found = found || m.name == name
+		}
+		if !found {
+			return StateDiff{}, fmt.Errorf("unknown module %s, the modules are %s", name, strings.Join(StateDiffModuleNames(), ", "))
+		}
+		selected[name] = true
+	}
+	diff := StateDiff{HeightA: heightA, HeightB: heightB}
+	for _, m := range stateDiffModules {
+		if len(selected) != 0 && !selected[m.name] {
+			continue
+		}
+		key, ok := storeKeys[m.store]
+		if !ok {
+			return StateDiff{}, fmt.Errorf("the store %s of the module %s is not mounted", m.store, m.name)
+		}
+		md, err := diffModule(m, a.GetKVStore(key), b.GetKVStore(key), heightA, heightB)
+		if err != nil {
+			return StateDiff{}, fmt.Errorf("cannot diff the module %s: %s", m.name, err)
+		}
+		diff.Modules = append(diff.Modules, md)
+	}
+	return diff, nil
+}
+
+// diffModule merges the iterators of the substore at both heights, as they are both sorted by key
+func diffModule(m stateDiffModule, a, b sdk.KVStore, heightA, heightB int64) (ModuleStateDiff, error) {
+	md := ModuleStateDiff{Module: m.name, Changes: []StateChange{}}
+	itA, err := a.Iterator(nil, nil)
+	if err != nil {
+		return md, err
+	}
+	defer itA.Close()
+	itB, err := b.Iterator(nil, nil)
+	if err != nil {
+		return md, err
+	}
+	defer itB.Close()
+	for itA.Valid() || itB.Valid() {
+		var key, valueA, valueB []byte
+		nextA, nextB := itA.Valid(), itB.Valid()
+		switch {
+		case !itB.Valid() || (itA.Valid() && bytes.Compare(itA.Key(), itB.Key()) < 0):
+			key, valueA, nextB = itA.Key(), itA.Value(), false
+		case !itA.Valid() || bytes.Compare(itA.Key(), itB.Key()) > 0:
+			key, valueB, nextA = itB.Key(), itB.Value(), false
+		default:
+			key, valueA, valueB = itA.Key(), itA.Value(), itB.Value()
+		}
+		if err := md.add(m, key, valueA, valueB, heightA, heightB); err != nil {
+			return md, err
+		}
+		if nextA {
+			itA.Next()
+		}
+		if nextB {
+			itB.Next()
+		}
+	}
+	return md, nil
+}
+
+// add records the entry stored at the key if its values at both heights differ
+func (md *ModuleStateDiff) add(m stateDiffModule, key, valueA, valueB []byte, heightA, heightB int64) (err error) {
+	if bytes.Equal(valueA, valueB) {
+		return nil
+	}
+	if !bytes.HasPrefix(key, m.prefix) {
+		md.Other++
+		return nil
+	}
+	c := StateChange{Key: stateDiffKey(m, key)}
+	if c.Left, err = decodeStateEntry(m.store, valueA, heightA); err != nil {
+		return fmt.Errorf("cannot decode %s at height %d: %s", c.Key, heightA, err)
+	}
+	if c.Right, err = decodeStateEntry(m.store, valueB, heightB); err != nil {
+		return fmt.Errorf("cannot decode %s at height %d: %s", c.Key, heightB, err)
+	}
+	if c.Left != nil && c.Right != nil {
+		for _, d := range diffJSON("", c.Left, c.Right, nil) {
+			if d.Path == "" {
+				// a scalar param, the change is the value itself
+				continue
+			}
+			d.Path = strings.TrimPrefix(d.Path, ".")
+			c.Fields = append(c.Fields, d)
+		}
+	}
+	md.Changes = append(md.Changes, c)
+	return nil
+}
+
+// stateDiffKey names the entry stored at the key
+func stateDiffKey(m stateDiffModule, key []byte) string {
+	key = key[len(m.prefix):]
+	switch m.store {
+	case sdk.ParamsKey.Name():
+		return string(key)
+	case pocketTypes.StoreKey:
+		if len(key) > sdk.AddrLen {
+			return sdk.Address(key[:sdk.AddrLen]).String() + "/" + hex.EncodeToString(key[sdk.AddrLen:])
+		}
+	}
+	return sdk.Address(key).String()
+}
+
+// decodeStateEntry decodes the value the way the keepers do, then to generic json so entries are compared field by field
+func decodeStateEntry(store string, value []byte, height int64) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	v, err := decodeStoreValue(store, value, height)
+	if err != nil {
+		return nil, err
+	}
+	bz, ok := v.(json.RawMessage)
+	if !ok {
+		if bz, err = Codec().MarshalJSON(v); err != nil {
+			return nil, err
+		}
+	}
+	var entry interface{}
+	if err := json.Unmarshal(bz, &entry); err != nil {
+		// not every param is json
+		return string(value), nil
+	}
+	// drop the amino envelope of the accounts and claims, the type is the module
+	if obj, ok := entry.(map[string]interface{}); ok && len(obj) == 2 && obj["type"] != nil && obj["value"] != nil {
+		return obj["value"], nil
+	}
+	return entry, nil
+}
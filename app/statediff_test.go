@@ -0,0 +1,86 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	sdk "github.com/pokt-network/pocket-core/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestDiffState(t *testing.T) {
+	MakeCodec()
+	rs := rootmulti.NewStore(dbm.NewMemDB(), false, 5000000)
+	storeKeys := map[string]sdk.StoreKey{
+		authTypes.StoreKey:   sdk.NewKVStoreKey(authTypes.StoreKey),
+		sdk.ParamsKey.Name(): sdk.ParamsKey,
+	}
+	for _, key := range storeKeys {
+		rs.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	}
+	require.Nil(t, rs.LoadLatestVersion())
+	accounts := rs.GetKVStore(storeKeys[authTypes.StoreKey])
+	params := rs.GetKVStore(storeKeys[sdk.ParamsKey.Name()])
+	setAccount := func(addr sdk.Address, amount int64, height int64) {
+		acc := authTypes.BaseAccount{Address: addr, Coins: sdk.NewCoins(sdk.NewCoin("upokt", sdk.NewInt(amount)))}
+		bz, err := Codec().MarshalBinaryBare(&acc, height)
+		require.Nil(t, err)
+		require.Nil(t, accounts.Set(authTypes.AddressStoreKey(addr), bz))
+	}
+	changed := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	removed := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	added := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	same := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+
+	// height 1
+	setAccount(changed, 10, 1)
+	setAccount(removed, 20, 1)
+	setAccount(same, 30, 1)
+	require.Nil(t, accounts.Set(authTypes.SupplyKeyPrefix, []byte{0x01}))
+	require.Nil(t, params.Set([]byte("pos/StakeMinimum"), []byte(`"15000000000"`)))
+	rs.Commit()
+	// height 2
+	setAccount(changed, 15, 2)
+	require.Nil(t, accounts.Delete(authTypes.AddressStoreKey(removed)))
+	setAccount(added, 40, 2)
+	require.Nil(t, accounts.Set(authTypes.SupplyKeyPrefix, []byte{0x02}))
+	require.Nil(t, params.Set([]byte("pos/StakeMinimum"), []byte(`"16000000000"`)))
+	rs.Commit()
+
+	a, err := rs.LoadLazyVersion(1)
+	require.Nil(t, err)
+	b, err := rs.LoadLazyVersion(2)
+	require.Nil(t, err)
+	diff, err := diffState((*a).(sdk.MultiStore), (*b).(sdk.MultiStore), storeKeys, 1, 2, []string{"accounts", "params"})
+	require.Nil(t, err)
+	require.Len(t, diff.Modules, 2)
+
+	acc := diff.Modules[0]
+	assert.Equal(t, "accounts", acc.Module)
+	assert.Equal(t, 1, acc.Other)
+	require.Len(t, acc.Changes, 3)
+	changes := make(map[string]StateChange)
+	for _, c := range acc.Changes {
+		changes[c.Key] = c
+	}
+	assert.Equal(t, "changed", changes[changed.String()].Change())
+	assert.Equal(t, []GenesisDifference{{Path: "coins[0].amount", Left: "10", Right: "15"}}, changes[changed.String()].Fields)
+	assert.Equal(t, "removed", changes[removed.String()].Change())
+	assert.Equal(t, "added", changes[added.String()].Change())
+	assert.NotContains(t, changes, same.String())
+
+	p := diff.Modules[1]
+	assert.Equal(t, "params", p.Module)
+	require.Len(t, p.Changes, 1)
+	assert.Equal(t, "pos/StakeMinimum", p.Changes[0].Key)
+	assert.Equal(t, "15000000000", p.Changes[0].Left)
+	assert.Equal(t, "16000000000", p.Changes[0].Right)
+	assert.Empty(t, p.Changes[0].Fields)
+
+	_, err = diffState((*a).(sdk.MultiStore), (*b).(sdk.MultiStore), storeKeys, 1, 2, []string{"unknown"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,193 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/pokt-network/pocket-core/crypto/keys"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	"github.com/tendermint/tendermint/libs/rand"
+)
+
+// TxFile is a transaction written to a file, so it can be built, signed and broadcast on different hosts. Tx is the
+// amino json of the StdTx, without a signature until it is signed
+type TxFile struct {
+	ChainID     string          `json:"chain_id"`
+	Signer      string          `json:"signer"` // hex address of the expected signer
+	LegacyCodec bool            `json:"legacy_codec"`
+	Tx          json.RawMessage `json:"tx"`
+}
+
+// NewTxFile returns the unsigned transaction of the msg
+func NewTxFile(msg sdk.ProtoMsg, signer sdk.Address, chainID string, fee int64, memo string, legacyCodec bool) (TxFile, error) {
+	tx := authTypes.StdTx{
+		Msg:     msg,
+		Fee:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee))),
+		Memo:    memo,
+		Entropy: rand.Int64(),
+	}
+	f := TxFile{ChainID: chainID, Signer: signer.String(), LegacyCodec: legacyCodec}
+	err := f.setStdTx(tx)
+	return f, err
+}
+
+// ReadTxFile reads the transaction file at path
+func ReadTxFile(path string) (f TxFile, err error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(bz, &f); err != nil {
+		return f, fmt.Errorf("%s is not a transaction file: %s", path, err.Error())
+	}
+	_, err = f.StdTx()
+	return
+}
+
+// Write writes the transaction file to path
+func (f TxFile) Write(path string) error {
+	bz, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, bz, 0644)
+}
+
+// StdTx decodes the transaction of the file
+func (f TxFile) StdTx() (tx authTypes.StdTx, err error) {
+	if err = cdc.UnmarshalJSON(f.Tx, &tx); err != nil {
+		return
+	}
+	if tx.Msg == nil {
+		return tx, fmt.Errorf("the transaction has no message")
+	}
+	// the json decoding returns the msg by value
+	tx.Msg, err = toProtoMsg(tx.Msg)
+	return
+}
+
+func (f *TxFile) setStdTx(tx authTypes.StdTx) error {
+	bz, err := cdc.MarshalJSON(tx)
+	if err != nil {
+		return err
+	}
+	f.Tx = bz
+	return nil
+}
+
+// IsSigned tells whether the transaction carries a signature
+func (f TxFile) IsSigned() bool {
+	tx, err := f.StdTx()
+	return err == nil && len(tx.Signature.Signature) != 0
+}
+
+// Sign signs the transaction with the key of the signer
+func (f TxFile) Sign(kb keys.Keybase, passphrase string) (TxFile, error) {
+	signer, err := sdk.AddressFromHex(f.Signer)
+	if err != nil {
+		return f, err
+	}
+	tx, err := f.StdTx()
+	if err != nil {
+		return f, err
+	}
+	signBytes, err := auth.StdSignBytes(f.ChainID, tx.Entropy, tx.Fee, tx.Msg, tx.Memo)
+	if err != nil {
+		return f, err
+	}
+	sig, pubKey, err := kb.Sign(signer, passphrase, signBytes)
+	if err != nil {
+		return f, err
+	}
+	tx.Signature = authTypes.StdSignature{PublicKey: pubKey, Signature: sig}
+	err = f.setStdTx(tx)
+	return f, err
+}
+
+// Encode returns the encoded transaction, as sent to /v1/client/rawtx
+func (f TxFile) Encode() ([]byte, error) {
+	tx, err := f.StdTx()
+	if err != nil {
+		return nil, err
+	}
+	return auth.DefaultTxEncoder(cdc)(tx, f.codecHeight())
+}
+
+// SetEncoded replaces the transaction with an encoded one, such as a multisig transaction signed by one more key
+func (f TxFile) SetEncoded(txBz []byte) (TxFile, error) {
+	tx, decodeErr := auth.DefaultTxDecoder(cdc)(txBz, f.codecHeight())
+	if decodeErr != nil {
+		return f, decodeErr
+	}
+	err := f.setStdTx(tx.(authTypes.StdTx))
+	return f, err
+}
+
+// BuildMultisigTxFile makes the transaction of the file a multisig transaction of the public key, signed by fromAddr as
+// the first signer
+func BuildMultisigTxFile(fromAddr string, f TxFile, passphrase string, pk crypto.PublicKeyMultiSig) (TxFile, error) {
+	tx, err := f.StdTx()
+	if err != nil {
+		return f, err
+	}
+	tx.Signature = authTypes.StdSignature{PublicKey: pk}
+	if err = f.setStdTx(tx); err != nil {
+		return f, err
+	}
+	return SignMultisigTxFile(fromAddr, f, passphrase, nil)
+}
+
+// SignMultisigTxFile adds the signature of fromAddr to the multisig transaction of the file, at its place in the ordered
+// keys when given, or else as the next signer
+func SignMultisigTxFile(fromAddr string, f TxFile, passphrase string, keys []crypto.PublicKey) (TxFile, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return f, err
+	}
+	bz, err := f.Encode()
+	if err != nil {
+		return f, err
+	}
+	kb, err := GetKeybase()
+	if err != nil {
+		return f, err
+	}
+	txBuilder := auth.NewTxBuilder(
+		auth.DefaultTxEncoder(cdc),
+		auth.DefaultTxDecoder(cdc),
+		f.ChainID,
+		"", nil).WithKeybase(kb)
+	signed, err := txBuilder.SignMultisigTransaction(fa, keys, passphrase, bz, f.LegacyCodec)
+	if err != nil {
+		return f, err
+	}
+	return f.SetEncoded(signed)
+}
+
+// codecHeight selects the amino encoding of the legacy codec, or else the protobuf one
+func (f TxFile) codecHeight() int64 {
+	if f.LegacyCodec {
+		return 0
+	}
+	return -1
+}
+
+// toProtoMsg returns a pointer to the msg, the proto msgs are implemented by the pointers
+func toProtoMsg(m sdk.Msg) (sdk.ProtoMsg, error) {
+	if pm, ok := m.(sdk.ProtoMsg); ok {
+		return pm, nil
+	}
+	val := reflect.ValueOf(m)
+	vp := reflect.New(val.Type())
+	vp.Elem().Set(val)
+	pm, ok := vp.Interface().(sdk.ProtoMsg)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert %T to a proto msg", m)
+	}
+	return pm, nil
+}
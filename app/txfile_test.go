@@ -0,0 +1,55 @@
+package app
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto/keys"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxFile(t *testing.T) {
+	MakeCodec()
+	kb := keys.NewInMemory()
+	kp, err := kb.Create("test")
+	require.Nil(t, err)
+	kp2, err := kb.Create("test")
+	require.Nil(t, err)
+	msg := &types.MsgSend{FromAddress: kp.GetAddress(), ToAddress: kp2.GetAddress(), Amount: sdk.NewInt(1)}
+	for _, legacyCodec := range []bool{true, false} {
+		path := filepath.Join(t.TempDir(), "tx.json")
+		f, err := NewTxFile(msg, kp.GetAddress(), "testnet", 10000, "memo", legacyCodec)
+		require.Nil(t, err)
+		require.Nil(t, f.Write(path))
+		f, err = ReadTxFile(path)
+		require.Nil(t, err)
+		assert.False(t, f.IsSigned())
+		tx, err := f.StdTx()
+		require.Nil(t, err)
+		assert.Equal(t, msg, tx.Msg)
+		assert.Equal(t, "memo", tx.Memo)
+
+		_, err = f.Sign(kb, "wrong")
+		assert.NotNil(t, err)
+		f, err = f.Sign(kb, "test")
+		require.Nil(t, err)
+		assert.True(t, f.IsSigned())
+		tx, err = f.StdTx()
+		require.Nil(t, err)
+		signBytes, err := auth.StdSignBytes("testnet", tx.Entropy, tx.Fee, tx.Msg, tx.Memo)
+		require.Nil(t, err)
+		assert.True(t, kp.PublicKey.VerifyBytes(signBytes, tx.Signature.Signature))
+
+		bz, err := f.Encode()
+		require.Nil(t, err)
+		decoded, err := f.SetEncoded(bz)
+		require.Nil(t, err)
+		assert.Equal(t, string(f.Tx), string(decoded.Tx))
+	}
+	_, err = ReadTxFile(filepath.Join(t.TempDir(), "missing.json"))
+	assert.NotNil(t, err)
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"reflect"
 
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -32,11 +31,10 @@ func BuildMultisig(fromAddr, jsonMessage, passphrase, chainID string, pk crypto.
 	if err := Codec().UnmarshalJSON([]byte(jsonMessage), &m); err != nil {
 		return nil, err
 	}
-	// use reflection to convert to proto msg
-	val := reflect.ValueOf(m)
-	vp := reflect.New(val.Type())
-	vp.Elem().Set(val)
-	protoMsg := vp.Interface().(sdk.ProtoMsg)
+	protoMsg, err := toProtoMsg(m)
+	if err != nil {
+		return nil, err
+	}
 	kb, err := GetKeybase()
 	if err != nil {
 		return nil, err
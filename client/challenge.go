@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// ConsensusNodes is the number of servicers a consensus relay is sent to, a majority of 2 against 1 is a challenge
+const ConsensusNodes = 3
+
+// ConsensusResult is the outcome of a relay sent to several servicers of the session
+type ConsensusResult struct {
+	Response    pocketTypes.RelayResponse              `json:"response"`               // the majority response
+	Responses   []pocketTypes.RelayResponse            `json:"responses"`              // every response, in the order of the nodes
+	Challenge   *pocketTypes.ChallengeProofInvalidData `json:"challenge,omitempty"`    // the challenge of the minority response, if any
+	ReporterURL string                                 `json:"reporter_url,omitempty"` // the service url of the reporter of the challenge
+}
+
+// ConsensusRelay sends the same request to three servicers of the session and compares their responses.
+// When one servicer disagrees with the other two, the result carries a challenge of its response, reported by one
+// of the majority servicers, ready for SubmitChallenge
+func (c *Client) ConsensusRelay(ctx context.Context, chain string, payload pocketTypes.Payload) (*ConsensusResult, error) {
+	session, err := c.Session(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+	if len(session.Nodes) < ConsensusNodes {
+		return nil, ErrNotEnoughNodes
+	}
+	// the same metadata keeps the request hash identical across the servicers
+	height := session.EstimatedHeight(c.config.BlockTime)
+	nodes := make([]nodesTypes.Validator, ConsensusNodes)
+	relays := make([]pocketTypes.Relay, ConsensusNodes)
+	for i := range nodes {
+		// consecutive picks are distinct nodes of the session
+		if nodes[i], err = session.NextNode(); err != nil {
+			return nil, err
+		}
+		if relays[i], err = c.newRelay(session, nodes[i], payload, height); err != nil {
+			return nil, err
+		}
+	}
+	responses := make([]*pocketTypes.RelayResponse, ConsensusNodes)
+	errs := make([]error, ConsensusNodes)
+	var wg sync.WaitGroup
+	for i := range nodes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i], errs[i] = c.SendRelay(ctx, nodes[i], relays[i])
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	res := &ConsensusResult{}
+	for _, r := range responses {
+		res.Responses = append(res.Responses, *r)
+	}
+	// find the response the majority agrees on
+	a, b, m := sortedJSON(responses[0].Response), sortedJSON(responses[1].Response), sortedJSON(responses[2].Response)
+	var majority [2]int
+	var minority int
+	switch {
+	case a == b && b == m:
+		res.Response = *responses[0]
+		return res, nil
+	case a == b:
+		majority, minority = [2]int{0, 1}, 2
+	case a == m:
+		majority, minority = [2]int{0, 2}, 1
+	case b == m:
+		majority, minority = [2]int{1, 2}, 0
+	default:
+		return res, ErrNoMajorityResponse
+	}
+	res.Response = *responses[majority[0]]
+	res.Challenge = &pocketTypes.ChallengeProofInvalidData{
+		MajorityResponses: []pocketTypes.RelayResponse{*responses[majority[0]], *responses[majority[1]]},
+		MinorityResponse:  *responses[minority],
+		ReporterAddress:   nodes[majority[0]].Address,
+	}
+	res.ReporterURL = nodes[majority[0]].ServiceURL
+	return res, nil
+}
+
+// SubmitChallenge sends the challenge to its reporter, a servicer of the session that gets paid for it
+func (c *Client) SubmitChallenge(ctx context.Context, reporterURL string, challenge pocketTypes.ChallengeProofInvalidData) (*pocketTypes.ChallengeResponse, error) {
+	if err := challenge.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	var res pocketTypes.ChallengeResponse
+	url := joinURL(reporterURL, ChallengePath)
+	bz, code, err := c.post(ctx, url, challenge, &res)
+	if err != nil {
+		return nil, err
+	}
+	if code != 200 {
+		return nil, fmt.Errorf("challenge to %s failed with status %d: %s", url, code, string(bz))
+	}
+	return &res, nil
+}
+
+// sortedJSON normalizes json objects so equal responses compare equal regardless of key order, like the servicers do
+func sortedJSON(response string) string {
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(response), &raw); err != nil {
+		return response
+	}
+	bz, err := json.Marshal(raw)
+	if err != nil {
+		return response
+	}
+	return string(bz)
+}
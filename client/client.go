@@ -0,0 +1,140 @@
+// Package client is a Go SDK for applications and gateways that use the pocket network:
+// it dispatches and caches sessions, signs relays with a client key and an application authentication token (AAT),
+// spreads the relays across the session nodes and builds challenges out of inconsistent responses
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+const (
+	DispatchPath  = "/v1/client/dispatch"
+	RelayPath     = "/v1/client/relay"
+	ChallengePath = "/v1/client/challenge"
+
+	DefaultBlocksPerSession = 4
+	DefaultBlockTime        = 15 * time.Minute
+	DefaultTimeout          = 30 * time.Second
+	DefaultMaxDispatchRetry = 1
+)
+
+var (
+	ErrNoDispatchers      = errors.New("at least one dispatch url is needed")
+	ErrNoClientKey        = errors.New("the client private key is needed to sign relays")
+	ErrClientKeyMismatch  = errors.New("the aat was issued for a different client public key")
+	ErrEmptySession       = errors.New("the session has no nodes")
+	ErrInvalidSession     = errors.New("the dispatched session is invalid")
+	ErrNotEnoughNodes     = errors.New("not enough session nodes to compare responses")
+	ErrNoMajorityResponse = errors.New("the session nodes did not agree on a response")
+)
+
+// Config of a pocket client
+type Config struct {
+	DispatchURLs     []string          // the pocket nodes asked for sessions, tried in turns
+	AAT              pocketTypes.AAT   // the token issued by the application for the client key
+	ClientKey        crypto.PrivateKey // the key relays are signed with
+	BlocksPerSession int64             // the session length of the network, used to expire the cached sessions
+	BlockTime        time.Duration     // the expected time between blocks, used to estimate the height between dispatches
+	Timeout          time.Duration     // the timeout of every http request
+	MaxDispatchRetry int               // how many times a relay is retried after the servicer asks for a new dispatch, negative disables it
+}
+
+// Client of the pocket network for a single application authentication token
+type Client struct {
+	config     Config
+	httpClient *http.Client
+	dispatcher uint64
+	mu         sync.Mutex
+	sessions   map[string]*Session // by chain
+}
+
+// NewClient validates the configuration and returns a pocket client, unset durations and counts use the defaults
+func NewClient(config Config) (*Client, error) {
+	if len(config.DispatchURLs) == 0 {
+		return nil, ErrNoDispatchers
+	}
+	if config.ClientKey == nil {
+		return nil, ErrNoClientKey
+	}
+	if err := config.AAT.Validate(); err != nil {
+		return nil, err
+	}
+	if config.AAT.ClientPublicKey != config.ClientKey.PublicKey().RawString() {
+		return nil, ErrClientKeyMismatch
+	}
+	if config.BlocksPerSession <= 0 {
+		config.BlocksPerSession = DefaultBlocksPerSession
+	}
+	if config.BlockTime <= 0 {
+		config.BlockTime = DefaultBlockTime
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+	if config.MaxDispatchRetry == 0 {
+		config.MaxDispatchRetry = DefaultMaxDispatchRetry
+	} else if config.MaxDispatchRetry < 0 {
+		config.MaxDispatchRetry = 0
+	}
+	return &Client{
+		config:     config,
+		httpClient: &http.Client{Timeout: config.Timeout},
+		sessions:   make(map[string]*Session),
+	}, nil
+}
+
+// nextDispatchURL spreads the dispatches across the configured nodes
+func (c *Client) nextDispatchURL() string {
+	i := atomic.AddUint64(&c.dispatcher, 1) - 1
+	return c.config.DispatchURLs[i%uint64(len(c.config.DispatchURLs))]
+}
+
+// post sends the json body to the url and decodes a successful response into res, it returns the raw body and the
+// status code so callers can decode error responses
+func (c *Client) post(ctx context.Context, url string, body interface{}, res interface{}) ([]byte, int, error) {
+	bz, err := json.Marshal(body)
+	if err != nil {
+		return nil, 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(bz))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	respBz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return respBz, resp.StatusCode, nil
+	}
+	if res != nil {
+		if err := json.Unmarshal(respBz, res); err != nil {
+			return respBz, resp.StatusCode, fmt.Errorf("unable to decode the response of %s: %s", url, err.Error())
+		}
+	}
+	return respBz, resp.StatusCode, nil
+}
+
+// joinURL adds the rpc path to a node url
+func joinURL(base, path string) string {
+	return strings.TrimRight(base, "/") + path
+}
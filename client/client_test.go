@@ -0,0 +1,265 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChain = "0001"
+
+// testServicer is a pocket node that answers relays with a fixed response
+type testServicer struct {
+	key        crypto.PrivateKey
+	server     *httptest.Server
+	response   string
+	reject     *pocketTypes.DispatchResponse // when set, the next relay is rejected for over service with this dispatch
+	relays     int64
+	challenges int64
+}
+
+func (s *testServicer) validator() nodesTypes.Validator {
+	return nodesTypes.Validator{
+		Address:      sdk.Address(s.key.PublicKey().Address()),
+		PublicKey:    s.key.PublicKey(),
+		Status:       sdk.Staked,
+		Chains:       []string{testChain},
+		ServiceURL:   s.server.URL,
+		StakedTokens: sdk.NewInt(1000000),
+	}
+}
+
+func (s *testServicer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case RelayPath:
+		var relay pocketTypes.Relay
+		if err := json.NewDecoder(r.Body).Decode(&relay); err != nil {
+			w.WriteHeader(400)
+			return
+		}
+		if s.reject != nil {
+			body, _ := json.Marshal(struct {
+				Error    error                         `json:"error"`
+				Dispatch *pocketTypes.DispatchResponse `json:"dispatch"`
+			}{pocketTypes.NewOverServiceError(pocketTypes.ModuleName), s.reject})
+			s.reject = nil
+			w.WriteHeader(400)
+			_, _ = w.Write(body)
+			return
+		}
+		if err := relay.Proof.ValidateBasic(); err != nil || relay.Proof.RequestHash != relay.RequestHashString() {
+			w.WriteHeader(400)
+			return
+		}
+		atomic.AddInt64(&s.relays, 1)
+		resp := pocketTypes.RelayResponse{Response: s.response, Proof: relay.Proof}
+		sig, _ := s.key.Sign(resp.Hash())
+		_ = json.NewEncoder(w).Encode(relaySuccessResponse{Signature: hex.EncodeToString(sig), Response: s.response})
+	case ChallengePath:
+		var challenge pocketTypes.ChallengeProofInvalidData
+		if err := json.NewDecoder(r.Body).Decode(&challenge); err != nil || challenge.ValidateBasic() != nil {
+			w.WriteHeader(400)
+			return
+		}
+		atomic.AddInt64(&s.challenges, 1)
+		_ = json.NewEncoder(w).Encode(pocketTypes.ChallengeResponse{Response: "ok"})
+	default:
+		w.WriteHeader(404)
+	}
+}
+
+// testNetwork is a dispatcher and the servicers of its session
+type testNetwork struct {
+	servicers  []*testServicer
+	dispatcher *httptest.Server
+	dispatches int64
+	appKey     crypto.PrivateKey
+}
+
+func newTestNetwork(t *testing.T, nodes int) *testNetwork {
+	n := &testNetwork{appKey: crypto.Ed25519PrivateKey{}.GenPrivateKey()}
+	for i := 0; i < nodes; i++ {
+		s := &testServicer{key: crypto.Ed25519PrivateKey{}.GenPrivateKey(), response: `{"id":1,"result":"0x1"}`}
+		s.server = httptest.NewServer(s)
+		t.Cleanup(s.server.Close)
+		n.servicers = append(n.servicers, s)
+	}
+	n.dispatcher = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&n.dispatches, 1)
+		_ = json.NewEncoder(w).Encode(n.dispatch(5))
+	}))
+	t.Cleanup(n.dispatcher.Close)
+	return n
+}
+
+func (n *testNetwork) dispatch(sessionBlockHeight int64) *pocketTypes.DispatchResponse {
+	res := &pocketTypes.DispatchResponse{BlockHeight: sessionBlockHeight}
+	res.Session.SessionHeader = pocketTypes.SessionHeader{
+		ApplicationPubKey:  n.appKey.PublicKey().RawString(),
+		Chain:              testChain,
+		SessionBlockHeight: sessionBlockHeight,
+	}
+	res.Session.SessionKey = pocketTypes.Hash([]byte("session"))
+	for _, s := range n.servicers {
+		res.Session.SessionNodes = append(res.Session.SessionNodes, s.validator())
+	}
+	return res
+}
+
+func (n *testNetwork) newClient(t *testing.T) *Client {
+	clientKey := crypto.Ed25519PrivateKey{}.GenPrivateKey()
+	aat := pocketTypes.AAT{
+		Version:              pocketTypes.SupportedTokenVersions[0],
+		ApplicationPublicKey: n.appKey.PublicKey().RawString(),
+		ClientPublicKey:      clientKey.PublicKey().RawString(),
+	}
+	sig, err := n.appKey.Sign(aat.Hash())
+	require.Nil(t, err)
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	c, err := NewClient(Config{DispatchURLs: []string{n.dispatcher.URL}, AAT: aat, ClientKey: clientKey})
+	require.Nil(t, err)
+	return c
+}
+
+func TestNewClient(t *testing.T) {
+	n := newTestNetwork(t, 0)
+	c := n.newClient(t)
+	_, err := NewClient(Config{AAT: c.config.AAT, ClientKey: c.config.ClientKey})
+	assert.Equal(t, ErrNoDispatchers, err)
+	_, err = NewClient(Config{DispatchURLs: []string{n.dispatcher.URL}, AAT: c.config.AAT, ClientKey: crypto.Ed25519PrivateKey{}.GenPrivateKey()})
+	assert.Equal(t, ErrClientKeyMismatch, err)
+	assert.Equal(t, int64(DefaultBlocksPerSession), c.config.BlocksPerSession)
+}
+
+func TestClient_Relay(t *testing.T) {
+	n := newTestNetwork(t, 5)
+	c := n.newClient(t)
+	payload := pocketTypes.Payload{Data: `{"method":"eth_blockNumber","id":1}`, Method: "POST"}
+	for i := 0; i < 10; i++ {
+		resp, err := c.Relay(context.Background(), testChain, payload)
+		require.Nil(t, err)
+		assert.Equal(t, n.servicers[0].response, resp.Response)
+		assert.Nil(t, resp.Proof.ValidateBasic())
+	}
+	// the session is cached and the relays are spread across its nodes
+	assert.Equal(t, int64(1), n.dispatches)
+	for _, s := range n.servicers {
+		assert.Equal(t, int64(2), s.relays)
+	}
+}
+
+func TestClient_RelayRedispatch(t *testing.T) {
+	n := newTestNetwork(t, 5)
+	c := n.newClient(t)
+	session, err := c.Session(context.Background(), testChain)
+	require.Nil(t, err)
+	// the servicer answers with the next session
+	n.servicers[0].reject = n.dispatch(9)
+	resp, err := c.Relay(context.Background(), testChain, pocketTypes.Payload{Data: "{}"})
+	require.Nil(t, err)
+	assert.Equal(t, int64(9), resp.Proof.SessionBlockHeight)
+	next, err := c.Session(context.Background(), testChain)
+	require.Nil(t, err)
+	assert.NotEqual(t, session, next)
+	assert.Equal(t, int64(9), next.Header.SessionBlockHeight)
+	// no retries left
+	c.config.MaxDispatchRetry = 0
+	n.servicers[1].reject = n.dispatch(13)
+	_, err = c.Relay(context.Background(), testChain, pocketTypes.Payload{Data: "{}"})
+	var rErr *RelayError
+	require.ErrorAs(t, err, &rErr)
+	assert.True(t, rErr.WarrantsDispatch())
+}
+
+func TestClient_ValidateSession(t *testing.T) {
+	n := newTestNetwork(t, 2)
+	c := n.newClient(t)
+	valid := func() *Session {
+		var res dispatchResponse
+		bz, err := json.Marshal(n.dispatch(5))
+		require.Nil(t, err)
+		require.Nil(t, json.Unmarshal(bz, &res))
+		return res.toSession()
+	}
+	assert.Nil(t, c.validateSession(testChain, valid()))
+	for name, edit := range map[string]func(s *Session){
+		"other app":        func(s *Session) { s.Header.ApplicationPubKey = n.servicers[0].key.PublicKey().RawString() },
+		"other chain":      func(s *Session) { s.Header.Chain = "0002" },
+		"not a session":    func(s *Session) { s.Header.SessionBlockHeight, s.BlockHeight = 6, 6 },
+		"other height":     func(s *Session) { s.BlockHeight = 9 },
+		"no nodes":         func(s *Session) { s.Nodes = nil },
+		"unstaked node":    func(s *Session) { s.Nodes[0].Status = sdk.Unstaking },
+		"jailed node":      func(s *Session) { s.Nodes[0].Jailed = true },
+		"other chain node": func(s *Session) { s.Nodes[0].Chains = []string{"0002"} },
+		"other key":        func(s *Session) { s.Nodes[0].PublicKey = n.servicers[1].key.PublicKey() },
+		"duplicate node":   func(s *Session) { s.Nodes[1] = s.Nodes[0] },
+	} {
+		s := valid()
+		edit(s)
+		assert.NotNil(t, c.validateSession(testChain, s), name)
+	}
+	// an invalid session sent back by a servicer is dispatched again
+	_, err := c.Session(context.Background(), testChain)
+	require.Nil(t, err)
+	reject := n.dispatch(9)
+	jailed := n.servicers[0].validator()
+	jailed.Jailed = true
+	reject.Session.SessionNodes[0] = jailed
+	n.servicers[0].reject = reject
+	resp, err := c.Relay(context.Background(), testChain, pocketTypes.Payload{Data: "{}"})
+	require.Nil(t, err)
+	assert.Equal(t, int64(5), resp.Proof.SessionBlockHeight)
+	assert.Equal(t, int64(2), n.dispatches)
+}
+
+func TestSession_IsExpired(t *testing.T) {
+	s := &Session{Header: pocketTypes.SessionHeader{SessionBlockHeight: 5}, BlockHeight: 6, DispatchedAt: time.Now()}
+	assert.False(t, s.IsExpired(4, time.Minute))
+	s.DispatchedAt = time.Now().Add(-2 * time.Minute)
+	assert.False(t, s.IsExpired(4, time.Minute))
+	s.DispatchedAt = time.Now().Add(-3 * time.Minute)
+	assert.True(t, s.IsExpired(4, time.Minute))
+}
+
+func TestClient_ConsensusRelay(t *testing.T) {
+	n := newTestNetwork(t, 5)
+	c := n.newClient(t)
+	payload := pocketTypes.Payload{Data: `{"method":"eth_blockNumber","id":1}`}
+	// agreement
+	res, err := c.ConsensusRelay(context.Background(), testChain, payload)
+	require.Nil(t, err)
+	assert.Nil(t, res.Challenge)
+	assert.Len(t, res.Responses, ConsensusNodes)
+	// the next pick starts at the fourth node, make the fifth one disagree
+	n.servicers[4].response = `{"id":1,"result":"0x2"}`
+	res, err = c.ConsensusRelay(context.Background(), testChain, payload)
+	require.Nil(t, err)
+	require.NotNil(t, res.Challenge)
+	assert.Equal(t, n.servicers[0].response, res.Response.Response)
+	assert.Equal(t, n.servicers[4].response, res.Challenge.MinorityResponse.Response)
+	assert.Nil(t, res.Challenge.ValidateBasic())
+	assert.Nil(t, res.Challenge.Validate([]string{testChain}, 5, 5))
+	_, err = c.SubmitChallenge(context.Background(), res.ReporterURL, *res.Challenge)
+	require.Nil(t, err)
+	var challenges int64
+	for _, s := range n.servicers {
+		challenges += s.challenges
+	}
+	assert.Equal(t, int64(1), challenges)
+	// not enough nodes
+	small := newTestNetwork(t, 2)
+	_, err = small.newClient(t).ConsensusRelay(context.Background(), testChain, payload)
+	assert.Equal(t, ErrNotEnoughNodes, err)
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+var ErrInvalidServicerSignature = errors.New("the relay response is not signed by the servicer")
+
+// RelayError is a relay rejected by a servicer
+type RelayError struct {
+	Node     sdk.Address     // the servicer that rejected the relay
+	Status   int             // the http status code
+	Err      error           // an sdk.Error when the servicer returned a pocket error
+	dispatch json.RawMessage // the new session the servicer returned, if any
+}
+
+func (e *RelayError) Error() string {
+	return fmt.Sprintf("relay to %s failed with status %d: %s", e.Node, e.Status, e.Err.Error())
+}
+
+func (e *RelayError) Unwrap() error {
+	return e.Err
+}
+
+// WarrantsDispatch returns true if the session used for the relay is no longer valid for the servicer
+func (e *RelayError) WarrantsDispatch() bool {
+	sdkErr, ok := e.Err.(sdk.Error)
+	return ok && sdkErr.Codespace() == pocketTypes.ModuleName && pocketTypes.ErrorWarrantsDispatch(sdkErr)
+}
+
+// relaySuccessResponse is the body of a serviced relay
+type relaySuccessResponse struct {
+	Signature string `json:"signature"`
+	Response  string `json:"response"`
+}
+
+// relayErrorResponse is the body of a rejected relay
+type relayErrorResponse struct {
+	Error    json.RawMessage `json:"error"`
+	Dispatch json.RawMessage `json:"dispatch"`
+}
+
+// relayErrorBody is the json form of an sdk.Error
+type relayErrorBody struct {
+	Codespace sdk.CodespaceType `json:"codespace"`
+	Code      sdk.CodeType      `json:"code"`
+	Message   string            `json:"message"`
+}
+
+// NewRelay builds a relay of the payload for a node of the session, and signs its proof with the client key
+func (c *Client) NewRelay(session *Session, node nodesTypes.Validator, payload pocketTypes.Payload) (pocketTypes.Relay, error) {
+	return c.newRelay(session, node, payload, session.EstimatedHeight(c.config.BlockTime))
+}
+
+// newRelay builds and signs a relay with the given block height in its metadata
+func (c *Client) newRelay(session *Session, node nodesTypes.Validator, payload pocketTypes.Payload, height int64) (pocketTypes.Relay, error) {
+	entropy, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		return pocketTypes.Relay{}, err
+	}
+	relay := pocketTypes.Relay{
+		Payload: payload,
+		Meta:    pocketTypes.RelayMeta{BlockHeight: height},
+	}
+	relay.Proof = pocketTypes.RelayProof{
+		RequestHash:        relay.RequestHashString(),
+		Entropy:            entropy.Int64(),
+		SessionBlockHeight: session.Header.SessionBlockHeight,
+		ServicerPubKey:     node.PublicKey.RawString(),
+		Blockchain:         session.Header.Chain,
+		Token:              c.config.AAT,
+	}
+	sig, err := c.config.ClientKey.Sign(relay.Proof.Hash())
+	if err != nil {
+		return pocketTypes.Relay{}, err
+	}
+	relay.Proof.Signature = hex.EncodeToString(sig)
+	return relay, nil
+}
+
+// SendRelay sends a signed relay to its servicer and checks the servicer signature of the response
+func (c *Client) SendRelay(ctx context.Context, node nodesTypes.Validator, relay pocketTypes.Relay) (*pocketTypes.RelayResponse, error) {
+	var res relaySuccessResponse
+	bz, code, err := c.post(ctx, joinURL(node.ServiceURL, RelayPath), relay, &res)
+	if err != nil {
+		return nil, err
+	}
+	if code != 200 {
+		return nil, newRelayError(node.Address, code, bz)
+	}
+	resp := &pocketTypes.RelayResponse{
+		Signature: res.Signature,
+		Response:  res.Response,
+		Proof:     relay.Proof,
+	}
+	sig, err := hex.DecodeString(resp.Signature)
+	if err != nil || !node.PublicKey.VerifyBytes(resp.Hash(), sig) {
+		return nil, ErrInvalidServicerSignature
+	}
+	return resp, nil
+}
+
+// newRelayError decodes the body of a rejected relay
+func newRelayError(node sdk.Address, status int, bz []byte) *RelayError {
+	rErr := &RelayError{Node: node, Status: status, Err: errors.New(string(bz))}
+	var res relayErrorResponse
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return rErr
+	}
+	rErr.dispatch = res.Dispatch
+	var body relayErrorBody
+	if err := json.Unmarshal(res.Error, &body); err == nil && body.Code != 0 {
+		rErr.Err = sdk.NewError(body.Codespace, body.Code, body.Message)
+	}
+	return rErr
+}
+
+// Relay sends the payload to the next node of the chain session. When the servicer asks for a new dispatch the relay
+// is retried on the new session, up to the configured number of retries
+func (c *Client) Relay(ctx context.Context, chain string, payload pocketTypes.Payload) (*pocketTypes.RelayResponse, error) {
+	session, err := c.Session(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 0; ; attempt++ {
+		node, err := session.NextNode()
+		if err != nil {
+			return nil, err
+		}
+		relay, err := c.NewRelay(session, node, payload)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SendRelay(ctx, node, relay)
+		if err == nil {
+			return resp, nil
+		}
+		var rErr *RelayError
+		if !errors.As(err, &rErr) || !rErr.WarrantsDispatch() || attempt >= c.config.MaxDispatchRetry {
+			return nil, err
+		}
+		if session, err = c.Redispatch(ctx, chain, rErr); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Redispatch replaces the session of the chain after a servicer rejected a relay for it, preferring the session the
+// servicer sent back over a new round trip to a dispatcher, unless it is invalid or older than the cached session
+func (c *Client) Redispatch(ctx context.Context, chain string, rErr *RelayError) (*Session, error) {
+	if s := c.sessionFromDispatch(chain, rErr.dispatch); s != nil {
+		return s, nil
+	}
+	return c.Dispatch(ctx, chain)
+}
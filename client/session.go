@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// Session is a dispatched session, the nodes that service the relays of the application for a chain
+type Session struct {
+	Header       pocketTypes.SessionHeader `json:"header"`
+	Key          pocketTypes.SessionKey    `json:"key"`
+	Nodes        []nodesTypes.Validator    `json:"nodes"`
+	BlockHeight  int64                     `json:"block_height"` // the height of the dispatching node
+	DispatchedAt time.Time                 `json:"dispatched_at"`
+	next         uint64                    // the node index of the next relay
+}
+
+// dispatchResponse is the concrete form of pocketTypes.DispatchResponse, that can be decoded
+type dispatchResponse struct {
+	Session struct {
+		Header pocketTypes.SessionHeader `json:"header"`
+		Key    pocketTypes.SessionKey    `json:"key"`
+		Nodes  []nodesTypes.Validator    `json:"nodes"`
+	} `json:"session"`
+	BlockHeight int64 `json:"block_height"`
+}
+
+// toSession converts the dispatch response into a session
+func (d dispatchResponse) toSession() *Session {
+	return &Session{
+		Header:       d.Session.Header,
+		Key:          d.Session.Key,
+		Nodes:        d.Session.Nodes,
+		BlockHeight:  d.BlockHeight,
+		DispatchedAt: time.Now(),
+	}
+}
+
+// EstimatedHeight is the height the network is expected to be at, from the dispatch height and the block time
+func (s *Session) EstimatedHeight(blockTime time.Duration) int64 {
+	return s.BlockHeight + int64(time.Since(s.DispatchedAt)/blockTime)
+}
+
+// IsExpired returns true once the network is expected to be past the last block of the session
+func (s *Session) IsExpired(blocksPerSession int64, blockTime time.Duration) bool {
+	return s.EstimatedHeight(blockTime) >= s.Header.SessionBlockHeight+blocksPerSession
+}
+
+// NextNode picks the session nodes in turns, so relays are spread evenly across the session
+func (s *Session) NextNode() (nodesTypes.Validator, error) {
+	if len(s.Nodes) == 0 {
+		return nodesTypes.Validator{}, ErrEmptySession
+	}
+	i := atomic.AddUint64(&s.next, 1) - 1
+	return s.Nodes[i%uint64(len(s.Nodes))], nil
+}
+
+// Dispatch asks a pocket node for the current session of the chain and caches it
+func (c *Client) Dispatch(ctx context.Context, chain string) (*Session, error) {
+	header := pocketTypes.SessionHeader{
+		ApplicationPubKey: c.config.AAT.ApplicationPublicKey,
+		Chain:             chain,
+	}
+	url := joinURL(c.nextDispatchURL(), DispatchPath)
+	var res dispatchResponse
+	bz, code, err := c.post(ctx, url, header, &res)
+	if err != nil {
+		return nil, err
+	}
+	if code != 200 {
+		return nil, fmt.Errorf("dispatch to %s failed with status %d: %s", url, code, string(bz))
+	}
+	session := res.toSession()
+	if err := c.validateSession(chain, session); err != nil {
+		return nil, err
+	}
+	c.setSession(chain, session)
+	return session, nil
+}
+
+// Session returns the cached session of the chain, dispatching a new one if there is none or it expired
+func (c *Client) Session(ctx context.Context, chain string) (*Session, error) {
+	c.mu.Lock()
+	session, ok := c.sessions[chain]
+	c.mu.Unlock()
+	if ok && !session.IsExpired(c.config.BlocksPerSession, c.config.BlockTime) {
+		return session, nil
+	}
+	return c.Dispatch(ctx, chain)
+}
+
+// setSession caches the session of the chain
+func (c *Client) setSession(chain string, session *Session) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessions[chain] = session
+}
+
+// ClearSessions drops every cached session
+func (c *Client) ClearSessions() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessions = make(map[string]*Session)
+}
+
+// sessionFromDispatch caches the new session a servicer returned alongside a relay error, if it is valid and not
+// older than the cached session of the chain
+func (c *Client) sessionFromDispatch(chain string, raw json.RawMessage) *Session {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	var res dispatchResponse
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil
+	}
+	session := res.toSession()
+	if err := c.validateSession(chain, session); err != nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if current, ok := c.sessions[chain]; ok && current.Header.SessionBlockHeight > session.Header.SessionBlockHeight {
+		return nil
+	}
+	c.sessions[chain] = session
+	return session
+}
+
+// validateSession checks that a dispatched session is the session of the application for the chain at the
+// dispatch height, and that its nodes are staked for the chain, before any relay is signed for them
+func (c *Client) validateSession(chain string, s *Session) error {
+	h := s.Header
+	if h.ApplicationPubKey != c.config.AAT.ApplicationPublicKey || h.Chain != chain {
+		return fmt.Errorf("%w: the session of %s for %s was requested, got the session of %s for %s", ErrInvalidSession,
+			c.config.AAT.ApplicationPublicKey, chain, h.ApplicationPubKey, h.Chain)
+	}
+	blocksPerSession := c.config.BlocksPerSession
+	if h.SessionBlockHeight < 1 || (h.SessionBlockHeight-1)%blocksPerSession != 0 || s.BlockHeight < h.SessionBlockHeight ||
+		s.BlockHeight >= h.SessionBlockHeight+blocksPerSession {
+		return fmt.Errorf("%w: the session height %d is not the session of the height %d", ErrInvalidSession, h.SessionBlockHeight, s.BlockHeight)
+	}
+	if len(s.Nodes) == 0 {
+		return ErrEmptySession
+	}
+	seen := make(map[string]struct{}, len(s.Nodes))
+	for _, node := range s.Nodes {
+		if node.PublicKey == nil || !node.Address.Equals(sdk.Address(node.PublicKey.Address())) {
+			return fmt.Errorf("%w: the node %s does not match its public key", ErrInvalidSession, node.Address)
+		}
+		if !node.IsStaked() || node.IsJailed() || !node.HasChain(chain) {
+			return fmt.Errorf("%w: the node %s is not staked for %s", ErrInvalidSession, node.Address, chain)
+		}
+		if _, ok := seen[node.Address.String()]; ok {
+			return fmt.Errorf("%w: the node %s is in the session twice", ErrInvalidSession, node.Address)
+		}
+		seen[node.Address.String()] = struct{}{}
+	}
+	return nil
+}
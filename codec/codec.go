@@ -50,6 +50,8 @@ const (
 	BlockSizeModifyKey           = "BLOCK"
 	RSCALKey                     = "RSCAL"
 	VEDITKey                     = "VEDIT"
+	PartialUnstakeKey            = "PUNST"
+	StakeWeightedSessionKey      = "SWSES"
 )
 
 func GetCodecUpgradeHeight() int64 {
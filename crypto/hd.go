@@ -0,0 +1,116 @@
+package crypto
+
+import (
+	ed255192 "crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/go-bip39"
+)
+
+const (
+	// HDPathPrefix is the SLIP-0010 ed25519 path of the pocket keys (SLIP-0044 coin type 635), the key index is
+	// appended as the last hardened level
+	HDPathPrefix = "m/44'/635'/0'/0'"
+	// MnemonicEntropyBits is the entropy of a new mnemonic, 24 words
+	MnemonicEntropyBits = 256
+	hdHardenedOffset    = uint32(0x80000000)
+	hdSeedModifier      = "ed25519 seed"
+)
+
+// NewMnemonic returns a new random BIP-39 mnemonic
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(MnemonicEntropyBits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// HDPath returns the path of the key at index
+func HDPath(index uint32) string {
+	return fmt.Sprintf("%s/%d'", HDPathPrefix, index)
+}
+
+// DeriveEd25519PrivateKey derives the private key at the SLIP-0010 path from the BIP-39 mnemonic
+func DeriveEd25519PrivateKey(mnemonic, path string) (Ed25519PrivateKey, error) {
+	seed, err := mnemonicSeed(mnemonic)
+	if err != nil {
+		return Ed25519PrivateKey{}, err
+	}
+	indexes, err := ParseHDPath(path)
+	if err != nil {
+		return Ed25519PrivateKey{}, err
+	}
+	key, chainCode := slip10Master(seed)
+	for _, i := range indexes {
+		key, chainCode = slip10Child(key, chainCode, i)
+	}
+	var pk Ed25519PrivateKey
+	copy(pk[:], ed255192.NewKeyFromSeed(key))
+	return pk, nil
+}
+
+// SeedFingerprint identifies the seed of a mnemonic without revealing it: the first 4 bytes of the hash of its
+// SLIP-0010 master public key, in hex
+func SeedFingerprint(mnemonic string) (string, error) {
+	seed, err := mnemonicSeed(mnemonic)
+	if err != nil {
+		return "", err
+	}
+	key, _ := slip10Master(seed)
+	h := sha256.Sum256(ed255192.NewKeyFromSeed(key).Public().(ed255192.PublicKey))
+	return hex.EncodeToString(h[:4]), nil
+}
+
+// ParseHDPath parses a path like m/44'/635'/0'/0'/1', ed25519 only derives hardened indexes
+func ParseHDPath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("the hd path %s must start with m", path)
+	}
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, p := range parts[1:] {
+		if !strings.HasSuffix(p, "'") && !strings.HasSuffix(p, "H") {
+			return nil, fmt.Errorf("the hd path %s has the normal index %s, ed25519 keys only derive hardened indexes", path, p)
+		}
+		i, err := strconv.ParseUint(p[:len(p)-1], 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index %s in the hd path %s", p, path)
+		}
+		indexes = append(indexes, uint32(i)|hdHardenedOffset)
+	}
+	return indexes, nil
+}
+
+func mnemonicSeed(mnemonic string) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, errors.New("invalid mnemonic")
+	}
+	return bip39.NewSeedWithErrorChecking(mnemonic, "")
+}
+
+func slip10Master(seed []byte) (key, chainCode []byte) {
+	h := hmac.New(sha512.New, []byte(hdSeedModifier))
+	h.Write(seed)
+	i := h.Sum(nil)
+	return i[:32], i[32:]
+}
+
+func slip10Child(key, chainCode []byte, index uint32) ([]byte, []byte) {
+	data := make([]byte, 37)
+	copy(data[1:33], key)
+	binary.BigEndian.PutUint32(data[33:], index)
+	h := hmac.New(sha512.New, chainCode)
+	h.Write(data)
+	i := h.Sum(nil)
+	return i[:32], i[32:]
+}
@@ -0,0 +1,55 @@
+package crypto
+
+import (
+	ed255192 "crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// test vector 1 for ed25519 of SLIP-0010
+func TestSLIP10Ed25519(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	key, chainCode := slip10Master(seed)
+	assert.Equal(t, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", hex.EncodeToString(key))
+	assert.Equal(t, "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb", hex.EncodeToString(chainCode))
+	indexes, err := ParseHDPath("m/0'")
+	require.Nil(t, err)
+	key, chainCode = slip10Child(key, chainCode, indexes[0])
+	assert.Equal(t, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", hex.EncodeToString(key))
+	assert.Equal(t, "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69", hex.EncodeToString(chainCode))
+	assert.Equal(t, "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c", hex.EncodeToString(ed255192.NewKeyFromSeed(key)[32:]))
+}
+
+func TestParseHDPath(t *testing.T) {
+	indexes, err := ParseHDPath(HDPath(7))
+	require.Nil(t, err)
+	assert.Equal(t, []uint32{44 | hdHardenedOffset, 635 | hdHardenedOffset, hdHardenedOffset, hdHardenedOffset, 7 | hdHardenedOffset}, indexes)
+	_, err = ParseHDPath("m/44'/635'/0")
+	assert.NotNil(t, err)
+	_, err = ParseHDPath("44'/635'")
+	assert.NotNil(t, err)
+}
+
+func TestDeriveEd25519PrivateKey(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	require.Nil(t, err)
+	k0, err := DeriveEd25519PrivateKey(mnemonic, HDPath(0))
+	require.Nil(t, err)
+	again, err := DeriveEd25519PrivateKey(mnemonic, HDPath(0))
+	require.Nil(t, err)
+	assert.Equal(t, k0, again)
+	k1, err := DeriveEd25519PrivateKey(mnemonic, HDPath(1))
+	require.Nil(t, err)
+	assert.NotEqual(t, k0, k1)
+	sig, err := k1.Sign([]byte("foo"))
+	require.Nil(t, err)
+	assert.True(t, k1.PublicKey().VerifyBytes([]byte("foo"), sig))
+	fingerprint, err := SeedFingerprint(mnemonic)
+	require.Nil(t, err)
+	assert.Len(t, fingerprint, 8)
+	_, err = DeriveEd25519PrivateKey("not a mnemonic", HDPath(0))
+	assert.NotNil(t, err)
+}
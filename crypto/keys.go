@@ -41,6 +41,12 @@ type PrivateKey interface {
 	Size() int
 }
 
+// Signer signs with a key it doesn't have to expose, as a remote signer does
+type Signer interface {
+	PublicKey() PublicKey
+	Sign(msg []byte) ([]byte, error)
+}
+
 type PublicKeyMultiSig interface {
 	Address() crypto.Address
 	String() string
@@ -34,14 +34,11 @@ func NewInMemory() Keybase { return &dbKeybase{db: dbm.NewMemDB()} }
 
 func (kb *dbKeybase) GetCoinbase() (KeyPair, error) {
 	if kb.coinbase.PrivKeyArmor == "" {
-		kps, err := kb.List()
+		kp, err := firstSigningKeyPair(kb.List())
 		if err != nil {
 			return KeyPair{}, err
 		}
-		if len(kps) == 0 {
-			return KeyPair{}, fmt.Errorf("0 keypairs in the keybase, so could not get a coinbase")
-		}
-		kb.coinbase = kps[0]
+		kb.coinbase = kp
 	}
 	return kb.coinbase, nil
 }
@@ -51,10 +48,26 @@ func (kb *dbKeybase) SetCoinbase(address types.Address) error {
 	if err != nil {
 		return err
 	}
+	if kp.IsWatchOnly() {
+		return fmt.Errorf("the key with address %s is watch-only, it can't be the coinbase", address)
+	}
 	kb.coinbase = kp
 	return nil
 }
 
+// firstSigningKeyPair returns the first key of the list with a private key, watch-only keys can't sign
+func firstSigningKeyPair(kps []KeyPair, err error) (KeyPair, error) {
+	if err != nil {
+		return KeyPair{}, err
+	}
+	for _, kp := range kps {
+		if !kp.IsWatchOnly() {
+			return kp, nil
+		}
+	}
+	return KeyPair{}, fmt.Errorf("0 keypairs in the keybase, so could not get a coinbase")
+}
+
 // List returns the keys from storage in alphabetical order.
 func (kb dbKeybase) List() ([]KeyPair, error) {
 	var res []KeyPair
@@ -90,7 +103,10 @@ func (kb dbKeybase) Delete(address types.Address, passphrase string) error {
 		return err
 	}
 
-	// Verify passphrase matches
+	// Verify passphrase matches, a watch-only key has none
+	if kp.IsWatchOnly() {
+		return kb.db.DeleteSync(addrKey(kp.GetAddress()))
+	}
 	if _, err = mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, passphrase); err != nil {
 		return err
 	}
@@ -125,10 +141,12 @@ func (kb dbKeybase) Update(address types.Address, oldpass string, newpass string
 		return err
 	}
 
-	_, err = kb.writeLocalKeyPair(privKey, newpass, "")
+	// keep the label and the derivation of the key
+	kp.PrivKeyArmor, err = mintkey.EncryptArmorPrivKey(privKey, newpass, "")
 	if err != nil {
 		return err
 	}
+	kb.writeKeyPair(kp)
 	return nil
 }
 
@@ -162,13 +180,63 @@ func (kb dbKeybase) Sign(address types.Address, passphrase string, msg []byte) (
 // Create a new KeyPair and encrypt it to disk using encryptPassphrase
 func (kb dbKeybase) Create(encryptPassphrase string) (KeyPair, error) {
 	privKey := crypto.PrivateKey(crypto.Ed25519PrivateKey{}).GenPrivateKey()
-	kp, err := kb.writeLocalKeyPair(privKey, encryptPassphrase, "")
+	kp, err := kb.writeLocalKeyPair(privKey, encryptPassphrase, "", "")
 	if err != nil {
 		return kp, err
 	}
 	return kp, nil
 }
 
+// CreateMnemonic creates a new mnemonic and stores its KeyPair at index 0
+func (kb dbKeybase) CreateMnemonic(encryptPassphrase string) (string, KeyPair, error) {
+	mnemonic, err := crypto.NewMnemonic()
+	if err != nil {
+		return "", KeyPair{}, err
+	}
+	kps, err := kb.Recover(mnemonic, []uint32{0}, encryptPassphrase)
+	if err != nil {
+		return "", KeyPair{}, err
+	}
+	return mnemonic, kps[0], nil
+}
+
+// Recover derives the KeyPairs of the mnemonic at the indexes. A key already stored is kept, with its derivation
+// metadata added if it was imported without it
+func (kb dbKeybase) Recover(mnemonic string, indexes []uint32, encryptPassphrase string) ([]KeyPair, error) {
+	fingerprint, err := crypto.SeedFingerprint(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]KeyPair, 0, len(indexes))
+	for _, i := range indexes {
+		path := crypto.HDPath(i)
+		privKey, err := crypto.DeriveEd25519PrivateKey(mnemonic, path)
+		if err != nil {
+			return nil, err
+		}
+		label := ""
+		if kp, err := kb.Get(types.Address(privKey.PublicKey().Address())); err == nil && kp.IsWatchOnly() {
+			label = kp.Label
+		} else if err == nil {
+			if !kp.IsDerived() {
+				kp.HDPath, kp.SeedFingerprint = path, fingerprint
+				kb.writeKeyPair(kp)
+			}
+			res = append(res, kp)
+			continue
+		}
+		privArmor, err := mintkey.EncryptArmorPrivKey(privKey, encryptPassphrase, "")
+		if err != nil {
+			return nil, err
+		}
+		kp := NewKeyPair(privKey.PublicKey(), privArmor)
+		kp.HDPath, kp.SeedFingerprint, kp.Label = path, fingerprint, label
+		kb.writeKeyPair(kp)
+		res = append(res, kp)
+	}
+	return res, nil
+}
+
 // ImportPrivKey imports a private key in ASCII armor format.
 // It returns an error if a key with the same address exists or a wrong decryptPassphrase is
 // supplied.
@@ -181,10 +249,11 @@ func (kb dbKeybase) ImportPrivKey(armor, decryptPassphrase, encryptPassphrase st
 	if err != nil {
 		return KeyPair{}, err
 	}
-	if _, err := kb.Get(Address); err == nil {
-		return KeyPair{}, errors.New("Cannot overwrite key with address: " + Address.String())
+	label, err := kb.watchOnlyLabel(Address)
+	if err != nil {
+		return KeyPair{}, err
 	}
-	return kb.writeLocalKeyPair(privKey, encryptPassphrase, "")
+	return kb.writeLocalKeyPair(privKey, encryptPassphrase, "", label)
 }
 
 // ExportPrivKeyEncryptedArmor finds the KeyPair by the address, decrypts the armor private key,
@@ -204,10 +273,85 @@ func (kb dbKeybase) ImportPrivateKeyObject(privateKey [64]byte, encryptPassphras
 	if err != nil {
 		return KeyPair{}, err
 	}
-	if _, err := kb.Get(Address); err == nil {
-		return KeyPair{}, errors.New("Cannot overwrite key with address: " + Address.String())
+	label, err := kb.watchOnlyLabel(Address)
+	if err != nil {
+		return KeyPair{}, err
 	}
-	return kb.writeLocalKeyPair(ed25519PK, encryptPassphrase, "")
+	return kb.writeLocalKeyPair(ed25519PK, encryptPassphrase, "", label)
+}
+
+// watchOnlyLabel returns the label of the key of the address, that a private key may only be stored for if it is
+// missing or watch-only
+func (kb dbKeybase) watchOnlyLabel(address types.Address) (string, error) {
+	kp, err := kb.Get(address)
+	if err != nil {
+		return "", nil
+	}
+	if !kp.IsWatchOnly() {
+		return "", errors.New("Cannot overwrite key with address: " + address.String())
+	}
+	return kp.Label, nil
+}
+
+// AddWatchOnly stores the address without a private key, the public key is optional but must match the address
+func (kb dbKeybase) AddWatchOnly(address types.Address, pubKey crypto.PublicKey, label string) (KeyPair, error) {
+	if len(address) != types.AddrLen {
+		return KeyPair{}, fmt.Errorf("invalid address length: %d", len(address))
+	}
+	if pubKey != nil && !address.Equals(types.Address(pubKey.Address())) {
+		return KeyPair{}, fmt.Errorf("the public key is not the key of the address %s", address)
+	}
+	if _, err := kb.Get(address); err == nil {
+		return KeyPair{}, errors.New("Cannot overwrite key with address: " + address.String())
+	}
+	if err := kb.checkLabel(address, label); err != nil {
+		return KeyPair{}, err
+	}
+	kp := KeyPair{PublicKey: pubKey, Label: label}
+	if pubKey == nil {
+		kp.Address = address
+	}
+	kb.writeKeyPair(kp)
+	return kp, nil
+}
+
+// SetLabel names the key of the address
+func (kb dbKeybase) SetLabel(address types.Address, label string) error {
+	kp, err := kb.Get(address)
+	if err != nil {
+		return err
+	}
+	if err := kb.checkLabel(address, label); err != nil {
+		return err
+	}
+	kp.Label = label
+	kb.writeKeyPair(kp)
+	return nil
+}
+
+// GetByLabel returns the key with the label
+func (kb dbKeybase) GetByLabel(label string) (KeyPair, error) {
+	kps, err := kb.List()
+	if err != nil {
+		return KeyPair{}, err
+	}
+	for _, kp := range kps {
+		if label != "" && kp.Label == label {
+			return kp, nil
+		}
+	}
+	return KeyPair{}, fmt.Errorf("key with label %s not found", label)
+}
+
+// checkLabel returns an error if another key of the keybase has the label
+func (kb dbKeybase) checkLabel(address types.Address, label string) error {
+	if label == "" {
+		return nil
+	}
+	if kp, err := kb.GetByLabel(label); err == nil && !kp.GetAddress().Equals(address) {
+		return fmt.Errorf("the label %s is already used by %s", label, kp.GetAddress())
+	}
+	return nil
 }
 
 // ExportPrivateKeyObject exports raw PrivKey object.
@@ -235,7 +379,7 @@ func (kb dbKeybase) CloseDB() {
 }
 
 // Private interface
-func (kb dbKeybase) writeLocalKeyPair(priv crypto.PrivateKey, passphrase, hint string) (KeyPair, error) {
+func (kb dbKeybase) writeLocalKeyPair(priv crypto.PrivateKey, passphrase, hint, label string) (KeyPair, error) {
 	// encrypt private key using passphrase
 	privArmor, err := mintkey.EncryptArmorPrivKey(priv, passphrase, hint)
 	if err != nil || privArmor == "" {
@@ -245,6 +389,7 @@ func (kb dbKeybase) writeLocalKeyPair(priv crypto.PrivateKey, passphrase, hint s
 	// make Info
 	pub := priv.PublicKey()
 	localKeyPair := NewKeyPair(pub, privArmor)
+	localKeyPair.Label = label
 	kb.writeKeyPair(localKeyPair)
 
 	return localKeyPair, nil
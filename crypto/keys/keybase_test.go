@@ -215,3 +215,108 @@ func TestCoinbase(t *testing.T) {
 	require.NotEmpty(t, coinbase)
 	require.Equal(t, coinbase, kp)
 }
+
+func TestMnemonicRecover(t *testing.T) {
+	dir, cleanup := NewTestCaseDir(t)
+	defer cleanup()
+	kb := New("keybasename", dir)
+	mnemonic, kp, err := kb.CreateMnemonic("1234")
+	require.Nil(t, err)
+	assert.True(t, kp.IsDerived())
+	assert.Equal(t, crypto.HDPath(0), kp.HDPath)
+
+	// the metadata is stored with the key
+	stored, err := kb.Get(kp.GetAddress())
+	require.Nil(t, err)
+	assert.Equal(t, kp.HDPath, stored.HDPath)
+	assert.Equal(t, kp.SeedFingerprint, stored.SeedFingerprint)
+
+	// another keybase derives the same keys
+	other := NewInMemory()
+	kps, err := other.Recover(mnemonic, []uint32{0, 1, 2}, "5678")
+	require.Nil(t, err)
+	require.Len(t, kps, 3)
+	assert.Equal(t, kp.GetAddress(), kps[0].GetAddress())
+	assert.Equal(t, crypto.HDPath(2), kps[2].HDPath)
+	assert.Equal(t, kp.SeedFingerprint, kps[2].SeedFingerprint)
+	_, _, err = other.Sign(kps[2].GetAddress(), "5678", []byte("foo"))
+	assert.Nil(t, err)
+
+	// recovering again keeps the stored keys, a key imported without metadata gets it
+	pk, err := crypto.DeriveEd25519PrivateKey(mnemonic, crypto.HDPath(3))
+	require.Nil(t, err)
+	imported, err := other.ImportPrivateKeyObject(pk, "5678")
+	require.Nil(t, err)
+	assert.False(t, imported.IsDerived())
+	kps, err = other.Recover(mnemonic, []uint32{2, 3}, "abcd")
+	require.Nil(t, err)
+	assert.Equal(t, crypto.HDPath(3), kps[1].HDPath)
+	_, _, err = other.Sign(kps[0].GetAddress(), "5678", []byte("foo"))
+	assert.Nil(t, err)
+	l, err := other.List()
+	require.Nil(t, err)
+	assert.Len(t, l, 4)
+
+	_, err = other.Recover("not a mnemonic", []uint32{0}, "1234")
+	assert.NotNil(t, err)
+}
+
+func TestWatchOnlyAndLabels(t *testing.T) {
+	dir, cleanup := NewTestCaseDir(t)
+	defer cleanup()
+	kb := New("keybasename", dir)
+	kp, err := kb.Create("1234")
+	require.Nil(t, err)
+	require.Nil(t, kb.SetLabel(kp.GetAddress(), "servicer"))
+
+	// a watch-only address, with or without its public key
+	pk := crypto.GenerateEd25519PrivKey()
+	output := types.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	_, err = kb.AddWatchOnly(output, nil, "output")
+	require.Nil(t, err)
+	watched, err := kb.AddWatchOnly(types.Address(pk.PublicKey().Address()), pk.PublicKey(), "app")
+	require.Nil(t, err)
+	assert.True(t, watched.IsWatchOnly())
+	_, err = kb.AddWatchOnly(output, nil, "")
+	assert.NotNil(t, err)
+	_, err = kb.AddWatchOnly(types.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address()), pk.PublicKey(), "")
+	assert.NotNil(t, err)
+	_, err = kb.AddWatchOnly(types.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address()), nil, "servicer")
+	assert.NotNil(t, err)
+	got, err := kb.Get(output)
+	require.Nil(t, err)
+	assert.Equal(t, output, got.GetAddress())
+	assert.Nil(t, got.PublicKey)
+	got, err = kb.GetByLabel("app")
+	require.Nil(t, err)
+	assert.Equal(t, watched.GetAddress(), got.GetAddress())
+	_, err = kb.GetByLabel("")
+	assert.NotNil(t, err)
+
+	// watch-only keys don't sign and are never the coinbase
+	_, _, err = kb.Sign(output, "", []byte("foo"))
+	assert.NotNil(t, err)
+	assert.NotNil(t, kb.SetCoinbase(output))
+	coinbase, err := kb.GetCoinbase()
+	require.Nil(t, err)
+	assert.Equal(t, kp.GetAddress(), coinbase.GetAddress())
+
+	// labels are unique, kept on a passphrase update and when the private key of a watched address is imported
+	assert.NotNil(t, kb.SetLabel(output, "app"))
+	require.Nil(t, kb.Update(kp.GetAddress(), "1234", "5678"))
+	got, err = kb.Get(kp.GetAddress())
+	require.Nil(t, err)
+	assert.Equal(t, "servicer", got.Label)
+	imported, err := kb.ImportPrivateKeyObject(pk.(crypto.Ed25519PrivateKey), "1234")
+	require.Nil(t, err)
+	assert.False(t, imported.IsWatchOnly())
+	assert.Equal(t, "app", imported.Label)
+	_, err = kb.ImportPrivateKeyObject(pk.(crypto.Ed25519PrivateKey), "1234")
+	assert.NotNil(t, err)
+
+	// a watch-only key is deleted without a passphrase
+	require.Nil(t, kb.Delete(output, ""))
+	l, err := kb.List()
+	require.Nil(t, err)
+	assert.Len(t, l, 2)
+}
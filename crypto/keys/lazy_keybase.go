@@ -30,14 +30,11 @@ func New(name, dir string) Keybase {
 
 func (kb *lazyKeybase) GetCoinbase() (KeyPair, error) {
 	if kb.coinbase.PrivKeyArmor == "" {
-		kps, err := kb.List()
+		kp, err := firstSigningKeyPair(kb.List())
 		if err != nil {
 			return KeyPair{}, err
 		}
-		if len(kps) == 0 {
-			return KeyPair{}, fmt.Errorf("0 keypairs in the keybase, so could not get a coinbase")
-		}
-		kb.coinbase = kps[0]
+		kb.coinbase = kp
 	}
 	return kb.coinbase, nil
 }
@@ -47,6 +44,9 @@ func (kb *lazyKeybase) SetCoinbase(address types.Address) error {
 	if err != nil {
 		return err
 	}
+	if kp.IsWatchOnly() {
+		return fmt.Errorf("the key with address %s is watch-only, it can't be the coinbase", address)
+	}
 	kb.coinbase = kp
 	return nil
 }
@@ -121,6 +121,56 @@ func (lkb lazyKeybase) Create(encryptPassphrase string) (KeyPair, error) {
 	return newDbKeybase(db).Create(encryptPassphrase)
 }
 
+func (lkb lazyKeybase) CreateMnemonic(encryptPassphrase string) (string, KeyPair, error) {
+	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
+	if err != nil {
+		return "", KeyPair{}, err
+	}
+	defer db.Close()
+
+	return newDbKeybase(db).CreateMnemonic(encryptPassphrase)
+}
+
+func (lkb lazyKeybase) Recover(mnemonic string, indexes []uint32, encryptPassphrase string) ([]KeyPair, error) {
+	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return newDbKeybase(db).Recover(mnemonic, indexes, encryptPassphrase)
+}
+
+func (lkb lazyKeybase) AddWatchOnly(address types.Address, pubKey crypto.PublicKey, label string) (KeyPair, error) {
+	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
+	if err != nil {
+		return KeyPair{}, err
+	}
+	defer db.Close()
+
+	return newDbKeybase(db).AddWatchOnly(address, pubKey, label)
+}
+
+func (lkb lazyKeybase) SetLabel(address types.Address, label string) error {
+	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return newDbKeybase(db).SetLabel(address, label)
+}
+
+func (lkb lazyKeybase) GetByLabel(label string) (KeyPair, error) {
+	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
+	if err != nil {
+		return KeyPair{}, err
+	}
+	defer db.Close()
+
+	return newDbKeybase(db).GetByLabel(label)
+}
+
 func (lkb lazyKeybase) ImportPrivKey(armor, decryptPassphrase, encryptPassphrase string) (KeyPair, error) {
 	db, err := sdk.NewLevelDB(lkb.name, lkb.dir, config.DefaultLevelDBOpts().ToGoLevelDBOpts())
 	if err != nil {
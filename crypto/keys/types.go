@@ -26,6 +26,21 @@ type Keybase interface {
 	// Create a new KeyPair and encrypt it to disk using encryptPassphrase
 	Create(encryptPassphrase string) (KeyPair, error)
 
+	// CreateMnemonic creates a new BIP-39 mnemonic, encrypts its KeyPair at index 0 to disk using encryptPassphrase and returns the mnemonic
+	CreateMnemonic(encryptPassphrase string) (mnemonic string, kp KeyPair, err error)
+
+	// Recover derives the KeyPairs of the mnemonic at the indexes and encrypts them to disk using encryptPassphrase
+	Recover(mnemonic string, indexes []uint32, encryptPassphrase string) ([]KeyPair, error)
+
+	// AddWatchOnly stores an address without its private key, and its public key if known, to label and query it
+	AddWatchOnly(address types.Address, pubKey crypto.PublicKey, label string) (KeyPair, error)
+
+	// SetLabel names the key of the address, an empty label removes it. Labels are unique in the keybase
+	SetLabel(address types.Address, label string) error
+
+	// GetByLabel returns the key with the label
+	GetByLabel(label string) (KeyPair, error)
+
 	// ImportPrivKey using Armored private key string. Decrypts armor with decryptPassphrase, and stores locally using encryptPassphrase
 	ImportPrivKey(armor, decryptPassphrase, encryptPassphrase string) (KeyPair, error)
 
@@ -44,8 +59,12 @@ type Keybase interface {
 
 // KeyPair is the public information about a locally stored key
 type KeyPair struct {
-	PublicKey    crypto.PublicKey `json:"pubkey"`
-	PrivKeyArmor string           `json:"privkey.armor"`
+	PublicKey       crypto.PublicKey `json:"pubkey"`
+	PrivKeyArmor    string           `json:"privkey.armor"`
+	HDPath          string           `json:"hd_path,omitempty"`          // the SLIP-0010 path of a key derived from a mnemonic
+	SeedFingerprint string           `json:"seed_fingerprint,omitempty"` // identifies the mnemonic of a derived key, see crypto.SeedFingerprint
+	Label           string           `json:"label,omitempty"`            // the human name of the key
+	Address         types.Address    `json:"address,omitempty"`          // the address of a watch-only key without a public key
 }
 
 // NewKeyPair with the given public key and priv armor key
@@ -56,8 +75,21 @@ func NewKeyPair(pub crypto.PublicKey, privArmor string) KeyPair {
 	}
 }
 
+// IsDerived tells whether the key was derived from a mnemonic, and can be recovered from it
+func (kp KeyPair) IsDerived() bool {
+	return kp.HDPath != ""
+}
+
+// IsWatchOnly tells whether the private key is missing, so the key can be queried but not sign
+func (kp KeyPair) IsWatchOnly() bool {
+	return kp.PrivKeyArmor == ""
+}
+
 // GetAddress for the given KeyPair
 func (kp KeyPair) GetAddress() types.Address {
+	if kp.PublicKey == nil {
+		return kp.Address
+	}
 	return kp.PublicKey.Address().Bytes()
 }
 
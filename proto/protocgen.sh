@@ -2,6 +2,8 @@
 
 set -eo pipefail
 
+# protoc-gen-gocosmos must be built against the gogo/protobuf version pinned in go.mod, the generated grpc stubs
+# use the grpc.ClientConn and grpc.Server of google.golang.org/grpc
 proto_dirs=$(find ./proto -path -prune -o -name '*.proto' -print0 | xargs -0 -n1 dirname | sort | uniq)
 for dir in $proto_dirs; do
   protoc \
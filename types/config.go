@@ -22,6 +22,7 @@ type PocketConfig struct {
 	GenesisName               string `json:"genesis_file"`
 	ChainsName                string `json:"chains_name"`
 	EvidenceDBName            string `json:"evidence_db_name"`
+	EvidenceDBBackend         string `json:"evidence_db_backend"`
 	TendermintURI             string `json:"tendermint_uri"`
 	KeybaseName               string `json:"keybase_name"`
 	RPCPort                   string `json:"rpc_port"`
@@ -48,12 +49,58 @@ type PocketConfig struct {
 	GenerateTokenOnStart      bool   `json:"generate_token_on_start"`
 	LeanPocket                bool   `json:"lean_pocket"`
 	LeanPocketUserKeyFileName string `json:"lean_pocket_user_key_file"`
+	EvidenceEncryption        bool   `json:"evidence_encryption"`
+	EvidenceEncryptionKeyFile string `json:"evidence_encryption_key_file"`
+	GatewayHost               string `json:"gateway_host"`
+	GatewayPort               string `json:"gateway_port"`
+	GatewayKeyFileName        string `json:"gateway_key_file"`
+	GatewayMaxRetries         int    `json:"gateway_max_retries"`
+
+	// limits of the public rpc server, zero values disable a limit
+	RPCIPRate             float64        `json:"rpc_ip_rate"`               // requests per second of a client ip
+	RPCIPBurst            int            `json:"rpc_ip_burst"`              // requests a client ip can send at once
+	RPCAppRelayRate       float64        `json:"rpc_app_relay_rate"`        // relays and dispatches per second of an application public key from a client ip
+	RPCAppRelayBurst      int            `json:"rpc_app_relay_burst"`       // relays and dispatches an application can send at once
+	RPCRouteConcurrency   map[string]int `json:"rpc_route_concurrency"`     // requests served at once by path, a trailing * shares the cap across the prefix
+	RPCMaxBodyBytes       int64          `json:"rpc_max_body_bytes"`        // max size of a request body
+	RPCTrustXForwardedFor bool           `json:"rpc_trust_x_forwarded_for"` // take the client ip from the X-Forwarded-For header of a proxy
+	RPCTrustedProxies     int            `json:"rpc_trusted_proxies"`       // the trusted proxies in front of the node, 1 when unset
+	RPCMaxTrackedClients  int            `json:"rpc_max_tracked_clients"`   // client ips and applications tracked, the least recently seen are forgotten
+	RPCMaxEventStreams    int            `json:"rpc_max_event_streams"`     // event streams open at once
+
+	// tls and authentication of the rpc server, the private routes also accept the auth token as a Bearer header
+	RPCTLSCertFile         string `json:"rpc_tls_cert_file"`          // serve the rpc over tls with this certificate
+	RPCTLSKeyFile          string `json:"rpc_tls_key_file"`           // the key of the tls certificate
+	RPCPrivateAddr         string `json:"rpc_private_addr"`           // serve the private routes on this host:port instead of the rpc port
+	RPCPrivateClientCAFile string `json:"rpc_private_client_ca_file"` // require client certificates signed by this ca on the private routes
+	RPCCLICertFile         string `json:"rpc_cli_cert_file"`          // the client certificate the cli presents to the private routes
+	RPCCLIKeyFile          string `json:"rpc_cli_key_file"`           // the key of the cli client certificate
+
+	// the grpc query, broadcast and block subscription services, started with --grpc over the rpc tls certificate
+	GRPCPort string `json:"grpc_port"`
+
+	// the pocket signer holding the servicer keys, which are then never loaded by the node, over mutual tls
+	RemoteSignerAddr     string `json:"remote_signer_addr"`      // unix:///path/to/socket or tcp://host:port, empty to sign with the local keys
+	RemoteSignerCertFile string `json:"remote_signer_cert_file"` // the client certificate presented to the signer
+	RemoteSignerKeyFile  string `json:"remote_signer_key_file"`  // the key of the client certificate
+	RemoteSignerCAFile   string `json:"remote_signer_ca_file"`   // the ca the certificate of the signer must be signed by
+	RemoteSignerTimeout  int64  `json:"remote_signer_timeout"`   // ms to wait for a signature
+
+	// the claims and proofs of the servicers, computed and sent in the background after the blocks
+	ClaimProofWorkers int `json:"claim_proof_workers"` // servicers whose claims and proofs are computed at once
+
+	// ms between the syncs to disk of the logdb evidence backend, zero syncs every write
+	EvidenceDBSyncInterval int64 `json:"evidence_db_sync_interval"`
 }
 
 func (c PocketConfig) GetLeanPocketUserKeyFilePath() string {
 	return path.Join(c.DataDir, c.LeanPocketUserKeyFileName)
 }
 
+func (c PocketConfig) GetGatewayKeyFilePath() string {
+	return path.Join(c.DataDir, c.GatewayKeyFileName)
+}
+
 type Config struct {
 	TendermintConfig config.Config `json:"tendermint_config"`
 	PocketConfig     PocketConfig  `json:"pocket_config"`
@@ -77,6 +124,7 @@ const (
 	DefaultGenesisName                 = "genesis.json"
 	DefaultRPCPort                     = "8081"
 	DefaultEvidenceDBName              = "pocket_evidence"
+	DefaultEvidenceDBBackend           = "goleveldb"
 	DefaultTMURI                       = "tcp://localhost:26657"
 	DefaultMaxSessionCacheEntries      = 500
 	DefaultMaxEvidenceCacheEntries     = 500
@@ -111,6 +159,18 @@ const (
 	DefaultGenerateTokenOnStart        = true
 	DefaultLeanPocket                  = false
 	DefaultLeanPocketUserKeyFileName   = "lean_nodes_keys.json"
+	DefaultEvidenceEncryption          = false
+	DefaultEvidenceEncryptionKeyFile   = ""
+	DefaultGatewayHost                 = "127.0.0.1"
+	DefaultGatewayPort                 = "8082"
+	DefaultGatewayKeyFileName          = "gateway_key.json"
+	DefaultGatewayMaxRetries           = 2
+	DefaultRPCMaxBodyBytes             = 1048576
+	DefaultRPCMaxTrackedClients        = 100000
+	DefaultRPCMaxEventStreams          = 100
+	DefaultGRPCPort                    = "9081"
+	DefaultRemoteSignerTimeout         = 3000
+	DefaultClaimProofWorkers           = 4
 )
 
 func DefaultConfig(dataDir string) Config {
@@ -121,6 +181,7 @@ func DefaultConfig(dataDir string) Config {
 			GenesisName:               DefaultGenesisName,
 			ChainsName:                DefaultChainsName,
 			EvidenceDBName:            DefaultEvidenceDBName,
+			EvidenceDBBackend:         DefaultEvidenceDBBackend,
 			TendermintURI:             DefaultTMURI,
 			KeybaseName:               DefaultKeybaseName,
 			RPCPort:                   DefaultRPCPort,
@@ -146,6 +207,18 @@ func DefaultConfig(dataDir string) Config {
 			GenerateTokenOnStart:      DefaultGenerateTokenOnStart,
 			LeanPocket:                DefaultLeanPocket,
 			LeanPocketUserKeyFileName: DefaultLeanPocketUserKeyFileName,
+			EvidenceEncryption:        DefaultEvidenceEncryption,
+			EvidenceEncryptionKeyFile: DefaultEvidenceEncryptionKeyFile,
+			GatewayHost:               DefaultGatewayHost,
+			GatewayPort:               DefaultGatewayPort,
+			GatewayKeyFileName:        DefaultGatewayKeyFileName,
+			GatewayMaxRetries:         DefaultGatewayMaxRetries,
+			RPCMaxBodyBytes:           DefaultRPCMaxBodyBytes,
+			RPCMaxTrackedClients:      DefaultRPCMaxTrackedClients,
+			RPCMaxEventStreams:        DefaultRPCMaxEventStreams,
+			GRPCPort:                  DefaultGRPCPort,
+			RemoteSignerTimeout:       DefaultRemoteSignerTimeout,
+			ClaimProofWorkers:         DefaultClaimProofWorkers,
 		},
 	}
 	c.TendermintConfig.LevelDBOptions = config.DefaultLevelDBOpts()
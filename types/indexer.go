@@ -12,6 +12,7 @@ import (
 	"github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 	"math"
+	"strconv"
 )
 
 var (
@@ -29,6 +30,7 @@ const (
 	TxSignerKey         = "tx.signer"
 	TxRecipientKey      = "tx.recipient"
 	TxHashKey           = "tx.hash"
+	TxReindexKey        = "tx.reindex"
 	SortAscending       = "asc"
 	SortDescending      = "desc"
 	AuthCodespace       = "auth"
@@ -50,30 +52,9 @@ func (t *TransactionIndexer) AddBatch(b *txindex.Batch) error {
 	defer storeBatch.Close()
 
 	for _, result := range b.Ops { // iterate through all the transaction results
-		if result.Result.Codespace == AuthCodespace && result.Result.Code < AnteHandlerMaxError {
-			continue // don't index any ante handler level errors
-		}
-		hash := result.Tx.Hash()
-
-		// index tx by sender
-		if result.Result.Signer != nil {
-			storeBatch.Set(keyForSigner(result), hash)
-		}
-
-		// index tx by recipient
-		if result.Result.Recipient != nil {
-			storeBatch.Set(keyForRecipient(result), hash)
-		}
-
-		// index tx by height
-		storeBatch.Set(keyForHeight(result), hash)
-
-		// index tx by hash
-		rawBytes, err := cdc.MarshalBinaryBare(result, 0) // TODO make protobuf compatible
-		if err != nil {
+		if err := setResult(storeBatch, result); err != nil {
 			return err
 		}
-		storeBatch.Set(hash, rawBytes)
 	}
 
 	return storeBatch.WriteSync()
@@ -82,6 +63,14 @@ func (t *TransactionIndexer) AddBatch(b *txindex.Batch) error {
 func (t *TransactionIndexer) Index(result *types.TxResult) error {
 	storeBatch := t.store.NewBatch()
 	defer storeBatch.Close()
+	if err := setResult(storeBatch, result); err != nil {
+		return err
+	}
+	return storeBatch.WriteSync()
+}
+
+// setResult writes all of the index entries for a single transaction result into the batch
+func setResult(storeBatch dbm.Batch, result *types.TxResult) error {
 	if result.Result.Codespace == AuthCodespace && result.Result.Code < AnteHandlerMaxError {
 		return nil // no indexing for ante handler level errors
 	}
@@ -105,8 +94,7 @@ func (t *TransactionIndexer) Index(result *types.TxResult) error {
 		return err
 	}
 	storeBatch.Set(hash, rawBytes)
-
-	return storeBatch.WriteSync()
+	return nil
 }
 
 func (t *TransactionIndexer) Get(hash []byte) (*types.TxResult, error) {
@@ -180,6 +168,37 @@ func (t *TransactionIndexer) DeleteFromHeight(ctx context.Context, height int64)
 	return b.WriteSync()
 }
 
+// ReindexCheckpoint returns the last height fully written by a reindex operation, or zero if no reindex is in progress
+func (t *TransactionIndexer) ReindexCheckpoint() (int64, error) {
+	bz, err := t.store.Get([]byte(TxReindexKey))
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	height, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "error parsing the reindex checkpoint")
+	}
+	return height, nil
+}
+
+// AddBatchWithCheckpoint indexes the batch and records the checkpoint height within the same atomic write
+func (t *TransactionIndexer) AddBatchWithCheckpoint(b *txindex.Batch, height int64) error {
+	storeBatch := t.store.NewBatch()
+	defer storeBatch.Close()
+	for _, result := range b.Ops {
+		if err := setResult(storeBatch, result); err != nil {
+			return err
+		}
+	}
+	storeBatch.Set([]byte(TxReindexKey), []byte(strconv.FormatInt(height, 10)))
+	return storeBatch.WriteSync()
+}
+
+// ClearReindexCheckpoint removes the checkpoint once a reindex operation completes
+func (t *TransactionIndexer) ClearReindexCheckpoint() error {
+	return t.store.DeleteSync([]byte(TxReindexKey))
+}
+
 func (t *TransactionIndexer) hashQuery(condition query.Condition) (res []*types.TxResult, total int, err error) {
 	hash, err := hex.DecodeString(condition.Operand.(string))
 	if err != nil {
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestTransactionIndexer_AddBatchWithCheckpoint(t *testing.T) {
+	indexer := NewTransactionIndexer(dbm.NewMemDB())
+	checkpoint, err := indexer.ReindexCheckpoint()
+	assert.Nil(t, err)
+	assert.Zero(t, checkpoint)
+	result := &types.TxResult{
+		Height: 5,
+		Index:  0,
+		Tx:     types.Tx("tx"),
+		Result: abci.ResponseDeliverTx{Signer: Address([]byte("signer"))},
+	}
+	batch := txindex.NewBatch(1)
+	assert.Nil(t, batch.Add(result))
+	assert.Nil(t, indexer.AddBatchWithCheckpoint(batch, 7))
+	checkpoint, err = indexer.ReindexCheckpoint()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), checkpoint)
+	res, err := indexer.Get(result.Tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, result.Height, res.Height)
+	assert.Nil(t, indexer.ClearReindexCheckpoint())
+	checkpoint, err = indexer.ReindexCheckpoint()
+	assert.Nil(t, err)
+	assert.Zero(t, checkpoint)
+}
@@ -0,0 +1,35 @@
+package verify
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// AccountKey returns the store and key of an account, the balance is part of the account
+func AccountKey(addr sdk.Address) (store string, key []byte) {
+	return authTypes.StoreKey, authTypes.AddressStoreKey(addr)
+}
+
+// NodeKey returns the store and key of a node (validator)
+func NodeKey(addr sdk.Address) (store string, key []byte) {
+	return nodesTypes.StoreKey, nodesTypes.KeyForValByAllVals(addr)
+}
+
+// AppKey returns the store and key of an application
+func AppKey(addr sdk.Address) (store string, key []byte) {
+	return appsTypes.StoreKey, appsTypes.KeyForAppByAllApps(addr)
+}
+
+// ClaimKey returns the store and key of a pending claim
+func ClaimKey(addr sdk.Address, header pocketTypes.SessionHeader, evidenceType pocketTypes.EvidenceType) (store string, key []byte, err error) {
+	key, err = pocketTypes.KeyForClaim(nil, addr, header, evidenceType)
+	return pocketTypes.StoreKey, key, err
+}
+
+// ParamKey returns the store and key of a parameter from its acl key e.g. pos/StakeMinimum
+func ParamKey(aclKey string) (store string, key []byte) {
+	return sdk.ParamsKey.Name(), []byte(aclKey)
+}
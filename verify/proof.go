@@ -0,0 +1,58 @@
+// Package verify checks the merkle proofs returned by the rpc query routes against a trusted block header,
+// so light clients can use query results without trusting the serving node
+package verify
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// StoreProof is the proof of a raw store value (or its absence) at a height
+type StoreProof struct {
+	Height  int64            `json:"height"`   // the height of the queried state
+	Store   string           `json:"store"`    // the name of the multistore substore
+	Key     tmbytes.HexBytes `json:"key"`      // the raw key within the substore
+	Value   tmbytes.HexBytes `json:"value"`    // the raw value, empty for an absence proof
+	Proof   *merkle.Proof    `json:"proof"`    // the iavl and multistore proof ops
+	AppHash tmbytes.HexBytes `json:"app_hash"` // the app hash of the state, committed in the header at height + 1
+}
+
+// Verify checks the proof against a trusted app hash
+func (p StoreProof) Verify(trustedAppHash []byte) error {
+	if p.Proof == nil || len(p.Proof.Ops) == 0 {
+		return fmt.Errorf("the proof is empty")
+	}
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(p.Store), merkle.KeyEncodingURL).
+		AppendKey(p.Key, merkle.KeyEncodingURL).
+		String()
+	prt := rootmulti.DefaultProofRuntime()
+	if len(p.Value) == 0 {
+		return prt.VerifyAbsence(p.Proof, trustedAppHash, keyPath)
+	}
+	return prt.VerifyValue(p.Proof, trustedAppHash, keyPath, p.Value)
+}
+
+// VerifyHeader checks the proof against the app hash of a trusted header, which must be the header of the following height
+func (p StoreProof) VerifyHeader(header tmTypes.Header) error {
+	if header.Height != p.Height+1 {
+		return fmt.Errorf("the state at height %d is committed in the header at height %d, not %d", p.Height, p.Height+1, header.Height)
+	}
+	if !bytes.Equal(header.AppHash, p.AppHash) {
+		return fmt.Errorf("the app hash of the proof %s does not match the trusted header %s", p.AppHash, header.AppHash)
+	}
+	return p.Verify(header.AppHash)
+}
+
+// VerifyKey checks that the proof is for the expected store and key, to prevent a node proving a different object
+func (p StoreProof) VerifyKey(store string, key []byte) error {
+	if p.Store != store || !bytes.Equal(p.Key, key) {
+		return fmt.Errorf("the proof is for %s/%X, expected %s/%X", p.Store, []byte(p.Key), store, key)
+	}
+	return nil
+}
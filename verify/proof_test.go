@@ -0,0 +1,71 @@
+package verify
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/store/iavl"
+	"github.com/pokt-network/pocket-core/store/rootmulti"
+	"github.com/pokt-network/pocket-core/store/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func newTestStoreProof(t *testing.T, key []byte) (StoreProof, []byte) {
+	store := rootmulti.NewStore(dbm.NewMemDB(), false, 5000000)
+	storeKey := types.NewKVStoreKey("application")
+	store.MountStoreWithDB(storeKey, types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadVersion(0))
+	iavlStore := store.GetCommitStore(storeKey).(*iavl.Store)
+	require.NoError(t, iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE")))
+	cid := store.Commit()
+	res := store.Query(abci.RequestQuery{
+		Path:  "/application/key",
+		Data:  key,
+		Prove: true,
+	})
+	require.NotNil(t, res.Proof)
+	return StoreProof{
+		Height:  cid.Version,
+		Store:   "application",
+		Key:     key,
+		Value:   res.Value,
+		Proof:   res.Proof,
+		AppHash: cid.Hash,
+	}, cid.Hash
+}
+
+func TestStoreProof_Verify(t *testing.T) {
+	proof, appHash := newTestStoreProof(t, []byte("MYKEY"))
+	require.Nil(t, proof.Verify(appHash))
+	require.Nil(t, proof.VerifyHeader(tmTypes.Header{Height: proof.Height + 1, AppHash: appHash}))
+	require.Nil(t, proof.VerifyKey("application", []byte("MYKEY")))
+	// wrong key binding
+	require.NotNil(t, proof.VerifyKey("application", []byte("OTHERKEY")))
+	require.NotNil(t, proof.VerifyKey("pos", []byte("MYKEY")))
+	// wrong header
+	require.NotNil(t, proof.VerifyHeader(tmTypes.Header{Height: proof.Height, AppHash: appHash}))
+	require.NotNil(t, proof.VerifyHeader(tmTypes.Header{Height: proof.Height + 1, AppHash: []byte("bad")}))
+	// tampered value
+	tampered := proof
+	tampered.Value = []byte("OTHERVALUE")
+	require.NotNil(t, tampered.Verify(appHash))
+	// tampered key
+	tampered = proof
+	tampered.Key = []byte("OTHERKEY")
+	require.NotNil(t, tampered.Verify(appHash))
+	// empty proof
+	tampered = proof
+	tampered.Proof = nil
+	require.NotNil(t, tampered.Verify(appHash))
+}
+
+func TestStoreProof_VerifyAbsence(t *testing.T) {
+	proof, appHash := newTestStoreProof(t, []byte("MISSING"))
+	require.Empty(t, proof.Value)
+	require.Nil(t, proof.Verify(appHash))
+	// claiming a value for an absence proof fails
+	proof.Value = []byte("MYVALUE")
+	require.NotNil(t, proof.Verify(appHash))
+}
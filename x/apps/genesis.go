@@ -29,6 +29,11 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			stakedTokens = stakedTokens.Add(application.GetTokens())
 		}
 	}
+	// the partially unstaked tokens stay in the staked pool until released
+	for _, entry := range data.UnbondingEntries {
+		keeper.SetUnbondingEntry(ctx, entry)
+		stakedTokens = stakedTokens.Add(entry.Amount)
+	}
 	stakedCoins := sdk.NewCoins(sdk.NewCoin(posKeeper.StakeDenom(ctx), stakedTokens))
 	// check if the staked pool accounts exists
 	stakedPool := keeper.GetStakedPool(ctx)
@@ -56,10 +61,12 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 	applications := keeper.GetAllApplications(ctx)
+	unbondingEntries := keeper.GetAllUnbondingEntries(ctx)
 	return types.GenesisState{
-		Params:       params,
-		Applications: applications,
-		Exported:     true,
+		Params:           params,
+		Applications:     applications,
+		Exported:         true,
+		UnbondingEntries: unbondingEntries,
 	}
 }
 
@@ -74,6 +81,14 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	for _, entry := range data.UnbondingEntries {
+		if entry.Address.Empty() {
+			return fmt.Errorf("genesis unbonding entry is missing an address: %v", entry)
+		}
+		if entry.Amount.IsNegative() {
+			return fmt.Errorf("genesis unbonding entry cannot have a negative amount: %v", entry)
+		}
+	}
 	return nil
 }
 
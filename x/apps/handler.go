@@ -7,6 +7,7 @@ import (
 	"github.com/pokt-network/pocket-core/x/apps/keeper"
 	"github.com/pokt-network/pocket-core/x/apps/types"
 	"reflect"
+	"time"
 )
 
 func NewHandler(k keeper.Keeper) sdk.Handler {
@@ -21,6 +22,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleStake(ctx, msg, k)
 		case types.MsgBeginUnstake:
 			return handleMsgBeginUnstake(ctx, msg, k)
+		case types.MsgPartialUnstake:
+			return handleMsgPartialUnstake(ctx, msg, k)
 		case types.MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
 		default:
@@ -97,6 +100,39 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keep
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgPartialUnstake(ctx sdk.Ctx, msg types.MsgPartialUnstake, k keeper.Keeper) sdk.Result {
+	application, found := k.GetApplication(ctx, msg.Address)
+	if !found {
+		ctx.Logger().Error(fmt.Sprintf("App Not Found at height: %d", ctx.BlockHeight()) + msg.Address.String())
+		return types.ErrNoApplicationFound(k.Codespace()).Result()
+	}
+	if err := k.ValidatePartialUnstake(ctx, application, msg.Amount); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("App Partial Unstake Validation Not Successful, at height: %d", ctx.BlockHeight()) + msg.Address.String())
+		return err.Result()
+	}
+	ctx.Logger().Info("Starting to Partially Unstake App " + msg.Address.String())
+	entry, err := k.PartialUnstakeApplication(ctx, application, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePartialUnstake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, entry.CompletionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 // Applications must submit a transaction to unjail itself after todo
 // having been jailed (and thus unstaked) for downtime
 func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgUnjail, k keeper.Keeper) sdk.Result {
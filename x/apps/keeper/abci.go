@@ -11,5 +11,7 @@ func BeginBlocker(ctx sdk.Ctx, _ abci.RequestBeginBlock, k Keeper) {}
 func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	// Unstake all mature applications from the unstakeing queue.
 	k.unstakeAllMatureApplications(ctx)
+	// Release the partially unstaked tokens that finished their unstaking period.
+	k.completeAllMatureUnbondingEntries(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/apps/types"
+)
+
+// GetUnbondingEntries - Retrieve the partially unstaked stake of an application that has not been released yet
+func (k Keeper) GetUnbondingEntries(ctx sdk.Ctx, addr sdk.Address) []types.UnbondingEntry {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.KeyForUnbondingEntries(addr))
+	if bz == nil {
+		return []types.UnbondingEntry{}
+	}
+	var entries types.UnbondingEntries
+	err := k.Cdc.UnmarshalBinaryLengthPrefixed(bz, &entries, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal the unbonding entries of %s: %s", addr, err.Error()))
+		return []types.UnbondingEntry{}
+	}
+	return entries.Entries
+}
+
+// SetUnbondingEntries - Store the partially unstaked stake of an application, no entries removes the record
+func (k Keeper) SetUnbondingEntries(ctx sdk.Ctx, addr sdk.Address, entries []types.UnbondingEntry) {
+	store := ctx.KVStore(k.storeKey)
+	if len(entries) == 0 {
+		_ = store.Delete(types.KeyForUnbondingEntries(addr))
+		return
+	}
+	bz, err := k.Cdc.MarshalBinaryLengthPrefixed(&types.UnbondingEntries{Entries: entries}, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not marshal the unbonding entries of %s: %s", addr, err.Error()))
+		return
+	}
+	_ = store.Set(types.KeyForUnbondingEntries(addr), bz)
+}
+
+// GetAllUnbondingEntries - Retrieve the unbonding entries of every application
+func (k Keeper) GetAllUnbondingEntries(ctx sdk.Ctx) (entries []types.UnbondingEntry) {
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.UnbondingEntriesKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var e types.UnbondingEntries
+		err := k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &e, ctx.BlockHeight())
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal unbonding entries at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		entries = append(entries, e.Entries...)
+	}
+	return entries
+}
+
+// SetUnbondingEntry - Add an unbonding entry to the application and to the unbonding queue
+func (k Keeper) SetUnbondingEntry(ctx sdk.Ctx, entry types.UnbondingEntry) {
+	entries := k.GetUnbondingEntries(ctx, entry.Address)
+	k.SetUnbondingEntries(ctx, entry.Address, append(entries, entry))
+	addrs := k.getUnbondingQueue(ctx, entry.CompletionTime)
+	for _, addr := range addrs {
+		if addr.Equals(entry.Address) {
+			return
+		}
+	}
+	k.setUnbondingQueue(ctx, entry.CompletionTime, append(addrs, entry.Address))
+}
+
+// getUnbondingQueue - Retrieve the applications with unbonding entries that complete at exactly this time
+func (k Keeper) getUnbondingQueue(ctx sdk.Ctx, completionTime time.Time) (addrs sdk.Addresses) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.KeyForUnbondingQueue(completionTime))
+	if bz == nil {
+		return
+	}
+	_ = k.Cdc.UnmarshalBinaryLengthPrefixed(bz, &addrs, ctx.BlockHeight())
+	return addrs
+}
+
+// setUnbondingQueue - Store the applications with unbonding entries that complete at a certain time
+func (k Keeper) setUnbondingQueue(ctx sdk.Ctx, completionTime time.Time, addrs sdk.Addresses) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := k.Cdc.MarshalBinaryLengthPrefixed(&addrs, ctx.BlockHeight())
+	_ = store.Set(types.KeyForUnbondingQueue(completionTime), bz)
+}
+
+// ValidatePartialUnstake - Check if a staked application can release amount of its stake
+func (k Keeper) ValidatePartialUnstake(ctx sdk.Ctx, application types.Application, amount sdk.BigInt) sdk.Error {
+	if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.PartialUnstakeKey) {
+		return types.ErrPartialUnstakeInactive(k.codespace)
+	}
+	if !application.IsStaked() {
+		return types.ErrApplicationStatus(k.codespace)
+	}
+	if application.IsJailed() {
+		return types.ErrApplicationJailed(k.codespace)
+	}
+	if !amount.IsPositive() {
+		return types.ErrBadPartialUnstakeAmount(k.codespace)
+	}
+	// the remaining stake must still satisfy the minimum
+	if application.StakedTokens.Sub(amount).LT(sdk.NewInt(k.MinimumStake(ctx))) {
+		return types.ErrPartialUnstakeBelowMinimum(k.codespace)
+	}
+	return nil
+}
+
+// PartialUnstakeApplication - Remove amount from the application's stake and start its unstaking timer
+// The max relays of the application are lowered right away
+func (k Keeper) PartialUnstakeApplication(ctx sdk.Ctx, application types.Application, amount sdk.BigInt) (types.UnbondingEntry, sdk.Error) {
+	application, err := k.removeApplicationTokens(ctx, application, amount)
+	if err != nil {
+		return types.UnbondingEntry{}, sdk.ErrInternal(err.Error())
+	}
+	application.MaxRelays = k.CalculateAppRelays(ctx, application)
+	k.SetApplication(ctx, application)
+	// clear session cache
+	k.PocketKeeper.ClearSessionCache()
+	entry := types.UnbondingEntry{
+		Address:        application.Address,
+		Amount:         amount,
+		CreationHeight: ctx.BlockHeight(),
+		CompletionTime: ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx)),
+	}
+	k.SetUnbondingEntry(ctx, entry)
+	ctx.Logger().Info(fmt.Sprintf("Began unbonding %s tokens of application %s", amount, application.Address))
+	return entry, nil
+}
+
+// completeAllMatureUnbondingEntries - Release the tokens of all the unbonding entries that have finished their unstaking period
+func (k Keeper) completeAllMatureUnbondingEntries(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	now := ctx.BlockHeader().Time
+	iterator, _ := store.Iterator(types.UnbondingQueueKey, sdk.InclusiveEndBytes(types.KeyForUnbondingQueue(now)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var addrs sdk.Addresses
+		_ = k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &addrs, ctx.BlockHeight())
+		for _, addr := range addrs {
+			k.completeMatureUnbondingEntries(ctx, addr, now)
+		}
+		_ = store.Delete(iterator.Key())
+	}
+}
+
+// completeMatureUnbondingEntries - Send the tokens of the mature unbonding entries of an application to its account
+func (k Keeper) completeMatureUnbondingEntries(ctx sdk.Ctx, addr sdk.Address, now time.Time) {
+	var remaining []types.UnbondingEntry
+	for _, entry := range k.GetUnbondingEntries(ctx, addr) {
+		if entry.CompletionTime.After(now) {
+			remaining = append(remaining, entry)
+			continue
+		}
+		if entry.Amount.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), entry.Amount))
+			err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, entry.Address, coins)
+			if err != nil {
+				ctx.Logger().Error(fmt.Sprintf("could not release the unbonding tokens of %s: %s", addr, err.Error()))
+				// even if error continue with the unbonding
+			}
+		}
+		ctx.Logger().Info(fmt.Sprintf("Finished unbonding %s tokens of application %s", entry.Amount, addr))
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCompleteUnbonding,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, entry.Amount.String()),
+			),
+		})
+	}
+	k.SetUnbondingEntries(ctx, addr, remaining)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppUnbonding_ValidatePartialUnstake(t *testing.T) {
+	codec.UpgradeFeatureMap[codec.PartialUnstakeKey] = 1
+	defer delete(codec.UpgradeFeatureMap, codec.PartialUnstakeKey)
+	application := getStakedApplication()
+	jailed := getStakedApplication()
+	jailed.Jailed = true
+	unstaking := getUnstakingApplication()
+	tests := []struct {
+		name        string
+		height      int64
+		application types.Application
+		amount      sdk.BigInt
+		want        sdk.Error
+	}{
+		{"before the upgrade", 0, application, sdk.NewInt(100), types.ErrPartialUnstakeInactive(types.ModuleName)},
+		{"not staked", 10, unstaking, sdk.NewInt(100), types.ErrApplicationStatus(types.ModuleName)},
+		{"jailed", 10, jailed, sdk.NewInt(100), types.ErrApplicationJailed(types.ModuleName)},
+		{"zero amount", 10, application, sdk.ZeroInt(), types.ErrBadPartialUnstakeAmount(types.ModuleName)},
+		{"below minimum", 10, application, application.StakedTokens, types.ErrPartialUnstakeBelowMinimum(types.ModuleName)},
+		{"valid", 10, application, sdk.NewInt(100), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			context, _, keeper := createTestInput(t, true)
+			context = context.WithBlockHeight(tt.height)
+			err := keeper.ValidatePartialUnstake(context, tt.application, tt.amount)
+			if tt.want == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.want.Code(), err.Code())
+		})
+	}
+}
+
+func TestAppUnbonding_PartialUnstakeAndComplete(t *testing.T) {
+	codec.UpgradeFeatureMap[codec.PartialUnstakeKey] = 1
+	defer delete(codec.UpgradeFeatureMap, codec.PartialUnstakeKey)
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	amount := application.StakedTokens.QuoRaw(2)
+
+	entry, err := keeper.PartialUnstakeApplication(context, application, amount)
+	assert.Nil(t, err)
+	a, found := keeper.GetApplication(context, application.Address)
+	assert.True(t, found)
+	assert.True(t, a.StakedTokens.Equal(application.StakedTokens.Sub(amount)))
+	assert.True(t, a.MaxRelays.LT(application.MaxRelays))
+
+	keeper.completeAllMatureUnbondingEntries(context)
+	assert.Len(t, keeper.GetUnbondingEntries(context, application.Address), 1)
+
+	context = context.WithBlockTime(entry.CompletionTime.Add(time.Second))
+	keeper.completeAllMatureUnbondingEntries(context)
+	assert.Len(t, keeper.GetUnbondingEntries(context, application.Address), 0)
+	balance := keeper.AccountKeeper.GetCoins(context, application.Address).AmountOf(keeper.StakeDenom(context))
+	assert.True(t, balance.Equal(amount))
+}
@@ -36,6 +36,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryApplications(ctx, req, k)
 		case types.QueryApplication:
 			return queryApplication(ctx, req, k)
+		case types.QueryUnbonding:
+			return queryUnbonding(ctx, req, k)
 		case types.QueryParameters:
 			return queryParameters(ctx, k)
 		case types.QueryAppStakedPool:
@@ -79,6 +81,20 @@ func queryApplication(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk
 	return res, nil
 }
 
+func queryUnbonding(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAppParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	entries := k.GetUnbondingEntries(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, entries)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
 func queryStakedPool(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
 	stakedTokens := k.GetStakedTokens(ctx)
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, stakedTokens)
@@ -122,50 +122,147 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// UnbondingEntry defines an amount of stake removed from a staked application that is released after the unstaking time
+type UnbondingEntry struct {
+	Address        github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address" yaml:"address"`
+	Amount         github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"amount" yaml:"amount"`
+	CreationHeight int64                                             `protobuf:"varint,3,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height" yaml:"creation_height"`
+	CompletionTime time.Time                                         `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *UnbondingEntry) Reset()         { *m = UnbondingEntry{} }
+func (m *UnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntry) ProtoMessage()    {}
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d5a21b1d350fd62, []int{2}
+}
+func (m *UnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntry.Merge(m, src)
+}
+func (m *UnbondingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntry proto.InternalMessageInfo
+
+type UnbondingEntries struct {
+	Entries []UnbondingEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *UnbondingEntries) Reset()         { *m = UnbondingEntries{} }
+func (m *UnbondingEntries) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntries) ProtoMessage()    {}
+func (*UnbondingEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d5a21b1d350fd62, []int{3}
+}
+func (m *UnbondingEntries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntries.Merge(m, src)
+}
+func (m *UnbondingEntries) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntries proto.InternalMessageInfo
+
+func (m *UnbondingEntries) GetEntries() []UnbondingEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProtoApplication)(nil), "x.apps.ProtoApplication")
 	proto.RegisterType((*Pool)(nil), "x.apps.Pool")
+	proto.RegisterType((*UnbondingEntry)(nil), "x.apps.UnbondingEntry")
+	proto.RegisterType((*UnbondingEntries)(nil), "x.apps.UnbondingEntries")
 }
 
 func init() { proto.RegisterFile("x/apps/apps.proto", fileDescriptor_5d5a21b1d350fd62) }
 
 var fileDescriptor_5d5a21b1d350fd62 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0xd1, 0xd6, 0x4d, 0x8e, 0xb6, 0xa2, 0x16, 0x08, 0x53, 0x24, 0x9f, 0x65, 0x16, 0x2f,
-	0xb5, 0x81, 0x08, 0x09, 0x65, 0xab, 0x99, 0x80, 0x25, 0x72, 0x32, 0xc1, 0x10, 0x5d, 0x9c, 0xc3,
-	0x35, 0xfe, 0x71, 0x56, 0x7c, 0x11, 0x09, 0x7f, 0x01, 0x12, 0x4b, 0xff, 0x84, 0xcc, 0xfc, 0x25,
-	0x1d, 0x3b, 0x22, 0x86, 0x03, 0x25, 0x0b, 0xca, 0x84, 0x32, 0x32, 0xa1, 0xbb, 0x73, 0xea, 0xb2,
-	0x45, 0xb0, 0x44, 0xf7, 0xde, 0x97, 0xf7, 0xde, 0x77, 0x9f, 0x3f, 0x1b, 0x1e, 0x4f, 0x3d, 0x5c,
-	0x14, 0xa5, 0xfc, 0x71, 0x8b, 0x31, 0x65, 0x54, 0xd7, 0xa6, 0xae, 0x40, 0x27, 0x77, 0x23, 0x1a,
-	0x51, 0x49, 0x79, 0xe2, 0xa4, 0xaa, 0x27, 0x28, 0xa2, 0x34, 0x4a, 0x89, 0x27, 0xd1, 0x70, 0xf2,
-	0xce, 0x63, 0x71, 0x46, 0x4a, 0x86, 0xb3, 0x42, 0xfd, 0xc1, 0xfe, 0xa2, 0xc1, 0x3b, 0x5d, 0x71,
-	0x3a, 0x2b, 0x8a, 0x34, 0x0e, 0x31, 0x8b, 0x69, 0xae, 0xa7, 0x70, 0x1f, 0x8f, 0x46, 0x63, 0x52,
-	0x96, 0x06, 0xb0, 0x80, 0x73, 0xe0, 0x07, 0x2b, 0x8e, 0x36, 0xd4, 0x9a, 0xa3, 0xa3, 0x19, 0xce,
-	0xd2, 0x8e, 0x5d, 0x11, 0xf6, 0x6f, 0x8e, 0x9e, 0x44, 0x31, 0x3b, 0x9f, 0x0c, 0xdd, 0x90, 0x66,
-	0x5e, 0x41, 0x13, 0x76, 0x9a, 0x13, 0xf6, 0x81, 0x8e, 0x13, 0xaf, 0xa0, 0x61, 0x42, 0xd8, 0x69,
-	0x48, 0xc7, 0xc4, 0x63, 0xb3, 0x82, 0x94, 0xee, 0x99, 0x52, 0x05, 0x1b, 0x3f, 0xdd, 0x87, 0xb0,
-	0x98, 0x0c, 0xd3, 0x38, 0x1c, 0x24, 0x64, 0x66, 0xdc, 0x92, 0x81, 0x8f, 0x56, 0x1c, 0xdd, 0x60,
-	0xd7, 0x1c, 0x1d, 0xab, 0xcc, 0x9a, 0xb3, 0x83, 0x96, 0x02, 0xaf, 0xc9, 0x4c, 0x6f, 0x43, 0xed,
-	0x3d, 0x8e, 0x53, 0x32, 0x32, 0x76, 0x2c, 0xe0, 0x34, 0xfd, 0x87, 0x2b, 0x8e, 0x2a, 0x66, 0xcd,
-	0xd1, 0xa1, 0xd2, 0x2a, 0x6c, 0x07, 0x55, 0x41, 0x4f, 0xa1, 0x56, 0x32, 0xcc, 0x26, 0xa5, 0xb1,
-	0x6b, 0x01, 0x67, 0xcf, 0xef, 0x0b, 0x91, 0x62, 0x6a, 0x91, 0xc2, 0xe2, 0x8e, 0xcf, 0xb6, 0xbf,
-	0x63, 0x8f, 0xe1, 0x84, 0xf4, 0xa4, 0x32, 0xa8, 0x1c, 0x45, 0x8b, 0xe1, 0x39, 0x8e, 0xf3, 0xd2,
-	0xd8, 0xb3, 0x76, 0x9c, 0x96, 0x6a, 0x51, 0x31, 0x75, 0x9a, 0xc2, 0x76, 0x50, 0x15, 0xf4, 0x29,
-	0x3c, 0x2c, 0x85, 0xd7, 0x68, 0xc0, 0x68, 0x42, 0xf2, 0xd2, 0xd0, 0x2c, 0xe0, 0xb4, 0xfc, 0xde,
-	0x25, 0x47, 0x8d, 0x6f, 0x1c, 0x3d, 0xde, 0xbe, 0x25, 0x3f, 0x8e, 0x5e, 0xe6, 0x4c, 0x64, 0x2a,
-	0xa7, 0x3a, 0x53, 0x61, 0x3b, 0x38, 0x50, 0x49, 0x7d, 0x09, 0xf5, 0x8f, 0x10, 0x66, 0x78, 0x3a,
-	0x18, 0x93, 0x14, 0xcf, 0x4a, 0x63, 0x5f, 0xc6, 0xbe, 0xfd, 0x8f, 0xd8, 0x1b, 0x6e, 0xf5, 0xd3,
-	0xac, 0x39, 0x3b, 0x68, 0x65, 0x78, 0x1a, 0xc8, 0xb3, 0xfe, 0x19, 0xc0, 0x07, 0x93, 0x5c, 0xb4,
-	0x13, 0xe7, 0xd1, 0x20, 0xa4, 0x59, 0x91, 0x12, 0xb1, 0x98, 0x03, 0xb1, 0xbd, 0x46, 0xd3, 0x02,
-	0xce, 0xed, 0xa7, 0x27, 0xae, 0x5a, 0x6d, 0x77, 0xb3, 0xda, 0x6e, 0x7f, 0xb3, 0xda, 0x7e, 0x5b,
-	0xf4, 0xb9, 0xe2, 0xe8, 0xa8, 0x36, 0x11, 0xca, 0x35, 0x47, 0xf7, 0x54, 0xee, 0xdf, 0xbc, 0x7d,
-	0xf1, 0x1d, 0x81, 0xe0, 0xfe, 0x35, 0xf9, 0xe2, 0x3a, 0x50, 0x58, 0x76, 0x9a, 0x9f, 0xe6, 0xa8,
-	0xf1, 0x73, 0x8e, 0x80, 0x3d, 0x84, 0xbb, 0x5d, 0x4a, 0x53, 0xbd, 0x0b, 0xab, 0x21, 0xca, 0xd7,
-	0xa3, 0xe5, 0x3f, 0xff, 0xd7, 0xb9, 0x04, 0x95, 0x4f, 0xa7, 0x29, 0xfc, 0x7f, 0xcd, 0x11, 0xf0,
-	0x5f, 0x5d, 0x2e, 0x4c, 0x70, 0xb5, 0x30, 0xc1, 0x8f, 0x85, 0x09, 0x2e, 0x96, 0x66, 0xe3, 0x6a,
-	0x69, 0x36, 0xbe, 0x2e, 0xcd, 0xc6, 0x9b, 0xad, 0xdc, 0xab, 0x0f, 0x84, 0x0c, 0x19, 0x6a, 0x72,
-	0x36, 0xed, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x38, 0x85, 0x13, 0xd9, 0x37, 0x04, 0x00, 0x00,
+	// 710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xbf, 0x4f, 0xdb, 0x4e,
+	0x14, 0xcf, 0x11, 0x08, 0xc9, 0x01, 0x01, 0xac, 0xef, 0x97, 0xba, 0x54, 0xca, 0x45, 0xee, 0xe2,
+	0x05, 0xbb, 0x05, 0x21, 0x55, 0x6c, 0xb8, 0xaa, 0xd4, 0x1f, 0x0b, 0x3a, 0xa0, 0x43, 0x3b, 0x44,
+	0x8e, 0x73, 0x75, 0x5c, 0xff, 0x38, 0xcb, 0xbe, 0xa8, 0x09, 0x7f, 0x41, 0xa5, 0x2e, 0x8c, 0x1d,
+	0x99, 0xfb, 0x97, 0xb0, 0x54, 0x62, 0xac, 0x3a, 0xb8, 0x15, 0x2c, 0x55, 0xa6, 0x2a, 0x63, 0xa7,
+	0xea, 0xee, 0x6c, 0x4c, 0x58, 0x8a, 0x8a, 0xd4, 0x25, 0x7a, 0xef, 0xf3, 0xfc, 0x3e, 0x9f, 0xe7,
+	0x7b, 0x9f, 0x73, 0xe0, 0xea, 0xd0, 0xb4, 0xe3, 0x38, 0x15, 0x3f, 0x46, 0x9c, 0x50, 0x46, 0x95,
+	0xda, 0xd0, 0xe0, 0xd9, 0xfa, 0x7f, 0x2e, 0x75, 0xa9, 0x80, 0x4c, 0x1e, 0xc9, 0xea, 0x3a, 0x72,
+	0x29, 0x75, 0x03, 0x62, 0x8a, 0xac, 0x3b, 0x78, 0x63, 0x32, 0x2f, 0x24, 0x29, 0xb3, 0xc3, 0x58,
+	0x3e, 0xa0, 0x7d, 0xaa, 0xc1, 0x95, 0x3d, 0x1e, 0xed, 0xc6, 0x71, 0xe0, 0x39, 0x36, 0xf3, 0x68,
+	0xa4, 0x04, 0x70, 0xde, 0xee, 0xf5, 0x12, 0x92, 0xa6, 0x2a, 0x68, 0x03, 0x7d, 0xd1, 0xc2, 0xe3,
+	0x0c, 0x15, 0xd0, 0x24, 0x43, 0xcd, 0x91, 0x1d, 0x06, 0x3b, 0x5a, 0x0e, 0x68, 0xbf, 0x32, 0xf4,
+	0xd0, 0xf5, 0x58, 0x7f, 0xd0, 0x35, 0x1c, 0x1a, 0x9a, 0x31, 0xf5, 0xd9, 0x46, 0x44, 0xd8, 0x3b,
+	0x9a, 0xf8, 0x66, 0x4c, 0x1d, 0x9f, 0xb0, 0x0d, 0x87, 0x26, 0xc4, 0x64, 0xa3, 0x98, 0xa4, 0xc6,
+	0xae, 0xec, 0xc2, 0x05, 0x9f, 0x62, 0x41, 0x18, 0x0f, 0xba, 0x81, 0xe7, 0x74, 0x7c, 0x32, 0x52,
+	0x67, 0x84, 0xe0, 0xfd, 0x71, 0x86, 0xae, 0xa0, 0x93, 0x0c, 0xad, 0x4a, 0xcd, 0x12, 0xd3, 0x70,
+	0x43, 0x26, 0x2f, 0xc8, 0x48, 0xd9, 0x82, 0xb5, 0xb7, 0xb6, 0x17, 0x90, 0x9e, 0x5a, 0x6d, 0x03,
+	0xbd, 0x6e, 0xdd, 0x1b, 0x67, 0x28, 0x47, 0x26, 0x19, 0x5a, 0x92, 0xbd, 0x32, 0xd7, 0x70, 0x5e,
+	0x50, 0x02, 0x58, 0x4b, 0x99, 0xcd, 0x06, 0xa9, 0x3a, 0xdb, 0x06, 0xfa, 0x9c, 0x75, 0xc0, 0x9b,
+	0x24, 0x52, 0x36, 0xc9, 0x9c, 0xbf, 0xe3, 0xf6, 0xcd, 0xdf, 0x71, 0x9f, 0xd9, 0x3e, 0xd9, 0x17,
+	0x9d, 0x38, 0x67, 0xe4, 0x23, 0x3a, 0x7d, 0xdb, 0x8b, 0x52, 0x75, 0xae, 0x5d, 0xd5, 0x1b, 0x72,
+	0x44, 0x89, 0x94, 0x6a, 0x32, 0xd7, 0x70, 0x5e, 0x50, 0x86, 0x70, 0x29, 0xe5, 0x5c, 0xbd, 0x0e,
+	0xa3, 0x3e, 0x89, 0x52, 0xb5, 0xd6, 0x06, 0x7a, 0xc3, 0xda, 0x3f, 0xcd, 0x50, 0xe5, 0x6b, 0x86,
+	0x1e, 0xdc, 0x7c, 0x24, 0xcb, 0x73, 0x9f, 0x45, 0x8c, 0x6b, 0x4a, 0xa6, 0x52, 0x53, 0xe6, 0x1a,
+	0x5e, 0x94, 0x4a, 0x07, 0x22, 0x55, 0x8e, 0x20, 0x0c, 0xed, 0x61, 0x27, 0x21, 0x81, 0x3d, 0x4a,
+	0xd5, 0x79, 0x21, 0xfb, 0xfa, 0x16, 0xb2, 0x57, 0xd8, 0xca, 0x6d, 0x96, 0x98, 0x86, 0x1b, 0xa1,
+	0x3d, 0xc4, 0x22, 0x56, 0x3e, 0x00, 0x78, 0x77, 0x10, 0xf1, 0x71, 0xbc, 0xc8, 0xed, 0x38, 0x34,
+	0x8c, 0x03, 0xc2, 0x8d, 0xd9, 0xe1, 0xee, 0x55, 0xeb, 0x6d, 0xa0, 0x2f, 0x6c, 0xae, 0x1b, 0xd2,
+	0xda, 0x46, 0x61, 0x6d, 0xe3, 0xa0, 0xb0, 0xb6, 0xb5, 0xc5, 0xe7, 0x1c, 0x67, 0xa8, 0x59, 0x92,
+	0xf0, 0xce, 0x49, 0x86, 0xfe, 0x97, 0xba, 0xd3, 0xb8, 0x76, 0xfc, 0x0d, 0x01, 0x7c, 0xe7, 0x12,
+	0x7c, 0x7c, 0x29, 0xc8, 0x29, 0x77, 0xea, 0xef, 0x4f, 0x50, 0xe5, 0xc7, 0x09, 0x02, 0x5a, 0x17,
+	0xce, 0xee, 0x51, 0x1a, 0x28, 0x7b, 0x30, 0x3f, 0x44, 0x71, 0x3d, 0x1a, 0xd6, 0xa3, 0xbf, 0x3d,
+	0x17, 0x9c, 0xf3, 0xec, 0xd4, 0x39, 0xff, 0x4f, 0xae, 0xf1, 0xb9, 0x0a, 0x9b, 0x87, 0x51, 0x97,
+	0x46, 0x3d, 0x2f, 0x72, 0x9f, 0x44, 0x2c, 0x19, 0xfd, 0xe3, 0xeb, 0xe8, 0xc3, 0x9a, 0x1d, 0xd2,
+	0x41, 0xc4, 0xd4, 0x99, 0xdb, 0x7b, 0x4d, 0x32, 0x95, 0x5e, 0x93, 0xb9, 0x86, 0xf3, 0x82, 0xf2,
+	0x12, 0x2e, 0x3b, 0x09, 0x11, 0x5f, 0x9d, 0x4e, 0x9f, 0x78, 0x6e, 0x9f, 0x89, 0x0b, 0x5c, 0xb5,
+	0x36, 0xc6, 0x19, 0xba, 0x5e, 0x9a, 0x64, 0x68, 0x2d, 0xbf, 0x26, 0xd3, 0x05, 0x0d, 0x37, 0x0b,
+	0xe4, 0xa9, 0x00, 0x94, 0x23, 0xb8, 0x7c, 0xdd, 0x36, 0xb3, 0x7f, 0xb4, 0xcd, 0x76, 0x6e, 0x9b,
+	0xeb, 0xad, 0x57, 0x74, 0xa7, 0x0b, 0xd2, 0x38, 0x4d, 0x67, 0xda, 0x2f, 0x8b, 0xdc, 0x2f, 0x1f,
+	0x4f, 0x10, 0x10, 0x9e, 0x39, 0x84, 0x2b, 0x53, 0xeb, 0xf4, 0x48, 0xaa, 0xec, 0xc2, 0x79, 0x22,
+	0x43, 0x15, 0xb4, 0xab, 0xfa, 0xc2, 0xe6, 0x9a, 0x21, 0xbf, 0xe2, 0xc6, 0xf4, 0xe6, 0xad, 0xe5,
+	0x7c, 0xa2, 0xe2, 0x71, 0x5c, 0x04, 0xd6, 0xf3, 0xd3, 0xf3, 0x16, 0x38, 0x3b, 0x6f, 0x81, 0xef,
+	0xe7, 0x2d, 0x70, 0x7c, 0xd1, 0xaa, 0x9c, 0x5d, 0xb4, 0x2a, 0x5f, 0x2e, 0x5a, 0x95, 0x57, 0x37,
+	0xda, 0x53, 0xfe, 0x3f, 0x22, 0xd6, 0xd5, 0xad, 0x89, 0xb3, 0xd8, 0xfa, 0x3d, 0x00, 0x8d, 0xe9,
+	0x4e, 0xea, 0x5e, 0x06, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -174,294 +271,304 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func AppsDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 4590 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0x5d, 0x70, 0x1b, 0xd7,
-		0x75, 0xd6, 0x82, 0x00, 0x08, 0x1c, 0x80, 0xe0, 0x72, 0x49, 0x49, 0x10, 0x1d, 0x0b, 0x12, 0xfc,
-		0x23, 0xda, 0x8a, 0x48, 0x57, 0x7f, 0x96, 0xc1, 0xc6, 0x2e, 0x40, 0x42, 0x0c, 0x65, 0xfe, 0xc0,
-		0x0b, 0x32, 0xb6, 0x95, 0xc9, 0xec, 0x2c, 0x17, 0x97, 0xe0, 0x8a, 0x8b, 0xdd, 0xcd, 0xee, 0x42,
-		0x12, 0x35, 0x7d, 0x70, 0xc7, 0x69, 0x9b, 0x8c, 0xa7, 0x8d, 0xd3, 0x76, 0xda, 0xc4, 0x75, 0x5c,
-		0xc5, 0x9d, 0xd6, 0xad, 0xfb, 0x9b, 0xfe, 0x24, 0x4d, 0xfb, 0x92, 0x4e, 0xa7, 0xad, 0x9f, 0x3a,
-		0xf1, 0x5b, 0xa7, 0x0f, 0x6c, 0xc6, 0xf6, 0x4c, 0x53, 0xd5, 0x6d, 0x53, 0x55, 0x99, 0xe9, 0xd4,
-		0x2f, 0x99, 0xfb, 0xb7, 0xd8, 0x05, 0x40, 0x2d, 0xe8, 0x8c, 0xec, 0x17, 0x09, 0x7b, 0xee, 0xf9,
-		0xbe, 0x7b, 0xee, 0xb9, 0xe7, 0x9e, 0x7b, 0xee, 0xdd, 0x25, 0xfc, 0xdd, 0x2c, 0x1c, 0x6b, 0x5a,
-		0x56, 0xd3, 0x40, 0x33, 0xb6, 0x63, 0x79, 0xd6, 0x46, 0x7b, 0x73, 0xa6, 0x81, 0x5c, 0xcd, 0xd1,
-		0x6d, 0xcf, 0x72, 0xa6, 0x89, 0x4c, 0x1a, 0xa5, 0x1a, 0xd3, 0x5c, 0xa3, 0xb8, 0x0c, 0x63, 0x17,
-		0x75, 0x03, 0xcd, 0xfb, 0x8a, 0x75, 0xe4, 0x49, 0x17, 0x20, 0xbe, 0xa9, 0x1b, 0x28, 0x2f, 0x1c,
-		0x1b, 0x9a, 0xca, 0x9c, 0x7e, 0x70, 0xba, 0x0b, 0x34, 0x1d, 0x46, 0xd4, 0xb0, 0x58, 0x26, 0x88,
-		0xe2, 0x7b, 0x71, 0x18, 0xef, 0xd3, 0x2a, 0x49, 0x10, 0x37, 0xd5, 0x16, 0x66, 0x14, 0xa6, 0xd2,
-		0x32, 0xf9, 0x2d, 0xe5, 0x61, 0xd8, 0x56, 0xb5, 0x6d, 0xb5, 0x89, 0xf2, 0x31, 0x22, 0xe6, 0x8f,
-		0xd2, 0x51, 0x80, 0x06, 0xb2, 0x91, 0xd9, 0x40, 0xa6, 0xb6, 0x93, 0x1f, 0x3a, 0x36, 0x34, 0x95,
-		0x96, 0x03, 0x12, 0xe9, 0x24, 0x8c, 0xd9, 0xed, 0x0d, 0x43, 0xd7, 0x94, 0x80, 0x1a, 0x1c, 0x1b,
-		0x9a, 0x4a, 0xc8, 0x22, 0x6d, 0x98, 0xef, 0x28, 0x9f, 0x80, 0xd1, 0x6b, 0x48, 0xdd, 0x0e, 0xaa,
-		0x66, 0x88, 0x6a, 0x0e, 0x8b, 0x03, 0x8a, 0x73, 0x90, 0x6d, 0x21, 0xd7, 0x55, 0x9b, 0x48, 0xf1,
-		0x76, 0x6c, 0x94, 0x8f, 0x93, 0xd1, 0x1f, 0xeb, 0x19, 0x7d, 0xf7, 0xc8, 0x33, 0x0c, 0xb5, 0xb6,
-		0x63, 0x23, 0xa9, 0x0c, 0x69, 0x64, 0xb6, 0x5b, 0x94, 0x21, 0xb1, 0x87, 0xff, 0xaa, 0x66, 0xbb,
-		0xd5, 0xcd, 0x92, 0xc2, 0x30, 0x46, 0x31, 0xec, 0x22, 0xe7, 0xaa, 0xae, 0xa1, 0x7c, 0x92, 0x10,
-		0x9c, 0xe8, 0x21, 0xa8, 0xd3, 0xf6, 0x6e, 0x0e, 0x8e, 0x93, 0xe6, 0x20, 0x8d, 0xae, 0x7b, 0xc8,
-		0x74, 0x75, 0xcb, 0xcc, 0x0f, 0x13, 0x92, 0x87, 0xfa, 0xcc, 0x22, 0x32, 0x1a, 0xdd, 0x14, 0x1d,
-		0x9c, 0x74, 0x1e, 0x86, 0x2d, 0xdb, 0xd3, 0x2d, 0xd3, 0xcd, 0xa7, 0x8e, 0x09, 0x53, 0x99, 0xd3,
-		0x9f, 0xe8, 0x1b, 0x08, 0xab, 0x54, 0x47, 0xe6, 0xca, 0xd2, 0x22, 0x88, 0xae, 0xd5, 0x76, 0x34,
-		0xa4, 0x68, 0x56, 0x03, 0x29, 0xba, 0xb9, 0x69, 0xe5, 0xd3, 0x84, 0xa0, 0xd0, 0x3b, 0x10, 0xa2,
-		0x38, 0x67, 0x35, 0xd0, 0xa2, 0xb9, 0x69, 0xc9, 0x39, 0x37, 0xf4, 0x2c, 0x1d, 0x82, 0xa4, 0xbb,
-		0x63, 0x7a, 0xea, 0xf5, 0x7c, 0x96, 0x44, 0x08, 0x7b, 0x2a, 0x7e, 0x27, 0x09, 0xa3, 0x83, 0x84,
-		0xd8, 0x2c, 0x24, 0x36, 0xf1, 0x28, 0xf3, 0xb1, 0xfd, 0xf8, 0x80, 0x62, 0xc2, 0x4e, 0x4c, 0x7e,
-		0x48, 0x27, 0x96, 0x21, 0x63, 0x22, 0xd7, 0x43, 0x0d, 0x1a, 0x11, 0x43, 0x03, 0xc6, 0x14, 0x50,
-		0x50, 0x6f, 0x48, 0xc5, 0x3f, 0x54, 0x48, 0x3d, 0x07, 0xa3, 0xbe, 0x49, 0x8a, 0xa3, 0x9a, 0x4d,
-		0x1e, 0x9b, 0x33, 0x51, 0x96, 0x4c, 0x57, 0x39, 0x4e, 0xc6, 0x30, 0x39, 0x87, 0x42, 0xcf, 0xd2,
-		0x3c, 0x80, 0x65, 0x22, 0x6b, 0x53, 0x69, 0x20, 0xcd, 0xc8, 0xa7, 0xf6, 0xf0, 0xd2, 0x2a, 0x56,
-		0xe9, 0xf1, 0x92, 0x45, 0xa5, 0x9a, 0x21, 0x3d, 0xd1, 0x09, 0xb5, 0xe1, 0x3d, 0x22, 0x65, 0x99,
-		0x2e, 0xb2, 0x9e, 0x68, 0x5b, 0x87, 0x9c, 0x83, 0x70, 0xdc, 0xa3, 0x06, 0x1b, 0x59, 0x9a, 0x18,
-		0x31, 0x1d, 0x39, 0x32, 0x99, 0xc1, 0xe8, 0xc0, 0x46, 0x9c, 0xe0, 0xa3, 0xf4, 0x00, 0xf8, 0x02,
-		0x85, 0x84, 0x15, 0x90, 0x2c, 0x94, 0xe5, 0xc2, 0x15, 0xb5, 0x85, 0x26, 0x6f, 0x40, 0x2e, 0xec,
-		0x1e, 0x69, 0x02, 0x12, 0xae, 0xa7, 0x3a, 0x1e, 0x89, 0xc2, 0x84, 0x4c, 0x1f, 0x24, 0x11, 0x86,
-		0x90, 0xd9, 0x20, 0x59, 0x2e, 0x21, 0xe3, 0x9f, 0xd2, 0xcf, 0x74, 0x06, 0x3c, 0x44, 0x06, 0xfc,
-		0x70, 0xef, 0x8c, 0x86, 0x98, 0xbb, 0xc7, 0x3d, 0xf9, 0x38, 0x8c, 0x84, 0x06, 0x30, 0x68, 0xd7,
-		0xc5, 0x9f, 0x85, 0x83, 0x7d, 0xa9, 0xa5, 0xe7, 0x60, 0xa2, 0x6d, 0xea, 0xa6, 0x87, 0x1c, 0xdb,
-		0x41, 0x38, 0x62, 0x69, 0x57, 0xf9, 0x7f, 0x1b, 0xde, 0x23, 0xe6, 0xd6, 0x83, 0xda, 0x94, 0x45,
-		0x1e, 0x6f, 0xf7, 0x0a, 0x1f, 0x4d, 0xa7, 0x7e, 0x30, 0x2c, 0xbe, 0xf0, 0xc2, 0x0b, 0x2f, 0xc4,
-		0x8a, 0x7f, 0x9b, 0x84, 0x89, 0x7e, 0x6b, 0xa6, 0xef, 0xf2, 0x3d, 0x04, 0x49, 0xb3, 0xdd, 0xda,
-		0x40, 0x0e, 0x71, 0x52, 0x42, 0x66, 0x4f, 0x52, 0x19, 0x12, 0x86, 0xba, 0x81, 0x8c, 0x7c, 0xfc,
-		0x98, 0x30, 0x95, 0x3b, 0x7d, 0x72, 0xa0, 0x55, 0x39, 0xbd, 0x84, 0x21, 0x32, 0x45, 0x4a, 0x4f,
-		0x42, 0x9c, 0xa5, 0x68, 0xcc, 0xf0, 0xe8, 0x60, 0x0c, 0x78, 0x2d, 0xc9, 0x04, 0x27, 0xdd, 0x07,
-		0x69, 0xfc, 0x3f, 0x8d, 0x8d, 0x24, 0xb1, 0x39, 0x85, 0x05, 0x38, 0x2e, 0xa4, 0x49, 0x48, 0x91,
-		0x65, 0xd2, 0x40, 0x7c, 0x6b, 0xf3, 0x9f, 0x71, 0x60, 0x35, 0xd0, 0xa6, 0xda, 0x36, 0x3c, 0xe5,
-		0xaa, 0x6a, 0xb4, 0x11, 0x09, 0xf8, 0xb4, 0x9c, 0x65, 0xc2, 0xcf, 0x60, 0x99, 0x54, 0x80, 0x0c,
-		0x5d, 0x55, 0xba, 0xd9, 0x40, 0xd7, 0x49, 0xf6, 0x4c, 0xc8, 0x74, 0xa1, 0x2d, 0x62, 0x09, 0xee,
-		0xfe, 0x8a, 0x6b, 0x99, 0x3c, 0x34, 0x49, 0x17, 0x58, 0x40, 0xba, 0x7f, 0xbc, 0x3b, 0x71, 0xdf,
-		0xdf, 0x7f, 0x78, 0x3d, 0x6b, 0xe9, 0x04, 0x8c, 0x12, 0x8d, 0x33, 0x6c, 0xea, 0x55, 0x23, 0x3f,
-		0x76, 0x4c, 0x98, 0x4a, 0xc9, 0x39, 0x2a, 0x5e, 0x65, 0xd2, 0xe2, 0xb7, 0x62, 0x10, 0x27, 0x89,
-		0x65, 0x14, 0x32, 0x6b, 0xcf, 0xd7, 0xaa, 0xca, 0xfc, 0xea, 0x7a, 0x65, 0xa9, 0x2a, 0x0a, 0x52,
-		0x0e, 0x80, 0x08, 0x2e, 0x2e, 0xad, 0x96, 0xd7, 0xc4, 0x98, 0xff, 0xbc, 0xb8, 0xb2, 0x76, 0xfe,
-		0xac, 0x38, 0xe4, 0x03, 0xd6, 0xa9, 0x20, 0x1e, 0x54, 0x38, 0x73, 0x5a, 0x4c, 0x48, 0x22, 0x64,
-		0x29, 0xc1, 0xe2, 0x73, 0xd5, 0xf9, 0xf3, 0x67, 0xc5, 0x64, 0x58, 0x72, 0xe6, 0xb4, 0x38, 0x2c,
-		0x8d, 0x40, 0x9a, 0x48, 0x2a, 0xab, 0xab, 0x4b, 0x62, 0xca, 0xe7, 0xac, 0xaf, 0xc9, 0x8b, 0x2b,
-		0x0b, 0x62, 0xda, 0xe7, 0x5c, 0x90, 0x57, 0xd7, 0x6b, 0x22, 0xf8, 0x0c, 0xcb, 0xd5, 0x7a, 0xbd,
-		0xbc, 0x50, 0x15, 0x33, 0xbe, 0x46, 0xe5, 0xf9, 0xb5, 0x6a, 0x5d, 0xcc, 0x86, 0xcc, 0x3a, 0x73,
-		0x5a, 0x1c, 0xf1, 0xbb, 0xa8, 0xae, 0xac, 0x2f, 0x8b, 0x39, 0x69, 0x0c, 0x46, 0x68, 0x17, 0xdc,
-		0x88, 0xd1, 0x2e, 0xd1, 0xf9, 0xb3, 0xa2, 0xd8, 0x31, 0x84, 0xb2, 0x8c, 0x85, 0x04, 0xe7, 0xcf,
-		0x8a, 0x52, 0x71, 0x0e, 0x12, 0x24, 0x0c, 0x25, 0x09, 0x72, 0x4b, 0xe5, 0x4a, 0x75, 0x49, 0x59,
-		0xad, 0xad, 0x2d, 0xae, 0xae, 0x94, 0x97, 0x44, 0xa1, 0x23, 0x93, 0xab, 0xcf, 0xac, 0x2f, 0xca,
-		0xd5, 0x79, 0x31, 0x16, 0x94, 0xd5, 0xaa, 0xe5, 0xb5, 0xea, 0xbc, 0x38, 0x54, 0xd4, 0x60, 0xa2,
-		0x5f, 0x42, 0xed, 0xbb, 0x84, 0x02, 0xb1, 0x10, 0xdb, 0x23, 0x16, 0x08, 0x57, 0x77, 0x2c, 0x14,
-		0xdf, 0x8d, 0xc1, 0x78, 0x9f, 0x4d, 0xa5, 0x6f, 0x27, 0x4f, 0x41, 0x82, 0xc6, 0x32, 0xdd, 0x66,
-		0x1f, 0xe9, 0xbb, 0x3b, 0x91, 0xc8, 0xee, 0xd9, 0x6a, 0x09, 0x2e, 0x58, 0x6a, 0x0c, 0xed, 0x51,
-		0x6a, 0x60, 0x8a, 0x9e, 0x80, 0xfd, 0x5c, 0x4f, 0xf2, 0xa7, 0xfb, 0xe3, 0xf9, 0x41, 0xf6, 0x47,
-		0x22, 0xdb, 0xdf, 0x26, 0x90, 0xe8, 0xb3, 0x09, 0xcc, 0xc2, 0x58, 0x0f, 0xd1, 0xc0, 0xc9, 0xf8,
-		0x45, 0x01, 0xf2, 0x7b, 0x39, 0x27, 0x22, 0x25, 0xc6, 0x42, 0x29, 0x71, 0xb6, 0xdb, 0x83, 0xc7,
-		0xf7, 0x9e, 0x84, 0x9e, 0xb9, 0x7e, 0x43, 0x80, 0x43, 0xfd, 0x4b, 0xca, 0xbe, 0x36, 0x3c, 0x09,
-		0xc9, 0x16, 0xf2, 0xb6, 0x2c, 0x5e, 0x56, 0x3d, 0xdc, 0x67, 0xb3, 0xc6, 0xcd, 0xdd, 0x93, 0xcd,
-		0x50, 0xc1, 0xdd, 0x7e, 0x68, 0xaf, 0xba, 0x90, 0x5a, 0xd3, 0x63, 0xe9, 0x97, 0x62, 0x70, 0xb0,
-		0x2f, 0x79, 0x5f, 0x43, 0xef, 0x07, 0xd0, 0x4d, 0xbb, 0xed, 0xd1, 0xd2, 0x89, 0x66, 0xe2, 0x34,
-		0x91, 0x90, 0xe4, 0x85, 0xb3, 0x6c, 0xdb, 0xf3, 0xdb, 0x87, 0x48, 0x3b, 0x50, 0x11, 0x51, 0xb8,
-		0xd0, 0x31, 0x34, 0x4e, 0x0c, 0x3d, 0xba, 0xc7, 0x48, 0x7b, 0x02, 0xf3, 0x31, 0x10, 0x35, 0x43,
-		0x47, 0xa6, 0xa7, 0xb8, 0x9e, 0x83, 0xd4, 0x96, 0x6e, 0x36, 0xc9, 0x56, 0x93, 0x2a, 0x25, 0x36,
-		0x55, 0xc3, 0x45, 0xf2, 0x28, 0x6d, 0xae, 0xf3, 0x56, 0x8c, 0x20, 0x01, 0xe4, 0x04, 0x10, 0xc9,
-		0x10, 0x82, 0x36, 0xfb, 0x88, 0xe2, 0x57, 0xd2, 0x90, 0x09, 0x14, 0xe0, 0xd2, 0x71, 0xc8, 0x5e,
-		0x51, 0xaf, 0xaa, 0x0a, 0x3f, 0x54, 0x51, 0x4f, 0x64, 0xb0, 0xac, 0xc6, 0x0e, 0x56, 0x8f, 0xc1,
-		0x04, 0x51, 0xb1, 0xda, 0x1e, 0x72, 0x14, 0xcd, 0x50, 0x5d, 0x97, 0x38, 0x2d, 0x45, 0x54, 0x25,
-		0xdc, 0xb6, 0x8a, 0x9b, 0xe6, 0x78, 0x8b, 0x74, 0x0e, 0xc6, 0x09, 0xa2, 0xd5, 0x36, 0x3c, 0xdd,
-		0x36, 0x90, 0x82, 0x8f, 0x79, 0x2e, 0xd9, 0x72, 0x7c, 0xcb, 0xc6, 0xb0, 0xc6, 0x32, 0x53, 0xc0,
-		0x16, 0xb9, 0xd2, 0x3c, 0xdc, 0x4f, 0x60, 0x4d, 0x64, 0x22, 0x47, 0xf5, 0x90, 0x82, 0x3e, 0xdf,
-		0x56, 0x0d, 0x57, 0x51, 0xcd, 0x86, 0xb2, 0xa5, 0xba, 0x5b, 0xf9, 0x09, 0x4c, 0x50, 0x89, 0xe5,
-		0x05, 0xf9, 0x08, 0x56, 0x5c, 0x60, 0x7a, 0x55, 0xa2, 0x56, 0x36, 0x1b, 0x9f, 0x56, 0xdd, 0x2d,
-		0xa9, 0x04, 0x87, 0x08, 0x8b, 0xeb, 0x39, 0xba, 0xd9, 0x54, 0xb4, 0x2d, 0xa4, 0x6d, 0x2b, 0x6d,
-		0x6f, 0xf3, 0x42, 0xfe, 0xbe, 0x60, 0xff, 0xc4, 0xc2, 0x3a, 0xd1, 0x99, 0xc3, 0x2a, 0xeb, 0xde,
-		0xe6, 0x05, 0xa9, 0x0e, 0x59, 0x3c, 0x19, 0x2d, 0xfd, 0x06, 0x52, 0x36, 0x2d, 0x87, 0xec, 0xa1,
-		0xb9, 0x3e, 0xa9, 0x29, 0xe0, 0xc1, 0xe9, 0x55, 0x06, 0x58, 0xb6, 0x1a, 0xa8, 0x94, 0xa8, 0xd7,
-		0xaa, 0xd5, 0x79, 0x39, 0xc3, 0x59, 0x2e, 0x5a, 0x0e, 0x0e, 0xa8, 0xa6, 0xe5, 0x3b, 0x38, 0x43,
-		0x03, 0xaa, 0x69, 0x71, 0xf7, 0x9e, 0x83, 0x71, 0x4d, 0xa3, 0x63, 0xd6, 0x35, 0x85, 0x1d, 0xc6,
-		0xdc, 0xbc, 0x18, 0x72, 0x96, 0xa6, 0x2d, 0x50, 0x05, 0x16, 0xe3, 0xae, 0xf4, 0x04, 0x1c, 0xec,
-		0x38, 0x2b, 0x08, 0x1c, 0xeb, 0x19, 0x65, 0x37, 0xf4, 0x1c, 0x8c, 0xdb, 0x3b, 0xbd, 0x40, 0x29,
-		0xd4, 0xa3, 0xbd, 0xd3, 0x0d, 0x7b, 0x1c, 0x26, 0xec, 0x2d, 0xbb, 0x17, 0xf7, 0x68, 0x10, 0x27,
-		0xd9, 0x5b, 0x76, 0x37, 0xf0, 0x21, 0x72, 0x32, 0x77, 0x90, 0xa6, 0x7a, 0xa8, 0x91, 0x3f, 0x1c,
-		0x54, 0x0f, 0x34, 0x48, 0xd3, 0x20, 0x6a, 0x9a, 0x82, 0x4c, 0x75, 0xc3, 0x40, 0x8a, 0xea, 0x20,
-		0x53, 0x75, 0xf3, 0x05, 0xa2, 0x1c, 0xf7, 0x9c, 0x36, 0x92, 0x73, 0x9a, 0x56, 0x25, 0x8d, 0x65,
-		0xd2, 0x26, 0x3d, 0x0a, 0x63, 0xd6, 0xc6, 0x15, 0x8d, 0x46, 0xa4, 0x62, 0x3b, 0x68, 0x53, 0xbf,
-		0x9e, 0x7f, 0x90, 0xb8, 0x77, 0x14, 0x37, 0x90, 0x78, 0xac, 0x11, 0xb1, 0xf4, 0x08, 0x88, 0x9a,
-		0xbb, 0xa5, 0x3a, 0x36, 0x49, 0xc9, 0xae, 0xad, 0x6a, 0x28, 0xff, 0x10, 0x55, 0xa5, 0xf2, 0x15,
-		0x2e, 0xc6, 0x2b, 0xc2, 0xbd, 0xa6, 0x6f, 0x7a, 0x9c, 0xf1, 0x04, 0x5d, 0x11, 0x44, 0xc6, 0xd8,
-		0xa6, 0x40, 0xc4, 0x9e, 0x08, 0x75, 0x3c, 0x45, 0xd4, 0x72, 0xf6, 0x96, 0x1d, 0xec, 0xf7, 0x01,
-		0x18, 0xc1, 0x9a, 0x9d, 0x4e, 0x1f, 0xa1, 0x85, 0x9b, 0xbd, 0x15, 0xe8, 0xf1, 0x2c, 0x1c, 0xc2,
-		0x4a, 0x2d, 0xe4, 0xa9, 0x0d, 0xd5, 0x53, 0x03, 0xda, 0x9f, 0x24, 0xda, 0xd8, 0xed, 0xcb, 0xac,
-		0x31, 0x64, 0xa7, 0xd3, 0xde, 0xd8, 0xf1, 0x03, 0xeb, 0x14, 0xb5, 0x13, 0xcb, 0x78, 0x68, 0xdd,
-		0xb3, 0xe2, 0xbc, 0x58, 0x82, 0x6c, 0x30, 0xee, 0xa5, 0x34, 0xd0, 0xc8, 0x17, 0x05, 0x5c, 0x04,
-		0xcd, 0xad, 0xce, 0xe3, 0xf2, 0xe5, 0x72, 0x55, 0x8c, 0xe1, 0x32, 0x6a, 0x69, 0x71, 0xad, 0xaa,
-		0xc8, 0xeb, 0x2b, 0x6b, 0x8b, 0xcb, 0x55, 0x71, 0x28, 0x50, 0xd8, 0x5f, 0x8a, 0xa7, 0x1e, 0x16,
-		0x4f, 0x14, 0xdf, 0x8e, 0x41, 0x2e, 0x7c, 0x52, 0x93, 0x7e, 0x1a, 0x0e, 0xf3, 0x6b, 0x15, 0x17,
-		0x79, 0xca, 0x35, 0xdd, 0x21, 0x0b, 0xb2, 0xa5, 0xd2, 0xcd, 0xd1, 0x8f, 0x9f, 0x09, 0xa6, 0x55,
-		0x47, 0xde, 0xb3, 0xba, 0x83, 0x97, 0x5b, 0x4b, 0xf5, 0xa4, 0x25, 0x28, 0x98, 0x96, 0xe2, 0x7a,
-		0xaa, 0xd9, 0x50, 0x9d, 0x86, 0xd2, 0xb9, 0xd0, 0x52, 0x54, 0x4d, 0x43, 0xae, 0x6b, 0xd1, 0x8d,
-		0xd0, 0x67, 0xf9, 0x84, 0x69, 0xd5, 0x99, 0x72, 0x67, 0x87, 0x28, 0x33, 0xd5, 0xae, 0xf0, 0x1d,
-		0xda, 0x2b, 0x7c, 0xef, 0x83, 0x74, 0x4b, 0xb5, 0x15, 0x64, 0x7a, 0xce, 0x0e, 0xa9, 0xcf, 0x53,
-		0x72, 0xaa, 0xa5, 0xda, 0x55, 0xfc, 0xfc, 0x91, 0x1c, 0x93, 0x2e, 0xc5, 0x53, 0x29, 0x31, 0x7d,
-		0x29, 0x9e, 0x4a, 0x8b, 0x50, 0x7c, 0x67, 0x08, 0xb2, 0xc1, 0x7a, 0x1d, 0x1f, 0x7f, 0x34, 0xb2,
-		0x63, 0x09, 0x24, 0xa7, 0x3d, 0x70, 0xd7, 0xea, 0x7e, 0x7a, 0x0e, 0x6f, 0x65, 0xa5, 0x24, 0x2d,
-		0x8e, 0x65, 0x8a, 0xc4, 0x65, 0x04, 0x0e, 0x36, 0x44, 0x8b, 0x91, 0x94, 0xcc, 0x9e, 0xa4, 0x05,
-		0x48, 0x5e, 0x71, 0x09, 0x77, 0x92, 0x70, 0x3f, 0x78, 0x77, 0xee, 0x4b, 0x75, 0x42, 0x9e, 0xbe,
-		0x54, 0x57, 0x56, 0x56, 0xe5, 0xe5, 0xf2, 0x92, 0xcc, 0xe0, 0xd2, 0x11, 0x88, 0x1b, 0xea, 0x8d,
-		0x9d, 0xf0, 0xa6, 0x47, 0x44, 0x83, 0x4e, 0xc2, 0x11, 0x88, 0x5f, 0x43, 0xea, 0x76, 0x78, 0xab,
-		0x21, 0xa2, 0x7b, 0xb8, 0x18, 0x66, 0x20, 0x41, 0xfc, 0x25, 0x01, 0x30, 0x8f, 0x89, 0x07, 0xa4,
-		0x14, 0xc4, 0xe7, 0x56, 0x65, 0xbc, 0x20, 0x44, 0xc8, 0x52, 0xa9, 0x52, 0x5b, 0xac, 0xce, 0x55,
-		0xc5, 0x58, 0xf1, 0x1c, 0x24, 0xa9, 0x13, 0xf0, 0x62, 0xf1, 0xdd, 0x20, 0x1e, 0x60, 0x8f, 0x8c,
-		0x43, 0xe0, 0xad, 0xeb, 0xcb, 0x95, 0xaa, 0x2c, 0xc6, 0xc2, 0x53, 0x1d, 0x17, 0x13, 0x45, 0x17,
-		0xb2, 0xc1, 0x3a, 0xfc, 0xa3, 0x39, 0x8c, 0x7f, 0x57, 0x80, 0x4c, 0xa0, 0xae, 0xc6, 0x05, 0x91,
-		0x6a, 0x18, 0xd6, 0x35, 0x45, 0x35, 0x74, 0xd5, 0x65, 0xa1, 0x01, 0x44, 0x54, 0xc6, 0x92, 0x41,
-		0xa7, 0xee, 0x23, 0x5a, 0x22, 0x09, 0x31, 0x59, 0x7c, 0x4d, 0x00, 0xb1, 0xbb, 0xb0, 0xed, 0x32,
-		0x53, 0xf8, 0x38, 0xcd, 0x2c, 0xbe, 0x2a, 0x40, 0x2e, 0x5c, 0xcd, 0x76, 0x99, 0x77, 0xfc, 0x63,
-		0x35, 0xef, 0xfb, 0x31, 0x18, 0x09, 0xd5, 0xb0, 0x83, 0x5a, 0xf7, 0x79, 0x18, 0xd3, 0x1b, 0xa8,
-		0x65, 0x5b, 0x1e, 0x32, 0xb5, 0x1d, 0xc5, 0x40, 0x57, 0x91, 0x91, 0x2f, 0x92, 0xa4, 0x31, 0x73,
-		0xf7, 0x2a, 0x79, 0x7a, 0xb1, 0x83, 0x5b, 0xc2, 0xb0, 0xd2, 0xf8, 0xe2, 0x7c, 0x75, 0xb9, 0xb6,
-		0xba, 0x56, 0x5d, 0x99, 0x7b, 0x5e, 0x59, 0x5f, 0x79, 0x7a, 0x65, 0xf5, 0xd9, 0x15, 0x59, 0xd4,
-		0xbb, 0xd4, 0xee, 0xe1, 0xb2, 0xaf, 0x81, 0xd8, 0x6d, 0x94, 0x74, 0x18, 0xfa, 0x99, 0x25, 0x1e,
-		0x90, 0xc6, 0x61, 0x74, 0x65, 0x55, 0xa9, 0x2f, 0xce, 0x57, 0x95, 0xea, 0xc5, 0x8b, 0xd5, 0xb9,
-		0xb5, 0x3a, 0xbd, 0xf7, 0xf0, 0xb5, 0xd7, 0x42, 0x0b, 0xbc, 0xf8, 0xca, 0x10, 0x8c, 0xf7, 0xb1,
-		0x44, 0x2a, 0xb3, 0x13, 0x0b, 0x3d, 0x44, 0x9d, 0x1a, 0xc4, 0xfa, 0x69, 0x5c, 0x33, 0xd4, 0x54,
-		0xc7, 0x63, 0x07, 0x9c, 0x47, 0x00, 0x7b, 0xc9, 0xf4, 0xf4, 0x4d, 0x1d, 0x39, 0xec, 0x3e, 0x89,
-		0x1e, 0x63, 0x46, 0x3b, 0x72, 0x7a, 0xa5, 0xf4, 0x49, 0x90, 0x6c, 0xcb, 0xd5, 0x3d, 0xfd, 0x2a,
-		0x52, 0x74, 0x93, 0x5f, 0x3e, 0xe1, 0x63, 0x4d, 0x5c, 0x16, 0x79, 0xcb, 0xa2, 0xe9, 0xf9, 0xda,
-		0x26, 0x6a, 0xaa, 0x5d, 0xda, 0x38, 0x99, 0x0f, 0xc9, 0x22, 0x6f, 0xf1, 0xb5, 0x8f, 0x43, 0xb6,
-		0x61, 0xb5, 0x71, 0xad, 0x47, 0xf5, 0xf0, 0xde, 0x21, 0xc8, 0x19, 0x2a, 0xf3, 0x55, 0x58, 0x15,
-		0xdf, 0xb9, 0xf5, 0xca, 0xca, 0x19, 0x2a, 0xa3, 0x2a, 0x27, 0x60, 0x54, 0x6d, 0x36, 0x1d, 0x4c,
-		0xce, 0x89, 0xe8, 0xb9, 0x24, 0xe7, 0x8b, 0x89, 0xe2, 0xe4, 0x25, 0x48, 0x71, 0x3f, 0xe0, 0xad,
-		0x1a, 0x7b, 0x42, 0xb1, 0xe9, 0x61, 0x3b, 0x36, 0x95, 0x96, 0x53, 0x26, 0x6f, 0x3c, 0x0e, 0x59,
-		0xdd, 0x55, 0x3a, 0x97, 0xf8, 0xb1, 0x63, 0xb1, 0xa9, 0x94, 0x9c, 0xd1, 0x5d, 0xff, 0x02, 0xb4,
-		0xf8, 0x46, 0x0c, 0x72, 0xe1, 0x97, 0x10, 0xd2, 0x3c, 0xa4, 0x0c, 0x4b, 0x53, 0x49, 0x68, 0xd1,
-		0x37, 0x60, 0x53, 0x11, 0xef, 0x2d, 0xa6, 0x97, 0x98, 0xbe, 0xec, 0x23, 0x27, 0xff, 0x49, 0x80,
-		0x14, 0x17, 0x4b, 0x87, 0x20, 0x6e, 0xab, 0xde, 0x16, 0xa1, 0x4b, 0x54, 0x62, 0xa2, 0x20, 0x93,
-		0x67, 0x2c, 0x77, 0x6d, 0xd5, 0x24, 0x21, 0xc0, 0xe4, 0xf8, 0x19, 0xcf, 0xab, 0x81, 0xd4, 0x06,
-		0x39, 0xf4, 0x58, 0xad, 0x16, 0x32, 0x3d, 0x97, 0xcf, 0x2b, 0x93, 0xcf, 0x31, 0xb1, 0x74, 0x12,
-		0xc6, 0x3c, 0x47, 0xd5, 0x8d, 0x90, 0x6e, 0x9c, 0xe8, 0x8a, 0xbc, 0xc1, 0x57, 0x2e, 0xc1, 0x11,
-		0xce, 0xdb, 0x40, 0x9e, 0xaa, 0x6d, 0xa1, 0x46, 0x07, 0x94, 0x24, 0x97, 0x1b, 0x87, 0x99, 0xc2,
-		0x3c, 0x6b, 0xe7, 0xd8, 0xe2, 0xdb, 0x02, 0x8c, 0xf1, 0x63, 0x5a, 0xc3, 0x77, 0xd6, 0x32, 0x80,
-		0x6a, 0x9a, 0x96, 0x17, 0x74, 0x57, 0x6f, 0x28, 0xf7, 0xe0, 0xa6, 0xcb, 0x3e, 0x48, 0x0e, 0x10,
-		0x4c, 0xb6, 0x00, 0x3a, 0x2d, 0x7b, 0xba, 0xad, 0x00, 0x19, 0xf6, 0x86, 0x89, 0xbc, 0xa6, 0xa4,
-		0x07, 0x7b, 0xa0, 0x22, 0x7c, 0x9e, 0x93, 0x26, 0x20, 0xb1, 0x81, 0x9a, 0xba, 0xc9, 0xee, 0x8d,
-		0xe9, 0x03, 0xbf, 0x7e, 0x89, 0xfb, 0xd7, 0x2f, 0x95, 0x2f, 0x0b, 0x30, 0xae, 0x59, 0xad, 0x6e,
-		0x7b, 0x2b, 0x62, 0xd7, 0xed, 0x82, 0xfb, 0x69, 0xe1, 0xf2, 0x93, 0x4d, 0xdd, 0xdb, 0x6a, 0x6f,
-		0x4c, 0x6b, 0x56, 0x6b, 0xa6, 0x69, 0x19, 0xaa, 0xd9, 0xec, 0xbc, 0x67, 0x25, 0x3f, 0xb4, 0x53,
-		0x4d, 0x64, 0x9e, 0x6a, 0x5a, 0x81, 0xb7, 0xae, 0xb3, 0x9d, 0x9f, 0xff, 0x27, 0x08, 0xaf, 0xc7,
-		0x86, 0x16, 0x6a, 0x95, 0x37, 0x63, 0x93, 0x0b, 0xb4, 0xbb, 0x1a, 0x77, 0x8f, 0x8c, 0x36, 0x0d,
-		0xa4, 0xe1, 0x21, 0xc3, 0xad, 0x93, 0x30, 0xd1, 0xb4, 0x9a, 0x16, 0x61, 0x9c, 0xc1, 0xbf, 0xd8,
-		0x9b, 0xdb, 0xb4, 0x2f, 0x9d, 0x8c, 0x7c, 0xcd, 0x5b, 0x5a, 0x81, 0x71, 0xa6, 0xac, 0x90, 0x57,
-		0x47, 0xf4, 0x60, 0x23, 0xdd, 0xf5, 0x56, 0x2d, 0xff, 0xcd, 0xf7, 0xc8, 0x86, 0x2e, 0x8f, 0x31,
-		0x28, 0x6e, 0xa3, 0x67, 0x9f, 0x92, 0x0c, 0x07, 0x43, 0x7c, 0x74, 0xd9, 0x22, 0x27, 0x82, 0xf1,
-		0xef, 0x19, 0xe3, 0x78, 0x80, 0xb1, 0xce, 0xa0, 0xa5, 0x39, 0x18, 0xd9, 0x0f, 0xd7, 0x3f, 0x30,
-		0xae, 0x2c, 0x0a, 0x92, 0x2c, 0xc0, 0x28, 0x21, 0xd1, 0xda, 0xae, 0x67, 0xb5, 0x48, 0x4e, 0xbc,
-		0x3b, 0xcd, 0x3f, 0xbe, 0x47, 0xd7, 0x51, 0x0e, 0xc3, 0xe6, 0x7c, 0x54, 0xa9, 0x04, 0xe4, 0x6d,
-		0x59, 0x03, 0x69, 0x46, 0x04, 0xc3, 0x5b, 0xcc, 0x10, 0x5f, 0xbf, 0xf4, 0x19, 0x98, 0xc0, 0xbf,
-		0x49, 0xca, 0x0a, 0x5a, 0x12, 0x7d, 0x05, 0x97, 0x7f, 0xfb, 0x45, 0xba, 0x54, 0xc7, 0x7d, 0x82,
-		0x80, 0x4d, 0x81, 0x59, 0x6c, 0x22, 0xcf, 0x43, 0x8e, 0xab, 0xa8, 0x46, 0x3f, 0xf3, 0x02, 0x77,
-		0x18, 0xf9, 0xaf, 0xbd, 0x1f, 0x9e, 0xc5, 0x05, 0x8a, 0x2c, 0x1b, 0x46, 0x69, 0x1d, 0x0e, 0xf7,
-		0x89, 0x8a, 0x01, 0x38, 0x5f, 0x61, 0x9c, 0x13, 0x3d, 0x91, 0x81, 0x69, 0x6b, 0xc0, 0xe5, 0xfe,
-		0x5c, 0x0e, 0xc0, 0xf9, 0x9b, 0x8c, 0x53, 0x62, 0x58, 0x3e, 0xa5, 0x98, 0xf1, 0x12, 0x8c, 0x5d,
-		0x45, 0xce, 0x86, 0xe5, 0xb2, 0x7b, 0xa3, 0x01, 0xe8, 0x5e, 0x65, 0x74, 0xa3, 0x0c, 0x48, 0x2e,
-		0x92, 0x30, 0xd7, 0x13, 0x90, 0xda, 0x54, 0x35, 0x34, 0x00, 0xc5, 0xd7, 0x19, 0xc5, 0x30, 0xd6,
-		0xc7, 0xd0, 0x32, 0x64, 0x9b, 0x16, 0xdb, 0xb5, 0xa2, 0xe1, 0xaf, 0x31, 0x78, 0x86, 0x63, 0x18,
-		0x85, 0x6d, 0xd9, 0x6d, 0x03, 0x6f, 0x69, 0xd1, 0x14, 0xbf, 0xc5, 0x29, 0x38, 0x86, 0x51, 0xec,
-		0xc3, 0xad, 0x37, 0x39, 0x85, 0x1b, 0xf0, 0xe7, 0x53, 0x90, 0xb1, 0x4c, 0x63, 0xc7, 0x32, 0x07,
-		0x31, 0xe2, 0x1b, 0x8c, 0x01, 0x18, 0x04, 0x13, 0xcc, 0x42, 0x7a, 0xd0, 0x89, 0xf8, 0x9d, 0xf7,
-		0xf9, 0xf2, 0xe0, 0x33, 0xb0, 0x00, 0xa3, 0x3c, 0x41, 0xe9, 0x96, 0x39, 0x00, 0xc5, 0xef, 0x32,
-		0x8a, 0x5c, 0x00, 0xc6, 0x86, 0xe1, 0x21, 0xd7, 0x6b, 0xa2, 0x41, 0x48, 0xde, 0xe0, 0xc3, 0x60,
-		0x10, 0xe6, 0xca, 0x0d, 0x64, 0x6a, 0x5b, 0x83, 0x31, 0xfc, 0x1e, 0x77, 0x25, 0xc7, 0x60, 0x8a,
-		0x39, 0x18, 0x69, 0xa9, 0x8e, 0xbb, 0xa5, 0x1a, 0x03, 0x4d, 0xc7, 0xef, 0x33, 0x8e, 0xac, 0x0f,
-		0x62, 0x1e, 0x69, 0x9b, 0xfb, 0xa1, 0x79, 0x93, 0x7b, 0x24, 0x00, 0x63, 0x4b, 0xcf, 0xf5, 0xc8,
-		0x25, 0xdb, 0x7e, 0xd8, 0xfe, 0x80, 0x2f, 0x3d, 0x8a, 0x5d, 0x0e, 0x32, 0xce, 0x42, 0xda, 0xd5,
-		0x6f, 0x0c, 0x44, 0xf3, 0x87, 0x7c, 0xa6, 0x09, 0x00, 0x83, 0x9f, 0x87, 0x23, 0x7d, 0xb7, 0x89,
-		0x01, 0xc8, 0xfe, 0x88, 0x91, 0x1d, 0xea, 0xb3, 0x55, 0xb0, 0x94, 0xb0, 0x5f, 0xca, 0x3f, 0xe6,
-		0x29, 0x01, 0x75, 0x71, 0xd5, 0xf0, 0x39, 0xc2, 0x55, 0x37, 0xf7, 0xe7, 0xb5, 0x3f, 0xe1, 0x5e,
-		0xa3, 0xd8, 0x90, 0xd7, 0xd6, 0xe0, 0x10, 0x63, 0xdc, 0xdf, 0xbc, 0xfe, 0x29, 0x4f, 0xac, 0x14,
-		0xbd, 0x1e, 0x9e, 0xdd, 0xcf, 0xc2, 0xa4, 0xef, 0x4e, 0x5e, 0xb0, 0xba, 0x4a, 0x4b, 0xb5, 0x07,
-		0x60, 0xfe, 0x26, 0x63, 0xe6, 0x19, 0xdf, 0xaf, 0x78, 0xdd, 0x65, 0xd5, 0xc6, 0xe4, 0xcf, 0x41,
-		0x9e, 0x93, 0xb7, 0x4d, 0x07, 0x69, 0x56, 0xd3, 0xd4, 0x6f, 0xa0, 0xc6, 0x00, 0xd4, 0x7f, 0xd6,
-		0x35, 0x55, 0xeb, 0x01, 0x38, 0x66, 0x5e, 0x04, 0xd1, 0xaf, 0x55, 0x14, 0xbd, 0x65, 0x5b, 0x8e,
-		0x17, 0xc1, 0xf8, 0xe7, 0x7c, 0xa6, 0x7c, 0xdc, 0x22, 0x81, 0x95, 0xaa, 0x40, 0xdf, 0x3c, 0x0f,
-		0x1a, 0x92, 0x7f, 0xc1, 0x88, 0x46, 0x3a, 0x28, 0x96, 0x38, 0x34, 0xab, 0x65, 0xab, 0xce, 0x20,
-		0xf9, 0xef, 0x2f, 0x79, 0xe2, 0x60, 0x10, 0x96, 0x38, 0xbc, 0x1d, 0x1b, 0xe1, 0xdd, 0x7e, 0x00,
-		0x86, 0x6f, 0xf1, 0xc4, 0xc1, 0x31, 0x8c, 0x82, 0x17, 0x0c, 0x03, 0x50, 0x7c, 0x9b, 0x53, 0x70,
-		0x0c, 0xa6, 0x78, 0xa6, 0xb3, 0xd1, 0x3a, 0xa8, 0xa9, 0xbb, 0x9e, 0x43, 0xcb, 0xe4, 0xbb, 0x53,
-		0xfd, 0xd5, 0xfb, 0xe1, 0x22, 0x4c, 0x0e, 0x40, 0x71, 0x26, 0x62, 0xd7, 0xae, 0xe4, 0x14, 0x15,
-		0x6d, 0xd8, 0x77, 0x78, 0x26, 0x0a, 0xc0, 0xb0, 0x6d, 0x81, 0x0a, 0x11, 0xbb, 0x5d, 0xc3, 0x67,
-		0x87, 0x01, 0xe8, 0xfe, 0xba, 0xcb, 0xb8, 0x3a, 0xc7, 0x62, 0xce, 0x40, 0xfd, 0xd3, 0x36, 0xb7,
-		0xd1, 0xce, 0x40, 0xd1, 0xf9, 0x37, 0x5d, 0xf5, 0xcf, 0x3a, 0x45, 0xd2, 0x1c, 0x32, 0xda, 0x55,
-		0x4f, 0x49, 0x51, 0xdf, 0x19, 0xe5, 0x7f, 0xee, 0x0e, 0x1b, 0x6f, 0xb8, 0x9c, 0x2a, 0x2d, 0xe1,
-		0x20, 0x0f, 0x17, 0x3d, 0xd1, 0x64, 0x2f, 0xde, 0xf1, 0xe3, 0x3c, 0x54, 0xf3, 0x94, 0x2e, 0xc2,
-		0x48, 0xa8, 0xe0, 0x89, 0xa6, 0xfa, 0x02, 0xa3, 0xca, 0x06, 0xeb, 0x9d, 0xd2, 0x39, 0x88, 0xe3,
-		0xe2, 0x25, 0x1a, 0xfe, 0xf3, 0x0c, 0x4e, 0xd4, 0x4b, 0x9f, 0x82, 0x14, 0x2f, 0x5a, 0xa2, 0xa1,
-		0xbf, 0xc0, 0xa0, 0x3e, 0x04, 0xc3, 0x79, 0xc1, 0x12, 0x0d, 0xff, 0x45, 0x0e, 0xe7, 0x10, 0x0c,
-		0x1f, 0xdc, 0x85, 0xdf, 0x7d, 0x29, 0xce, 0x36, 0x1d, 0xee, 0xbb, 0x59, 0x18, 0x66, 0x95, 0x4a,
-		0x34, 0xfa, 0x4b, 0xac, 0x73, 0x8e, 0x28, 0x3d, 0x0e, 0x89, 0x01, 0x1d, 0xfe, 0x4b, 0x0c, 0x4a,
-		0xf5, 0x4b, 0x73, 0x90, 0x09, 0x54, 0x27, 0xd1, 0xf0, 0x5f, 0x66, 0xf0, 0x20, 0x0a, 0x9b, 0xce,
-		0xaa, 0x93, 0x68, 0x82, 0x2f, 0x73, 0xd3, 0x19, 0x02, 0xbb, 0x8d, 0x17, 0x26, 0xd1, 0xe8, 0x97,
-		0xb9, 0xd7, 0x39, 0xa4, 0xf4, 0x14, 0xa4, 0xfd, 0xcd, 0x26, 0x1a, 0xff, 0x15, 0x86, 0xef, 0x60,
-		0xb0, 0x07, 0x02, 0x9b, 0x5d, 0x34, 0xc5, 0xaf, 0x70, 0x0f, 0x04, 0x50, 0x78, 0x19, 0x75, 0x17,
-		0x30, 0xd1, 0x4c, 0xbf, 0xca, 0x97, 0x51, 0x57, 0xfd, 0x82, 0x67, 0x93, 0xe4, 0xfc, 0x68, 0x8a,
-		0x5f, 0xe3, 0xb3, 0x49, 0xf4, 0xb1, 0x19, 0xdd, 0x15, 0x41, 0x34, 0xc7, 0x6f, 0x70, 0x33, 0xba,
-		0x0a, 0x82, 0x52, 0x0d, 0xa4, 0xde, 0x6a, 0x20, 0x9a, 0xef, 0xab, 0x8c, 0x6f, 0xac, 0xa7, 0x18,
-		0x28, 0x3d, 0x0b, 0x87, 0xfa, 0x57, 0x02, 0xd1, 0xac, 0x5f, 0xbb, 0xd3, 0x75, 0x76, 0x0b, 0x16,
-		0x02, 0xa5, 0xb5, 0xce, 0x96, 0x12, 0xac, 0x02, 0xa2, 0x69, 0x5f, 0xb9, 0x13, 0x4e, 0xdc, 0xc1,
-		0x22, 0xa0, 0x54, 0x06, 0xe8, 0x6c, 0xc0, 0xd1, 0x5c, 0xaf, 0x32, 0xae, 0x00, 0x08, 0x2f, 0x0d,
-		0xb6, 0xff, 0x46, 0xe3, 0xbf, 0xce, 0x97, 0x06, 0x43, 0xe0, 0xa5, 0xc1, 0xb7, 0xde, 0x68, 0xf4,
-		0x6b, 0x7c, 0x69, 0x70, 0x08, 0x8e, 0xec, 0xc0, 0xee, 0x16, 0xcd, 0xf0, 0x0d, 0x1e, 0xd9, 0x01,
-		0x54, 0x69, 0x05, 0xc6, 0x7a, 0x36, 0xc4, 0x68, 0xaa, 0xd7, 0x19, 0x95, 0xd8, 0xbd, 0x1f, 0x06,
-		0x37, 0x2f, 0xb6, 0x19, 0x46, 0xb3, 0xfd, 0x76, 0xd7, 0xe6, 0xc5, 0xf6, 0xc2, 0xd2, 0x2c, 0xa4,
-		0xcc, 0xb6, 0x61, 0xe0, 0xc5, 0x23, 0xdd, 0xfd, 0xdb, 0xc0, 0xfc, 0xbf, 0x7f, 0xc0, 0xbc, 0xc3,
-		0x01, 0xa5, 0x73, 0x90, 0x40, 0xad, 0x0d, 0xd4, 0x88, 0x42, 0xde, 0xfa, 0x80, 0x27, 0x4c, 0xac,
-		0x5d, 0x7a, 0x0a, 0x80, 0x5e, 0x8d, 0x90, 0xd7, 0x83, 0x11, 0xd8, 0xff, 0xf8, 0x80, 0x7d, 0x8c,
-		0xd3, 0x81, 0x74, 0x08, 0xe8, 0xa7, 0x3d, 0x77, 0x27, 0x78, 0x3f, 0x4c, 0x40, 0x66, 0xe4, 0x09,
-		0x18, 0xbe, 0xe2, 0x5a, 0xa6, 0xa7, 0x36, 0xa3, 0xd0, 0xff, 0xc9, 0xd0, 0x5c, 0x1f, 0x3b, 0xac,
-		0x65, 0x39, 0xc8, 0x53, 0x9b, 0x6e, 0x14, 0xf6, 0xbf, 0x18, 0xd6, 0x07, 0x60, 0xb0, 0xa6, 0xba,
-		0xde, 0x20, 0xe3, 0xfe, 0x6f, 0x0e, 0xe6, 0x00, 0x6c, 0x34, 0xfe, 0xbd, 0x8d, 0x76, 0xa2, 0xb0,
-		0x3f, 0xe4, 0x46, 0x33, 0xfd, 0xd2, 0xa7, 0x20, 0x8d, 0x7f, 0xd2, 0x2f, 0xec, 0x22, 0xc0, 0xff,
-		0xc3, 0xc0, 0x1d, 0x04, 0xee, 0xd9, 0xf5, 0x1a, 0x9e, 0x1e, 0xed, 0xec, 0xdb, 0x6c, 0xa6, 0xb9,
-		0x7e, 0xa9, 0x0c, 0x19, 0xd7, 0x6b, 0x34, 0xda, 0xac, 0x3e, 0x8d, 0x80, 0xff, 0xef, 0x07, 0xfe,
-		0x95, 0x85, 0x8f, 0xc1, 0xb3, 0x7d, 0x6d, 0xdb, 0xb3, 0x2d, 0xf2, 0x0a, 0x24, 0x8a, 0xe1, 0x0e,
-		0x63, 0x08, 0x40, 0x4a, 0x73, 0x90, 0xc5, 0x63, 0x71, 0x90, 0x8d, 0xc8, 0xfb, 0xaa, 0x08, 0x8a,
-		0x1f, 0x31, 0x07, 0x84, 0x40, 0x95, 0xcf, 0xbd, 0xf5, 0xce, 0x51, 0xe1, 0x7b, 0xef, 0x1c, 0x15,
-		0xbe, 0xff, 0xce, 0x51, 0xe1, 0xe5, 0x77, 0x8f, 0x1e, 0xf8, 0xde, 0xbb, 0x47, 0x0f, 0xfc, 0xf3,
-		0xbb, 0x47, 0x0f, 0xf4, 0xbf, 0x36, 0x86, 0x05, 0x6b, 0xc1, 0xa2, 0x17, 0xc6, 0x97, 0x8b, 0xa1,
-		0xeb, 0xe2, 0xa6, 0xd5, 0xb9, 0xad, 0xf5, 0x0f, 0x39, 0xf0, 0x72, 0x0c, 0x0a, 0xdd, 0x77, 0xb9,
-		0xd8, 0x81, 0xae, 0xa7, 0xb6, 0xec, 0xbd, 0xfe, 0x62, 0x67, 0x16, 0xd2, 0x6b, 0x5c, 0x47, 0xca,
-		0xc3, 0xb0, 0x8b, 0x34, 0xcb, 0x6c, 0xb8, 0xe4, 0x35, 0xe7, 0x90, 0xcc, 0x1f, 0xa5, 0x09, 0x48,
-		0x98, 0xaa, 0x69, 0xb9, 0xec, 0x3b, 0x41, 0xfa, 0x50, 0xf9, 0x75, 0x61, 0x7f, 0x23, 0xca, 0xf9,
-		0x5d, 0x91, 0x61, 0xd5, 0x84, 0xcb, 0x27, 0xef, 0x76, 0x0d, 0x8e, 0x43, 0xd6, 0xed, 0x0c, 0x21,
-		0x70, 0xe7, 0x7d, 0xb4, 0xfb, 0xce, 0xfb, 0x59, 0x64, 0x18, 0x4f, 0x9b, 0xd6, 0x35, 0x73, 0x0d,
-		0x63, 0x36, 0x92, 0xf4, 0x0b, 0x63, 0xf8, 0x76, 0x0a, 0xc6, 0xae, 0xcf, 0xa8, 0xb6, 0xed, 0x92,
-		0x7f, 0x98, 0x13, 0x92, 0xd7, 0xa7, 0xf1, 0xd3, 0x64, 0xdf, 0xab, 0xf1, 0xc9, 0x28, 0x1f, 0x16,
-		0xdf, 0x4c, 0x82, 0x48, 0x3a, 0x2e, 0xdb, 0xb6, 0xa1, 0xb3, 0x77, 0x32, 0x06, 0x0c, 0xab, 0x8d,
-		0x86, 0x83, 0x5c, 0xea, 0xba, 0x6c, 0x45, 0xbe, 0xb5, 0x5b, 0xe0, 0xa2, 0xdb, 0xbb, 0x85, 0xdc,
-		0x8e, 0xda, 0x32, 0x4a, 0x45, 0x26, 0x28, 0xfe, 0xff, 0x6e, 0xe1, 0xa7, 0x02, 0x43, 0xb7, 0xad,
-		0x6d, 0xef, 0x94, 0x89, 0xbc, 0x6b, 0x96, 0xb3, 0x3d, 0x63, 0x5b, 0xda, 0x36, 0xf2, 0x4e, 0x69,
-		0x96, 0x83, 0x66, 0x88, 0x0b, 0xa6, 0xcb, 0x14, 0x25, 0x73, 0x3e, 0xa9, 0x02, 0xc0, 0xfe, 0x64,
-		0x69, 0x1b, 0xed, 0x90, 0x39, 0xc9, 0x56, 0x1e, 0xb8, 0xb5, 0x5b, 0x08, 0x48, 0x6f, 0xef, 0x16,
-		0xc6, 0x68, 0x9f, 0x1d, 0x59, 0x51, 0x4e, 0xd3, 0x87, 0xa7, 0xd1, 0x8e, 0x74, 0x06, 0x92, 0x57,
-		0x54, 0xdd, 0xe0, 0x6f, 0xde, 0x2b, 0xf7, 0xdd, 0xda, 0x2d, 0x30, 0xc9, 0xed, 0xdd, 0xc2, 0x08,
-		0xc5, 0xd2, 0xe7, 0xa2, 0xcc, 0x1a, 0x24, 0x03, 0x92, 0xae, 0xa7, 0x7a, 0x6d, 0xfa, 0x52, 0x28,
-		0x51, 0x59, 0xc3, 0x20, 0x2a, 0xe9, 0x80, 0xe8, 0x33, 0x1e, 0xe3, 0xb9, 0xc1, 0xc7, 0x58, 0xf7,
-		0xd4, 0x6d, 0x54, 0x27, 0x48, 0x99, 0x31, 0x62, 0x13, 0xb5, 0x2d, 0x55, 0x37, 0x5d, 0xfa, 0xa9,
-		0x2c, 0x35, 0x91, 0x4a, 0x3a, 0xbd, 0xd1, 0xe7, 0xa2, 0xcc, 0x1a, 0xa4, 0xeb, 0x30, 0xe2, 0x62,
-		0xae, 0x86, 0xe2, 0x59, 0xdb, 0xc8, 0x74, 0xe9, 0xf7, 0xf4, 0x95, 0xfa, 0x5b, 0xbb, 0x85, 0x03,
-		0xff, 0xb2, 0x5b, 0x78, 0x6c, 0x70, 0x93, 0x2a, 0x7a, 0x73, 0xd1, 0xf4, 0x70, 0x9f, 0x94, 0xa9,
-		0xd3, 0x27, 0x7d, 0x2e, 0xca, 0x59, 0xda, 0xd3, 0x1a, 0x79, 0x94, 0x6e, 0x00, 0xb4, 0xd4, 0xeb,
-		0x8a, 0x83, 0x0c, 0x75, 0x87, 0xfe, 0xe9, 0x49, 0xba, 0xf2, 0xd9, 0x9f, 0xa0, 0xdb, 0x00, 0x5b,
-		0x67, 0x36, 0x3b, 0xb2, 0x22, 0xae, 0x9f, 0xaf, 0xcb, 0xe4, 0xb7, 0xf4, 0x92, 0x00, 0x47, 0xda,
-		0x26, 0x36, 0x87, 0xbd, 0xba, 0xb3, 0x0d, 0x44, 0x2e, 0x48, 0x71, 0xf4, 0xb2, 0x0f, 0xf7, 0x27,
-		0x7b, 0x92, 0x96, 0xbf, 0x1e, 0x2b, 0x67, 0xb0, 0x9d, 0xb7, 0x76, 0x0b, 0xb9, 0x0e, 0x09, 0x46,
-		0xde, 0xde, 0x2d, 0x1c, 0xa4, 0xfd, 0x86, 0xe5, 0xc5, 0x97, 0xff, 0xb5, 0x20, 0xc8, 0x87, 0x7d,
-		0xe1, 0x9c, 0xdf, 0x21, 0xa6, 0x2c, 0xa5, 0xbe, 0x78, 0xb3, 0x70, 0xe0, 0x07, 0x37, 0x0b, 0x42,
-		0x71, 0x03, 0xe2, 0x35, 0xcb, 0x32, 0xa4, 0x1a, 0x30, 0x27, 0xd2, 0x0f, 0x49, 0x2b, 0x17, 0x3e,
-		0xac, 0x5f, 0x64, 0xc6, 0x53, 0x4a, 0x61, 0xfe, 0x1f, 0xde, 0x2c, 0x08, 0x95, 0x4b, 0x7b, 0x65,
-		0xa1, 0xcb, 0x03, 0xb1, 0xb3, 0x04, 0xe1, 0x85, 0x33, 0x47, 0x02, 0x44, 0xd6, 0xd0, 0x72, 0x9b,
-		0x83, 0x24, 0x8e, 0xe2, 0x8f, 0x04, 0x18, 0x59, 0x76, 0x9b, 0x35, 0x7a, 0x16, 0x57, 0xb7, 0x91,
-		0x74, 0x16, 0x86, 0xed, 0xf6, 0x06, 0x59, 0xa3, 0x34, 0x29, 0x90, 0x00, 0xb6, 0xdb, 0x1b, 0x74,
-		0x7d, 0x8e, 0xf8, 0xeb, 0x93, 0xac, 0x4d, 0xdc, 0xc0, 0x16, 0x26, 0x8b, 0xfa, 0xd8, 0xe0, 0x51,
-		0xdf, 0xe4, 0x1f, 0xcd, 0x93, 0x8d, 0xa7, 0xf2, 0xcc, 0x4f, 0x10, 0x76, 0x94, 0xe8, 0xf6, 0x6e,
-		0x21, 0x4b, 0xbb, 0x22, 0x8f, 0x45, 0xf6, 0x71, 0x7d, 0x49, 0xc4, 0x53, 0xfb, 0xd5, 0x9b, 0x05,
-		0x01, 0xbb, 0xff, 0x8b, 0xaf, 0x17, 0x84, 0xe2, 0x9b, 0x02, 0x8c, 0x2e, 0xbb, 0xcd, 0x0a, 0x6a,
-		0xea, 0xe6, 0x3a, 0x09, 0x08, 0x24, 0x7d, 0x41, 0x80, 0xe1, 0x72, 0x28, 0x1f, 0x5e, 0xb9, 0xb5,
-		0x5b, 0x18, 0x57, 0x3b, 0x19, 0x53, 0xe9, 0xe4, 0xc6, 0x49, 0x96, 0x1b, 0x7b, 0x1b, 0x3f, 0x6c,
-		0x9e, 0x64, 0x3f, 0xfa, 0x18, 0xfb, 0x92, 0x00, 0xe9, 0x65, 0xb7, 0xb9, 0x6e, 0xe2, 0x84, 0x86,
-		0xb3, 0x76, 0xd9, 0xb6, 0xb1, 0xf6, 0xbd, 0xcc, 0xda, 0xac, 0x8b, 0x5e, 0x6b, 0xee, 0x45, 0xe4,
-		0xfe, 0x38, 0x00, 0x00, 0xff, 0xff, 0x18, 0x05, 0x79, 0x57, 0xba, 0x3b, 0x00, 0x00,
+		// 4741 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5b, 0x70, 0x1c, 0x57,
+		0x5a, 0xf6, 0x5c, 0x35, 0xf3, 0x6b, 0x34, 0xd3, 0x6a, 0x29, 0xf2, 0x58, 0xd9, 0x78, 0x9c, 0xc9,
+		0xc5, 0x4a, 0xb2, 0x96, 0x82, 0x1d, 0x3b, 0xc9, 0x98, 0x4d, 0x98, 0x91, 0xc6, 0x8a, 0x1c, 0x5d,
+		0x26, 0x3d, 0x52, 0x6e, 0x5b, 0x5b, 0x5d, 0xad, 0x9e, 0xa3, 0x51, 0x5b, 0x3d, 0xdd, 0xbd, 0xdd,
+		0x3d, 0xb6, 0xe5, 0xe2, 0x21, 0x54, 0x16, 0xd8, 0xad, 0x14, 0x6c, 0x16, 0x28, 0xd8, 0x84, 0x6c,
+		0xc8, 0x86, 0x82, 0x40, 0xb8, 0x2e, 0x97, 0x85, 0x85, 0x97, 0xa5, 0x28, 0x20, 0x2f, 0x50, 0x9b,
+		0xe2, 0x85, 0xe2, 0x41, 0x6c, 0x25, 0xa9, 0x62, 0x31, 0x01, 0x16, 0xe3, 0xad, 0xa2, 0xc8, 0x0b,
+		0x75, 0x6e, 0x3d, 0xdd, 0x3d, 0x23, 0xf7, 0x28, 0x29, 0x7b, 0xf7, 0xc5, 0x9e, 0xf3, 0x9f, 0xff,
+		0xfb, 0xce, 0x39, 0xff, 0xf9, 0xcf, 0x7f, 0xfe, 0x73, 0x4e, 0x0b, 0xfe, 0xfa, 0x2c, 0x1c, 0x6b,
+		0x9b, 0x66, 0x5b, 0x47, 0x73, 0x96, 0x6d, 0xba, 0xe6, 0x66, 0x77, 0x6b, 0xae, 0x85, 0x1c, 0xd5,
+		0xd6, 0x2c, 0xd7, 0xb4, 0x67, 0x89, 0x4c, 0x2c, 0x50, 0x8d, 0x59, 0xae, 0x51, 0x5e, 0x81, 0xf1,
+		0x73, 0x9a, 0x8e, 0x16, 0x3c, 0xc5, 0x26, 0x72, 0xc5, 0x47, 0x20, 0xb9, 0xa5, 0xe9, 0xa8, 0x18,
+		0x3b, 0x96, 0x98, 0x19, 0x3d, 0x79, 0xf7, 0x6c, 0x08, 0x34, 0x1b, 0x44, 0x34, 0xb0, 0x58, 0x22,
+		0x88, 0xf2, 0x07, 0x49, 0x98, 0x18, 0x50, 0x2b, 0x8a, 0x90, 0x34, 0x94, 0x0e, 0x66, 0x8c, 0xcd,
+		0x64, 0x25, 0xf2, 0x5b, 0x2c, 0xc2, 0x88, 0xa5, 0xa8, 0x3b, 0x4a, 0x1b, 0x15, 0xe3, 0x44, 0xcc,
+		0x8b, 0xe2, 0x51, 0x80, 0x16, 0xb2, 0x90, 0xd1, 0x42, 0x86, 0xba, 0x5b, 0x4c, 0x1c, 0x4b, 0xcc,
+		0x64, 0x25, 0x9f, 0x44, 0x7c, 0x00, 0xc6, 0xad, 0xee, 0xa6, 0xae, 0xa9, 0xb2, 0x4f, 0x0d, 0x8e,
+		0x25, 0x66, 0x52, 0x92, 0x40, 0x2b, 0x16, 0x7a, 0xca, 0xc7, 0xa1, 0x70, 0x09, 0x29, 0x3b, 0x7e,
+		0xd5, 0x51, 0xa2, 0x9a, 0xc7, 0x62, 0x9f, 0xe2, 0x3c, 0xe4, 0x3a, 0xc8, 0x71, 0x94, 0x36, 0x92,
+		0xdd, 0x5d, 0x0b, 0x15, 0x93, 0x64, 0xf4, 0xc7, 0xfa, 0x46, 0x1f, 0x1e, 0xf9, 0x28, 0x43, 0xad,
+		0xef, 0x5a, 0x48, 0xac, 0x42, 0x16, 0x19, 0xdd, 0x0e, 0x65, 0x48, 0xed, 0x63, 0xbf, 0xba, 0xd1,
+		0xed, 0x84, 0x59, 0x32, 0x18, 0xc6, 0x28, 0x46, 0x1c, 0x64, 0x5f, 0xd4, 0x54, 0x54, 0x4c, 0x13,
+		0x82, 0xe3, 0x7d, 0x04, 0x4d, 0x5a, 0x1f, 0xe6, 0xe0, 0x38, 0x71, 0x1e, 0xb2, 0xe8, 0xb2, 0x8b,
+		0x0c, 0x47, 0x33, 0x8d, 0xe2, 0x08, 0x21, 0xb9, 0x67, 0xc0, 0x2c, 0x22, 0xbd, 0x15, 0xa6, 0xe8,
+		0xe1, 0xc4, 0x33, 0x30, 0x62, 0x5a, 0xae, 0x66, 0x1a, 0x4e, 0x31, 0x73, 0x2c, 0x36, 0x33, 0x7a,
+		0xf2, 0x53, 0x03, 0x1d, 0x61, 0x8d, 0xea, 0x48, 0x5c, 0x59, 0x5c, 0x02, 0xc1, 0x31, 0xbb, 0xb6,
+		0x8a, 0x64, 0xd5, 0x6c, 0x21, 0x59, 0x33, 0xb6, 0xcc, 0x62, 0x96, 0x10, 0x94, 0xfa, 0x07, 0x42,
+		0x14, 0xe7, 0xcd, 0x16, 0x5a, 0x32, 0xb6, 0x4c, 0x29, 0xef, 0x04, 0xca, 0xe2, 0x14, 0xa4, 0x9d,
+		0x5d, 0xc3, 0x55, 0x2e, 0x17, 0x73, 0xc4, 0x43, 0x58, 0xa9, 0xfc, 0xad, 0x34, 0x14, 0x86, 0x71,
+		0xb1, 0xb3, 0x90, 0xda, 0xc2, 0xa3, 0x2c, 0xc6, 0x0f, 0x62, 0x03, 0x8a, 0x09, 0x1a, 0x31, 0xfd,
+		0x31, 0x8d, 0x58, 0x85, 0x51, 0x03, 0x39, 0x2e, 0x6a, 0x51, 0x8f, 0x48, 0x0c, 0xe9, 0x53, 0x40,
+		0x41, 0xfd, 0x2e, 0x95, 0xfc, 0x58, 0x2e, 0xf5, 0x2c, 0x14, 0xbc, 0x2e, 0xc9, 0xb6, 0x62, 0xb4,
+		0xb9, 0x6f, 0xce, 0x45, 0xf5, 0x64, 0xb6, 0xce, 0x71, 0x12, 0x86, 0x49, 0x79, 0x14, 0x28, 0x8b,
+		0x0b, 0x00, 0xa6, 0x81, 0xcc, 0x2d, 0xb9, 0x85, 0x54, 0xbd, 0x98, 0xd9, 0xc7, 0x4a, 0x6b, 0x58,
+		0xa5, 0xcf, 0x4a, 0x26, 0x95, 0xaa, 0xba, 0xf8, 0x68, 0xcf, 0xd5, 0x46, 0xf6, 0xf1, 0x94, 0x15,
+		0xba, 0xc8, 0xfa, 0xbc, 0x6d, 0x03, 0xf2, 0x36, 0xc2, 0x7e, 0x8f, 0x5a, 0x6c, 0x64, 0x59, 0xd2,
+		0x89, 0xd9, 0xc8, 0x91, 0x49, 0x0c, 0x46, 0x07, 0x36, 0x66, 0xfb, 0x8b, 0xe2, 0x5d, 0xe0, 0x09,
+		0x64, 0xe2, 0x56, 0x40, 0xa2, 0x50, 0x8e, 0x0b, 0x57, 0x95, 0x0e, 0x9a, 0xbe, 0x02, 0xf9, 0xa0,
+		0x79, 0xc4, 0x49, 0x48, 0x39, 0xae, 0x62, 0xbb, 0xc4, 0x0b, 0x53, 0x12, 0x2d, 0x88, 0x02, 0x24,
+		0x90, 0xd1, 0x22, 0x51, 0x2e, 0x25, 0xe1, 0x9f, 0xe2, 0x4f, 0xf4, 0x06, 0x9c, 0x20, 0x03, 0xbe,
+		0xb7, 0x7f, 0x46, 0x03, 0xcc, 0xe1, 0x71, 0x4f, 0x3f, 0x0c, 0x63, 0x81, 0x01, 0x0c, 0xdb, 0x74,
+		0xf9, 0x27, 0xe1, 0xb6, 0x81, 0xd4, 0xe2, 0xb3, 0x30, 0xd9, 0x35, 0x34, 0xc3, 0x45, 0xb6, 0x65,
+		0x23, 0xec, 0xb1, 0xb4, 0xa9, 0xe2, 0xbf, 0x8e, 0xec, 0xe3, 0x73, 0x1b, 0x7e, 0x6d, 0xca, 0x22,
+		0x4d, 0x74, 0xfb, 0x85, 0xf7, 0x67, 0x33, 0xdf, 0x1b, 0x11, 0x5e, 0x78, 0xe1, 0x85, 0x17, 0xe2,
+		0xe5, 0xbf, 0x4a, 0xc3, 0xe4, 0xa0, 0x35, 0x33, 0x70, 0xf9, 0x4e, 0x41, 0xda, 0xe8, 0x76, 0x36,
+		0x91, 0x4d, 0x8c, 0x94, 0x92, 0x58, 0x49, 0xac, 0x42, 0x4a, 0x57, 0x36, 0x91, 0x5e, 0x4c, 0x1e,
+		0x8b, 0xcd, 0xe4, 0x4f, 0x3e, 0x30, 0xd4, 0xaa, 0x9c, 0x5d, 0xc6, 0x10, 0x89, 0x22, 0xc5, 0xc7,
+		0x20, 0xc9, 0x42, 0x34, 0x66, 0xb8, 0x7f, 0x38, 0x06, 0xbc, 0x96, 0x24, 0x82, 0x13, 0x6f, 0x87,
+		0x2c, 0xfe, 0x9f, 0xfa, 0x46, 0x9a, 0xf4, 0x39, 0x83, 0x05, 0xd8, 0x2f, 0xc4, 0x69, 0xc8, 0x90,
+		0x65, 0xd2, 0x42, 0x7c, 0x6b, 0xf3, 0xca, 0xd8, 0xb1, 0x5a, 0x68, 0x4b, 0xe9, 0xea, 0xae, 0x7c,
+		0x51, 0xd1, 0xbb, 0x88, 0x38, 0x7c, 0x56, 0xca, 0x31, 0xe1, 0xd3, 0x58, 0x26, 0x96, 0x60, 0x94,
+		0xae, 0x2a, 0xcd, 0x68, 0xa1, 0xcb, 0x24, 0x7a, 0xa6, 0x24, 0xba, 0xd0, 0x96, 0xb0, 0x04, 0x37,
+		0x7f, 0xc1, 0x31, 0x0d, 0xee, 0x9a, 0xa4, 0x09, 0x2c, 0x20, 0xcd, 0x3f, 0x1c, 0x0e, 0xdc, 0x77,
+		0x0c, 0x1e, 0x5e, 0xdf, 0x5a, 0x3a, 0x0e, 0x05, 0xa2, 0x71, 0x8a, 0x4d, 0xbd, 0xa2, 0x17, 0xc7,
+		0x8f, 0xc5, 0x66, 0x32, 0x52, 0x9e, 0x8a, 0xd7, 0x98, 0xb4, 0xfc, 0xcd, 0x38, 0x24, 0x49, 0x60,
+		0x29, 0xc0, 0xe8, 0xfa, 0x73, 0x8d, 0xba, 0xbc, 0xb0, 0xb6, 0x51, 0x5b, 0xae, 0x0b, 0x31, 0x31,
+		0x0f, 0x40, 0x04, 0xe7, 0x96, 0xd7, 0xaa, 0xeb, 0x42, 0xdc, 0x2b, 0x2f, 0xad, 0xae, 0x9f, 0x79,
+		0x48, 0x48, 0x78, 0x80, 0x0d, 0x2a, 0x48, 0xfa, 0x15, 0x4e, 0x9d, 0x14, 0x52, 0xa2, 0x00, 0x39,
+		0x4a, 0xb0, 0xf4, 0x6c, 0x7d, 0xe1, 0xcc, 0x43, 0x42, 0x3a, 0x28, 0x39, 0x75, 0x52, 0x18, 0x11,
+		0xc7, 0x20, 0x4b, 0x24, 0xb5, 0xb5, 0xb5, 0x65, 0x21, 0xe3, 0x71, 0x36, 0xd7, 0xa5, 0xa5, 0xd5,
+		0x45, 0x21, 0xeb, 0x71, 0x2e, 0x4a, 0x6b, 0x1b, 0x0d, 0x01, 0x3c, 0x86, 0x95, 0x7a, 0xb3, 0x59,
+		0x5d, 0xac, 0x0b, 0xa3, 0x9e, 0x46, 0xed, 0xb9, 0xf5, 0x7a, 0x53, 0xc8, 0x05, 0xba, 0x75, 0xea,
+		0xa4, 0x30, 0xe6, 0x35, 0x51, 0x5f, 0xdd, 0x58, 0x11, 0xf2, 0xe2, 0x38, 0x8c, 0xd1, 0x26, 0x78,
+		0x27, 0x0a, 0x21, 0xd1, 0x99, 0x87, 0x04, 0xa1, 0xd7, 0x11, 0xca, 0x32, 0x1e, 0x10, 0x9c, 0x79,
+		0x48, 0x10, 0xcb, 0xf3, 0x90, 0x22, 0x6e, 0x28, 0x8a, 0x90, 0x5f, 0xae, 0xd6, 0xea, 0xcb, 0xf2,
+		0x5a, 0x63, 0x7d, 0x69, 0x6d, 0xb5, 0xba, 0x2c, 0xc4, 0x7a, 0x32, 0xa9, 0xfe, 0xd4, 0xc6, 0x92,
+		0x54, 0x5f, 0x10, 0xe2, 0x7e, 0x59, 0xa3, 0x5e, 0x5d, 0xaf, 0x2f, 0x08, 0x89, 0xb2, 0x0a, 0x93,
+		0x83, 0x02, 0xea, 0xc0, 0x25, 0xe4, 0xf3, 0x85, 0xf8, 0x3e, 0xbe, 0x40, 0xb8, 0xc2, 0xbe, 0x50,
+		0x7e, 0x3f, 0x0e, 0x13, 0x03, 0x36, 0x95, 0x81, 0x8d, 0x3c, 0x0e, 0x29, 0xea, 0xcb, 0x74, 0x9b,
+		0xbd, 0x6f, 0xe0, 0xee, 0x44, 0x3c, 0xbb, 0x6f, 0xab, 0x25, 0x38, 0x7f, 0xaa, 0x91, 0xd8, 0x27,
+		0xd5, 0xc0, 0x14, 0x7d, 0x0e, 0xfb, 0xb9, 0xbe, 0xe0, 0x4f, 0xf7, 0xc7, 0x33, 0xc3, 0xec, 0x8f,
+		0x44, 0x76, 0xb0, 0x4d, 0x20, 0x35, 0x60, 0x13, 0x38, 0x0b, 0xe3, 0x7d, 0x44, 0x43, 0x07, 0xe3,
+		0x17, 0x63, 0x50, 0xdc, 0xcf, 0x38, 0x11, 0x21, 0x31, 0x1e, 0x08, 0x89, 0x67, 0xc3, 0x16, 0xbc,
+		0x73, 0xff, 0x49, 0xe8, 0x9b, 0xeb, 0xb7, 0x62, 0x30, 0x35, 0x38, 0xa5, 0x1c, 0xd8, 0x87, 0xc7,
+		0x20, 0xdd, 0x41, 0xee, 0xb6, 0xc9, 0xd3, 0xaa, 0x7b, 0x07, 0x6c, 0xd6, 0xb8, 0x3a, 0x3c, 0xd9,
+		0x0c, 0x25, 0x3e, 0x1a, 0xee, 0x6b, 0x69, 0xbf, 0x04, 0xb7, 0xaf, 0xa7, 0x5f, 0x8a, 0xc3, 0x6d,
+		0x03, 0xc9, 0x07, 0x76, 0xf4, 0x0e, 0x00, 0xcd, 0xb0, 0xba, 0x2e, 0x4d, 0x9d, 0x68, 0x24, 0xce,
+		0x12, 0x09, 0x09, 0x5e, 0x38, 0xca, 0x76, 0x5d, 0xaf, 0x3e, 0x41, 0xea, 0x81, 0x8a, 0x88, 0xc2,
+		0x23, 0xbd, 0x8e, 0x26, 0x49, 0x47, 0x8f, 0xee, 0x33, 0xd2, 0x3e, 0xc7, 0x7c, 0x10, 0x04, 0x55,
+		0xd7, 0x90, 0xe1, 0xca, 0x8e, 0x6b, 0x23, 0xa5, 0xa3, 0x19, 0x6d, 0xb2, 0xd5, 0x64, 0x2a, 0xa9,
+		0x2d, 0x45, 0x77, 0x90, 0x54, 0xa0, 0xd5, 0x4d, 0x5e, 0x8b, 0x11, 0xc4, 0x81, 0x6c, 0x1f, 0x22,
+		0x1d, 0x40, 0xd0, 0x6a, 0x0f, 0x51, 0xfe, 0x4a, 0x16, 0x46, 0x7d, 0x09, 0xb8, 0x78, 0x27, 0xe4,
+		0x2e, 0x28, 0x17, 0x15, 0x99, 0x1f, 0xaa, 0xa8, 0x25, 0x46, 0xb1, 0xac, 0x41, 0x45, 0xe2, 0x83,
+		0x30, 0x49, 0x54, 0xcc, 0xae, 0x8b, 0x6c, 0x59, 0xd5, 0x15, 0xc7, 0x21, 0x46, 0xcb, 0x10, 0x55,
+		0x11, 0xd7, 0xad, 0xe1, 0xaa, 0x79, 0x5e, 0x23, 0x9e, 0x86, 0x09, 0x82, 0xe8, 0x74, 0x75, 0x57,
+		0xb3, 0x74, 0x24, 0xe3, 0x63, 0x9e, 0x53, 0x04, 0x7f, 0xcf, 0xc6, 0xb1, 0xc6, 0x0a, 0x53, 0xc0,
+		0x3d, 0x72, 0xc4, 0x05, 0xb8, 0x83, 0xc0, 0xda, 0xc8, 0x40, 0xb6, 0xe2, 0x22, 0x19, 0x7d, 0xbe,
+		0xab, 0xe8, 0x8e, 0xac, 0x18, 0x2d, 0x79, 0x5b, 0x71, 0xb6, 0x8b, 0x93, 0x98, 0xa0, 0x16, 0x2f,
+		0xc6, 0xa4, 0x23, 0x58, 0x71, 0x91, 0xe9, 0xd5, 0x89, 0x5a, 0xd5, 0x68, 0x3d, 0xa1, 0x38, 0xdb,
+		0x62, 0x05, 0xa6, 0x08, 0x8b, 0xe3, 0xda, 0x9a, 0xd1, 0x96, 0xd5, 0x6d, 0xa4, 0xee, 0xc8, 0x5d,
+		0x77, 0xeb, 0x91, 0xe2, 0xed, 0xfe, 0xf6, 0x49, 0x0f, 0x9b, 0x44, 0x67, 0x1e, 0xab, 0x6c, 0xb8,
+		0x5b, 0x8f, 0x88, 0x4d, 0xc8, 0xe1, 0xc9, 0xe8, 0x68, 0x57, 0x90, 0xbc, 0x65, 0xda, 0x64, 0x0f,
+		0xcd, 0x0f, 0x08, 0x4d, 0x3e, 0x0b, 0xce, 0xae, 0x31, 0xc0, 0x8a, 0xd9, 0x42, 0x95, 0x54, 0xb3,
+		0x51, 0xaf, 0x2f, 0x48, 0xa3, 0x9c, 0xe5, 0x9c, 0x69, 0x63, 0x87, 0x6a, 0x9b, 0x9e, 0x81, 0x47,
+		0xa9, 0x43, 0xb5, 0x4d, 0x6e, 0xde, 0xd3, 0x30, 0xa1, 0xaa, 0x74, 0xcc, 0x9a, 0x2a, 0xb3, 0xc3,
+		0x98, 0x53, 0x14, 0x02, 0xc6, 0x52, 0xd5, 0x45, 0xaa, 0xc0, 0x7c, 0xdc, 0x11, 0x1f, 0x85, 0xdb,
+		0x7a, 0xc6, 0xf2, 0x03, 0xc7, 0xfb, 0x46, 0x19, 0x86, 0x9e, 0x86, 0x09, 0x6b, 0xb7, 0x1f, 0x28,
+		0x06, 0x5a, 0xb4, 0x76, 0xc3, 0xb0, 0x87, 0x61, 0xd2, 0xda, 0xb6, 0xfa, 0x71, 0xf7, 0xfb, 0x71,
+		0xa2, 0xb5, 0x6d, 0x85, 0x81, 0xf7, 0x90, 0x93, 0xb9, 0x8d, 0x54, 0xc5, 0x45, 0xad, 0xe2, 0x61,
+		0xbf, 0xba, 0xaf, 0x42, 0x9c, 0x05, 0x41, 0x55, 0x65, 0x64, 0x28, 0x9b, 0x3a, 0x92, 0x15, 0x1b,
+		0x19, 0x8a, 0x53, 0x2c, 0x11, 0xe5, 0xa4, 0x6b, 0x77, 0x91, 0x94, 0x57, 0xd5, 0x3a, 0xa9, 0xac,
+		0x92, 0x3a, 0xf1, 0x7e, 0x18, 0x37, 0x37, 0x2f, 0xa8, 0xd4, 0x23, 0x65, 0xcb, 0x46, 0x5b, 0xda,
+		0xe5, 0xe2, 0xdd, 0xc4, 0xbc, 0x05, 0x5c, 0x41, 0xfc, 0xb1, 0x41, 0xc4, 0xe2, 0x7d, 0x20, 0xa8,
+		0xce, 0xb6, 0x62, 0x5b, 0x24, 0x24, 0x3b, 0x96, 0xa2, 0xa2, 0xe2, 0x3d, 0x54, 0x95, 0xca, 0x57,
+		0xb9, 0x18, 0xaf, 0x08, 0xe7, 0x92, 0xb6, 0xe5, 0x72, 0xc6, 0xe3, 0x74, 0x45, 0x10, 0x19, 0x63,
+		0x9b, 0x01, 0x01, 0x5b, 0x22, 0xd0, 0xf0, 0x0c, 0x51, 0xcb, 0x5b, 0xdb, 0x96, 0xbf, 0xdd, 0xbb,
+		0x60, 0xcc, 0xda, 0xf6, 0x37, 0x7a, 0x1f, 0x4d, 0xdc, 0xac, 0x6d, 0x5f, 0x8b, 0x0f, 0xc1, 0x14,
+		0x56, 0xea, 0x20, 0x57, 0x69, 0x29, 0xae, 0xe2, 0xd3, 0xfe, 0x34, 0xd1, 0xc6, 0x66, 0x5f, 0x61,
+		0x95, 0x81, 0x7e, 0xda, 0xdd, 0xcd, 0x5d, 0xcf, 0xb1, 0x4e, 0xd0, 0x7e, 0x62, 0x19, 0x77, 0xad,
+		0x9b, 0x96, 0x9c, 0x97, 0x2b, 0x90, 0xf3, 0xfb, 0xbd, 0x98, 0x05, 0xea, 0xf9, 0x42, 0x0c, 0x27,
+		0x41, 0xf3, 0x6b, 0x0b, 0x38, 0x7d, 0x79, 0xbe, 0x2e, 0xc4, 0x71, 0x1a, 0xb5, 0xbc, 0xb4, 0x5e,
+		0x97, 0xa5, 0x8d, 0xd5, 0xf5, 0xa5, 0x95, 0xba, 0x90, 0xf0, 0x25, 0xf6, 0xe7, 0x93, 0x99, 0x7b,
+		0x85, 0xe3, 0xe5, 0x77, 0xe3, 0x90, 0x0f, 0x9e, 0xd4, 0xc4, 0x1f, 0x87, 0xc3, 0xfc, 0x5a, 0xc5,
+		0x41, 0xae, 0x7c, 0x49, 0xb3, 0xc9, 0x82, 0xec, 0x28, 0x74, 0x73, 0xf4, 0xfc, 0x67, 0x92, 0x69,
+		0x35, 0x91, 0xfb, 0x8c, 0x66, 0xe3, 0xe5, 0xd6, 0x51, 0x5c, 0x71, 0x19, 0x4a, 0x86, 0x29, 0x3b,
+		0xae, 0x62, 0xb4, 0x14, 0xbb, 0x25, 0xf7, 0x2e, 0xb4, 0x64, 0x45, 0x55, 0x91, 0xe3, 0x98, 0x74,
+		0x23, 0xf4, 0x58, 0x3e, 0x65, 0x98, 0x4d, 0xa6, 0xdc, 0xdb, 0x21, 0xaa, 0x4c, 0x35, 0xe4, 0xbe,
+		0x89, 0xfd, 0xdc, 0xf7, 0x76, 0xc8, 0x76, 0x14, 0x4b, 0x46, 0x86, 0x6b, 0xef, 0x92, 0xfc, 0x3c,
+		0x23, 0x65, 0x3a, 0x8a, 0x55, 0xc7, 0xe5, 0x5b, 0x72, 0x4c, 0x3a, 0x9f, 0xcc, 0x64, 0x84, 0xec,
+		0xf9, 0x64, 0x26, 0x2b, 0x40, 0xf9, 0xbd, 0x04, 0xe4, 0xfc, 0xf9, 0x3a, 0x3e, 0xfe, 0xa8, 0x64,
+		0xc7, 0x8a, 0x91, 0x98, 0x76, 0xd7, 0x0d, 0xb3, 0xfb, 0xd9, 0x79, 0xbc, 0x95, 0x55, 0xd2, 0x34,
+		0x39, 0x96, 0x28, 0x12, 0xa7, 0x11, 0xd8, 0xd9, 0x10, 0x4d, 0x46, 0x32, 0x12, 0x2b, 0x89, 0x8b,
+		0x90, 0xbe, 0xe0, 0x10, 0xee, 0x34, 0xe1, 0xbe, 0xfb, 0xc6, 0xdc, 0xe7, 0x9b, 0x84, 0x3c, 0x7b,
+		0xbe, 0x29, 0xaf, 0xae, 0x49, 0x2b, 0xd5, 0x65, 0x89, 0xc1, 0xc5, 0x23, 0x90, 0xd4, 0x95, 0x2b,
+		0xbb, 0xc1, 0x4d, 0x8f, 0x88, 0x86, 0x9d, 0x84, 0x23, 0x90, 0xc4, 0x17, 0x74, 0xc1, 0xad, 0x86,
+		0x88, 0x6e, 0xe2, 0x62, 0x98, 0x83, 0x14, 0xb1, 0x97, 0x08, 0xc0, 0x2c, 0x26, 0x1c, 0x12, 0x33,
+		0x90, 0x9c, 0x5f, 0x93, 0xf0, 0x82, 0x10, 0x20, 0x47, 0xa5, 0x72, 0x63, 0xa9, 0x3e, 0x5f, 0x17,
+		0xe2, 0xe5, 0xd3, 0x90, 0xa6, 0x46, 0xc0, 0x8b, 0xc5, 0x33, 0x83, 0x70, 0x88, 0x15, 0x19, 0x47,
+		0x8c, 0xd7, 0x6e, 0xac, 0xd4, 0xea, 0x92, 0x10, 0x0f, 0x4e, 0x75, 0x52, 0x48, 0x95, 0x1d, 0xc8,
+		0xf9, 0xf3, 0xf0, 0x5b, 0x73, 0x18, 0xff, 0x76, 0x0c, 0x46, 0x7d, 0x79, 0x35, 0x4e, 0x88, 0x14,
+		0x5d, 0x37, 0x2f, 0xc9, 0x8a, 0xae, 0x29, 0x0e, 0x73, 0x0d, 0x20, 0xa2, 0x2a, 0x96, 0x0c, 0x3b,
+		0x75, 0xb7, 0x68, 0x89, 0xa4, 0x84, 0x74, 0xf9, 0xf5, 0x18, 0x08, 0xe1, 0xc4, 0x36, 0xd4, 0xcd,
+		0xd8, 0x0f, 0xb3, 0x9b, 0xe5, 0xd7, 0x62, 0x90, 0x0f, 0x66, 0xb3, 0xa1, 0xee, 0xdd, 0xf9, 0x43,
+		0xed, 0xde, 0x77, 0xe3, 0x30, 0x16, 0xc8, 0x61, 0x87, 0xed, 0xdd, 0xe7, 0x61, 0x5c, 0x6b, 0xa1,
+		0x8e, 0x65, 0xba, 0xf8, 0xf2, 0x5c, 0xd6, 0xd1, 0x45, 0xa4, 0x17, 0xcb, 0x24, 0x68, 0xcc, 0xdd,
+		0x38, 0x4b, 0x9e, 0x5d, 0xea, 0xe1, 0x96, 0x31, 0xac, 0x32, 0xb1, 0xb4, 0x50, 0x5f, 0x69, 0xac,
+		0xad, 0xd7, 0x57, 0xe7, 0x9f, 0x93, 0x37, 0x56, 0x9f, 0x5c, 0x5d, 0x7b, 0x66, 0x55, 0x12, 0xb4,
+		0x90, 0xda, 0x4d, 0x5c, 0xf6, 0x0d, 0x10, 0xc2, 0x9d, 0x12, 0x0f, 0xc3, 0xa0, 0x6e, 0x09, 0x87,
+		0xc4, 0x09, 0x28, 0xac, 0xae, 0xc9, 0xcd, 0xa5, 0x85, 0xba, 0x5c, 0x3f, 0x77, 0xae, 0x3e, 0xbf,
+		0xde, 0xa4, 0xf7, 0x1e, 0x9e, 0xf6, 0x7a, 0x60, 0x81, 0x97, 0x5f, 0x4d, 0xc0, 0xc4, 0x80, 0x9e,
+		0x88, 0x55, 0x76, 0x62, 0xa1, 0x87, 0xa8, 0x13, 0xc3, 0xf4, 0x7e, 0x16, 0xe7, 0x0c, 0x0d, 0xc5,
+		0x76, 0xd9, 0x01, 0xe7, 0x3e, 0xc0, 0x56, 0x32, 0x5c, 0x6d, 0x4b, 0x43, 0x36, 0xbb, 0x4f, 0xa2,
+		0xc7, 0x98, 0x42, 0x4f, 0x4e, 0xaf, 0x94, 0x3e, 0x0d, 0xa2, 0x65, 0x3a, 0x9a, 0xab, 0x5d, 0xc4,
+		0x57, 0xf2, 0xfc, 0xf2, 0x09, 0x1f, 0x6b, 0x92, 0x92, 0xc0, 0x6b, 0x96, 0x0c, 0xd7, 0xd3, 0x36,
+		0x50, 0x5b, 0x09, 0x69, 0xe3, 0x60, 0x9e, 0x90, 0x04, 0x5e, 0xe3, 0x69, 0xdf, 0x09, 0xb9, 0x96,
+		0xd9, 0xc5, 0xb9, 0x1e, 0xd5, 0xc3, 0x7b, 0x47, 0x4c, 0x1a, 0xa5, 0x32, 0x4f, 0x85, 0x65, 0xf1,
+		0xbd, 0x5b, 0xaf, 0x9c, 0x34, 0x4a, 0x65, 0x54, 0xe5, 0x38, 0x14, 0x94, 0x76, 0xdb, 0xc6, 0xe4,
+		0x9c, 0x88, 0x9e, 0x4b, 0xf2, 0x9e, 0x98, 0x28, 0x4e, 0x9f, 0x87, 0x0c, 0xb7, 0x03, 0xde, 0xaa,
+		0xb1, 0x25, 0x64, 0x8b, 0x1e, 0xb6, 0xe3, 0xf8, 0x22, 0xcc, 0xe0, 0x95, 0x77, 0x42, 0x4e, 0x73,
+		0xe4, 0xde, 0x25, 0x7e, 0xfc, 0x58, 0x7c, 0x26, 0x23, 0x8d, 0x6a, 0x8e, 0x77, 0x01, 0x5a, 0x7e,
+		0x2b, 0x0e, 0xf9, 0xe0, 0x23, 0x84, 0xb8, 0x00, 0x19, 0xdd, 0x54, 0x15, 0xe2, 0x5a, 0xf4, 0x05,
+		0x6c, 0x26, 0xe2, 0xdd, 0x62, 0x76, 0x99, 0xe9, 0x4b, 0x1e, 0x72, 0xfa, 0x1f, 0x62, 0x90, 0xe1,
+		0x62, 0x71, 0x0a, 0x92, 0x96, 0xe2, 0x6e, 0x13, 0xba, 0x54, 0x2d, 0x2e, 0xc4, 0x24, 0x52, 0xc6,
+		0x72, 0xc7, 0x52, 0x8c, 0x62, 0xbc, 0x27, 0xc7, 0x65, 0x3c, 0xaf, 0x3a, 0x52, 0x5a, 0xe4, 0xd0,
+		0x63, 0x76, 0x3a, 0xc8, 0x70, 0x1d, 0x3e, 0xaf, 0x4c, 0x3e, 0xcf, 0xc4, 0xf8, 0x2d, 0xcc, 0xb5,
+		0x15, 0x4d, 0x0f, 0xe8, 0x26, 0x89, 0xae, 0xc0, 0x2b, 0x3c, 0xe5, 0x0a, 0x1c, 0xe1, 0xbc, 0x2d,
+		0xe4, 0x2a, 0xea, 0x36, 0x6a, 0xf5, 0x40, 0x69, 0x72, 0xb9, 0x71, 0x98, 0x29, 0x2c, 0xb0, 0x7a,
+		0x8e, 0x2d, 0xbf, 0x1b, 0x83, 0x71, 0x7e, 0x4c, 0x6b, 0x79, 0xc6, 0x5a, 0x01, 0x50, 0x0c, 0xc3,
+		0x74, 0xfd, 0xe6, 0xea, 0x77, 0xe5, 0x3e, 0xdc, 0x6c, 0xd5, 0x03, 0x49, 0x3e, 0x82, 0xe9, 0x0e,
+		0x40, 0xaf, 0x66, 0x5f, 0xb3, 0x95, 0x60, 0x94, 0xbd, 0x30, 0x91, 0x67, 0x4a, 0x7a, 0xb0, 0x07,
+		0x2a, 0xc2, 0xe7, 0x39, 0x7c, 0xfd, 0xb2, 0x89, 0xda, 0x9a, 0xc1, 0xee, 0x8d, 0x69, 0x81, 0x5f,
+		0xbf, 0x24, 0xbd, 0xeb, 0x97, 0xda, 0x97, 0x63, 0x30, 0xa1, 0x9a, 0x9d, 0x70, 0x7f, 0x6b, 0x42,
+		0xe8, 0x76, 0xc1, 0x79, 0x22, 0xf6, 0xfc, 0x63, 0x6d, 0xcd, 0xdd, 0xee, 0x6e, 0xce, 0xaa, 0x66,
+		0x67, 0xae, 0x6d, 0xea, 0x8a, 0xd1, 0xee, 0xbd, 0xb3, 0x92, 0x1f, 0xea, 0x89, 0x36, 0x32, 0x4e,
+		0xb4, 0x4d, 0xdf, 0xab, 0xeb, 0xd9, 0xde, 0xcf, 0xff, 0x8d, 0xc5, 0xde, 0x8c, 0x27, 0x16, 0x1b,
+		0xb5, 0xb7, 0xe3, 0xd3, 0x8b, 0xb4, 0xb9, 0x06, 0x37, 0x8f, 0x84, 0xb6, 0x74, 0xa4, 0xe2, 0x21,
+		0xc3, 0xd5, 0x07, 0x60, 0xb2, 0x6d, 0xb6, 0x4d, 0xc2, 0x38, 0x87, 0x7f, 0xb1, 0x97, 0xdb, 0xac,
+		0x27, 0x9d, 0x8e, 0x7c, 0xe6, 0xad, 0xac, 0xc2, 0x04, 0x53, 0x96, 0xc9, 0xd3, 0x11, 0x3d, 0xd8,
+		0x88, 0x37, 0xbc, 0x55, 0x2b, 0x7e, 0xe3, 0x03, 0xb2, 0xa1, 0x4b, 0xe3, 0x0c, 0x8a, 0xeb, 0xe8,
+		0xd9, 0xa7, 0x22, 0xc1, 0x6d, 0x01, 0x3e, 0xba, 0x6c, 0x91, 0x1d, 0xc1, 0xf8, 0x37, 0x8c, 0x71,
+		0xc2, 0xc7, 0xd8, 0x64, 0xd0, 0xca, 0x3c, 0x8c, 0x1d, 0x84, 0xeb, 0x6f, 0x19, 0x57, 0x0e, 0xf9,
+		0x49, 0x16, 0xa1, 0x40, 0x48, 0xd4, 0xae, 0xe3, 0x9a, 0x1d, 0x12, 0x13, 0x6f, 0x4c, 0xf3, 0x77,
+		0x1f, 0xd0, 0x75, 0x94, 0xc7, 0xb0, 0x79, 0x0f, 0x55, 0xa9, 0x00, 0x79, 0x2d, 0xc3, 0xaf, 0x58,
+		0x11, 0x0c, 0xef, 0xb0, 0x8e, 0x78, 0xfa, 0x95, 0xa7, 0x61, 0x12, 0xff, 0x26, 0x21, 0xcb, 0xdf,
+		0x93, 0xe8, 0x2b, 0xb8, 0xe2, 0xbb, 0x2f, 0xd2, 0xa5, 0x3a, 0xe1, 0x11, 0xf8, 0xfa, 0xe4, 0x9b,
+		0xc5, 0x36, 0x72, 0x5d, 0x64, 0x3b, 0xb2, 0xa2, 0x0f, 0xea, 0x9e, 0xef, 0x0e, 0xa3, 0xf8, 0xca,
+		0x87, 0xc1, 0x59, 0x5c, 0xa4, 0xc8, 0xaa, 0xae, 0x57, 0x36, 0xe0, 0xf0, 0x00, 0xaf, 0x18, 0x82,
+		0xf3, 0x55, 0xc6, 0x39, 0xd9, 0xe7, 0x19, 0x98, 0xb6, 0x01, 0x5c, 0xee, 0xcd, 0xe5, 0x10, 0x9c,
+		0xbf, 0xca, 0x38, 0x45, 0x86, 0xe5, 0x53, 0x8a, 0x19, 0xcf, 0xc3, 0xf8, 0x45, 0x64, 0x6f, 0x9a,
+		0x0e, 0xbb, 0x37, 0x1a, 0x82, 0xee, 0x35, 0x46, 0x57, 0x60, 0x40, 0x72, 0x91, 0x84, 0xb9, 0x1e,
+		0x85, 0xcc, 0x96, 0xa2, 0xa2, 0x21, 0x28, 0xbe, 0xc6, 0x28, 0x46, 0xb0, 0x3e, 0x86, 0x56, 0x21,
+		0xd7, 0x36, 0xd9, 0xae, 0x15, 0x0d, 0x7f, 0x9d, 0xc1, 0x47, 0x39, 0x86, 0x51, 0x58, 0xa6, 0xd5,
+		0xd5, 0xf1, 0x96, 0x16, 0x4d, 0xf1, 0x6b, 0x9c, 0x82, 0x63, 0x18, 0xc5, 0x01, 0xcc, 0xfa, 0x06,
+		0xa7, 0x70, 0x7c, 0xf6, 0x7c, 0x1c, 0x3f, 0x27, 0xe9, 0xbb, 0xa6, 0x31, 0x4c, 0x27, 0xbe, 0xce,
+		0x18, 0x80, 0x41, 0x30, 0xc1, 0x59, 0xc8, 0x0e, 0x3b, 0x11, 0xbf, 0xf1, 0x21, 0x5f, 0x1e, 0x7c,
+		0x06, 0x16, 0xa1, 0xc0, 0x03, 0x14, 0x7e, 0x7e, 0x8e, 0xa6, 0xf8, 0x4d, 0x46, 0x91, 0xf7, 0xc1,
+		0xd8, 0x30, 0x5c, 0xe4, 0xb8, 0x6d, 0x34, 0x0c, 0xc9, 0x5b, 0x7c, 0x18, 0x0c, 0xc2, 0x4c, 0xb9,
+		0x89, 0x0c, 0x75, 0x7b, 0x38, 0x86, 0xdf, 0xe2, 0xa6, 0xe4, 0x18, 0x4c, 0x31, 0x0f, 0x63, 0x1d,
+		0xc5, 0x76, 0xb6, 0x15, 0x7d, 0xa8, 0xe9, 0xf8, 0x6d, 0xc6, 0x91, 0xf3, 0x40, 0xcc, 0x22, 0x5d,
+		0xe3, 0x20, 0x34, 0x6f, 0x73, 0x8b, 0x74, 0x8d, 0x00, 0x51, 0x03, 0x26, 0x1d, 0x97, 0x5c, 0xb2,
+		0x1d, 0x84, 0xed, 0x77, 0xf8, 0xd2, 0xa3, 0xd8, 0x15, 0x3f, 0xe3, 0x59, 0xc8, 0x3a, 0xda, 0x95,
+		0xa1, 0x68, 0x7e, 0x97, 0xcf, 0x34, 0x01, 0x60, 0xf0, 0x73, 0x70, 0x64, 0xe0, 0x36, 0x31, 0x04,
+		0xd9, 0xef, 0x31, 0xb2, 0xa9, 0x01, 0x5b, 0x05, 0x0b, 0x09, 0x07, 0xa5, 0xfc, 0x7d, 0x1e, 0x12,
+		0x50, 0x88, 0xab, 0x81, 0xcf, 0x11, 0x8e, 0xb2, 0x75, 0x30, 0xab, 0xfd, 0x01, 0xb7, 0x1a, 0xc5,
+		0x06, 0xac, 0xb6, 0x0e, 0x53, 0x8c, 0xf1, 0x60, 0xf3, 0xfa, 0x87, 0x3c, 0xb0, 0x52, 0xf4, 0x46,
+		0x70, 0x76, 0x3f, 0x0b, 0xd3, 0x9e, 0x39, 0x79, 0xc2, 0xea, 0xc8, 0xf8, 0x66, 0x2a, 0x9a, 0xf9,
+		0x1b, 0x8c, 0x99, 0x47, 0x7c, 0x2f, 0xe3, 0x75, 0x56, 0x14, 0x0b, 0x93, 0x3f, 0x0b, 0x45, 0x4e,
+		0xde, 0x35, 0x6c, 0xa4, 0x9a, 0x6d, 0x43, 0xbb, 0x82, 0x5a, 0x43, 0x50, 0xff, 0x51, 0x68, 0xaa,
+		0x36, 0x7c, 0x70, 0xcc, 0xbc, 0x04, 0x82, 0x97, 0xab, 0xc8, 0x5a, 0xc7, 0x32, 0x6d, 0x37, 0x82,
+		0xf1, 0x8f, 0xf9, 0x4c, 0x79, 0xb8, 0x25, 0x02, 0xab, 0xd4, 0x81, 0xbe, 0x3c, 0x0f, 0xeb, 0x92,
+		0x7f, 0xc2, 0x88, 0xc6, 0x7a, 0x28, 0x16, 0x38, 0x54, 0xb3, 0x63, 0x29, 0xf6, 0x30, 0xf1, 0xef,
+		0x4f, 0x79, 0xe0, 0x60, 0x10, 0x16, 0x38, 0xf0, 0xad, 0x16, 0xde, 0xed, 0x87, 0x60, 0xf8, 0x26,
+		0x0f, 0x1c, 0x1c, 0xc3, 0x28, 0x78, 0xc2, 0x30, 0x04, 0xc5, 0x9f, 0x71, 0x0a, 0x8e, 0xc1, 0x14,
+		0x4f, 0xf5, 0x36, 0x5a, 0x1b, 0xb5, 0x35, 0xc7, 0xb5, 0x69, 0x9a, 0x7c, 0x63, 0xaa, 0x3f, 0xff,
+		0x30, 0x98, 0x84, 0x49, 0x3e, 0x28, 0x8e, 0x44, 0xec, 0xda, 0x95, 0x9c, 0xa2, 0xa2, 0x3b, 0xf6,
+		0x2d, 0x1e, 0x89, 0x7c, 0x30, 0xdc, 0x37, 0x5f, 0x86, 0x88, 0xcd, 0xae, 0xe2, 0xb3, 0xc3, 0x10,
+		0x74, 0x7f, 0x11, 0xea, 0x5c, 0x93, 0x63, 0x31, 0xa7, 0x2f, 0xff, 0xe9, 0x1a, 0x3b, 0x68, 0x77,
+		0x28, 0xef, 0xfc, 0xcb, 0x50, 0xfe, 0xb3, 0x41, 0x91, 0x34, 0x86, 0x14, 0x42, 0xf9, 0x94, 0x18,
+		0xf5, 0x9d, 0x51, 0xf1, 0xa7, 0xae, 0xb3, 0xf1, 0x06, 0xd3, 0xa9, 0xca, 0x32, 0x08, 0x4c, 0xd2,
+		0x4b, 0x60, 0x23, 0xc9, 0x5e, 0xbc, 0xee, 0xf9, 0x79, 0x20, 0xe7, 0xa9, 0x9c, 0x83, 0xb1, 0x40,
+		0xc2, 0x13, 0x4d, 0xf5, 0x05, 0x46, 0x95, 0xf3, 0xe7, 0x3b, 0x95, 0xd3, 0x90, 0xc4, 0xc9, 0x4b,
+		0x34, 0xfc, 0xa7, 0x19, 0x9c, 0xa8, 0x57, 0x3e, 0x03, 0x19, 0x9e, 0xb4, 0x44, 0x43, 0x7f, 0x86,
+		0x41, 0x3d, 0x08, 0x86, 0xf3, 0x84, 0x25, 0x1a, 0xfe, 0xb3, 0x1c, 0xce, 0x21, 0x18, 0x3e, 0xbc,
+		0x09, 0xbf, 0xfd, 0x52, 0x92, 0xc2, 0x39, 0xa4, 0x82, 0x5f, 0xbe, 0x69, 0xa6, 0x12, 0x8d, 0xfe,
+		0x12, 0x6b, 0x9c, 0x23, 0x2a, 0x0f, 0x43, 0x6a, 0x48, 0x83, 0xff, 0x1c, 0x83, 0x52, 0xfd, 0xca,
+		0x3c, 0x8c, 0xfa, 0xb2, 0x93, 0x68, 0xf8, 0xcf, 0x33, 0xb8, 0x1f, 0x85, 0xbb, 0xce, 0xb2, 0x93,
+		0x68, 0x82, 0x2f, 0xf3, 0xae, 0x33, 0x04, 0x36, 0x1b, 0x4f, 0x4c, 0xa2, 0xd1, 0x2f, 0x73, 0xab,
+		0x73, 0x48, 0xe5, 0x71, 0xc8, 0x7a, 0x9b, 0x4d, 0x34, 0xfe, 0x2b, 0x0c, 0xdf, 0xc3, 0x60, 0x0b,
+		0x74, 0x8d, 0x03, 0x50, 0xfc, 0x02, 0xb7, 0x80, 0x0f, 0x85, 0x97, 0x51, 0x38, 0x81, 0x89, 0x66,
+		0xfa, 0x45, 0xbe, 0x8c, 0x42, 0xf9, 0x0b, 0x9e, 0x4d, 0x12, 0xf3, 0xa3, 0x29, 0x7e, 0x89, 0xcf,
+		0x26, 0xd1, 0xc7, 0xdd, 0x08, 0x67, 0x04, 0xd1, 0x1c, 0xbf, 0xc2, 0xbb, 0x11, 0x4a, 0x08, 0x2a,
+		0x0d, 0x10, 0xfb, 0xb3, 0x81, 0x68, 0xbe, 0xaf, 0x32, 0xbe, 0xf1, 0xbe, 0x64, 0xa0, 0xf2, 0x0c,
+		0x4c, 0x0d, 0xce, 0x04, 0xa2, 0x59, 0x5f, 0xb9, 0x1e, 0x3a, 0xbb, 0xf9, 0x13, 0x81, 0xca, 0x3a,
+		0x4c, 0x0e, 0xca, 0x02, 0xa2, 0x69, 0x5f, 0xbd, 0x1e, 0x0c, 0xdc, 0xfe, 0x24, 0xa0, 0x52, 0x05,
+		0xe8, 0x6d, 0xc0, 0xd1, 0x5c, 0xaf, 0x31, 0x2e, 0x1f, 0x08, 0x2f, 0x0d, 0xb6, 0xff, 0x46, 0xe3,
+		0xbf, 0xc6, 0x97, 0x06, 0x43, 0xe0, 0xa5, 0xc1, 0xb7, 0xde, 0x68, 0xf4, 0xeb, 0x7c, 0x69, 0x70,
+		0x08, 0xf6, 0x6c, 0xdf, 0xee, 0x16, 0xcd, 0xf0, 0x75, 0xee, 0xd9, 0x3e, 0x54, 0x65, 0x15, 0xc6,
+		0xfb, 0x36, 0xc4, 0x68, 0xaa, 0x37, 0x19, 0x95, 0x10, 0xde, 0x0f, 0xfd, 0x9b, 0x17, 0xdb, 0x0c,
+		0xa3, 0xd9, 0x7e, 0x3d, 0xb4, 0x79, 0xb1, 0xbd, 0xb0, 0x72, 0x16, 0x32, 0x46, 0x57, 0xd7, 0xf1,
+		0xe2, 0x11, 0x6f, 0xfc, 0x6d, 0x60, 0xf1, 0xdf, 0x3e, 0x62, 0xd6, 0xe1, 0x80, 0xca, 0x69, 0x48,
+		0xa1, 0xce, 0x26, 0x6a, 0x45, 0x21, 0xaf, 0x7e, 0xc4, 0x03, 0x26, 0xd6, 0xae, 0x3c, 0x0e, 0x40,
+		0xaf, 0x46, 0xc8, 0xf3, 0x60, 0x04, 0xf6, 0xdf, 0x3f, 0x62, 0x1f, 0xe3, 0xf4, 0x20, 0x3d, 0x02,
+		0xfa, 0x69, 0xcf, 0x8d, 0x09, 0x3e, 0x0c, 0x12, 0x90, 0x19, 0x79, 0x14, 0x46, 0xf0, 0x27, 0x92,
+		0xae, 0xd2, 0x8e, 0x42, 0xff, 0x07, 0x43, 0x73, 0x7d, 0x6c, 0xb0, 0x8e, 0x69, 0x23, 0x57, 0x69,
+		0x3b, 0x51, 0xd8, 0xff, 0x64, 0x58, 0x0f, 0x80, 0xc1, 0xaa, 0xe2, 0xb8, 0xc3, 0x8c, 0xfb, 0xbf,
+		0x38, 0x98, 0x03, 0x70, 0xa7, 0xf1, 0xef, 0x1d, 0xb4, 0x1b, 0x85, 0xfd, 0x3e, 0xef, 0x34, 0xd3,
+		0xaf, 0x7c, 0x06, 0xb2, 0xf8, 0x27, 0xfd, 0xc2, 0x2e, 0x02, 0xfc, 0xdf, 0x0c, 0xdc, 0x43, 0xe0,
+		0x96, 0x1d, 0xb7, 0xe5, 0x6a, 0xd1, 0xc6, 0xbe, 0xc6, 0x66, 0x9a, 0xeb, 0x57, 0xaa, 0x30, 0xea,
+		0xb8, 0xad, 0x56, 0x97, 0xe5, 0xa7, 0x11, 0xf0, 0xff, 0xf9, 0xc8, 0xbb, 0xb2, 0xf0, 0x30, 0x78,
+		0xb6, 0x2f, 0xed, 0xb8, 0x96, 0x49, 0x9e, 0x40, 0xa2, 0x18, 0xae, 0x33, 0x06, 0x1f, 0xa4, 0x32,
+		0x0f, 0x39, 0x3c, 0x16, 0x1b, 0x59, 0x88, 0xbc, 0x57, 0x45, 0x50, 0xfc, 0x80, 0x19, 0x20, 0x00,
+		0xaa, 0x7d, 0xee, 0x9d, 0xf7, 0x8e, 0xc6, 0xbe, 0xf3, 0xde, 0xd1, 0xd8, 0x77, 0xdf, 0x3b, 0x1a,
+		0x7b, 0xf9, 0xfd, 0xa3, 0x87, 0xbe, 0xf3, 0xfe, 0xd1, 0x43, 0xff, 0xf4, 0xfe, 0xd1, 0x43, 0x83,
+		0xaf, 0x8d, 0x61, 0xd1, 0x5c, 0x34, 0xe9, 0x85, 0xf1, 0xf3, 0xe5, 0xc0, 0x75, 0x71, 0xdb, 0xec,
+		0xdd, 0xd6, 0x7a, 0x87, 0x1c, 0xf8, 0xc7, 0x11, 0x10, 0x2e, 0xcf, 0x29, 0x96, 0xe5, 0xcc, 0x75,
+		0x9c, 0x36, 0xe5, 0x11, 0xd3, 0x97, 0x67, 0xb1, 0x64, 0x7a, 0xe0, 0x35, 0x70, 0xf9, 0x07, 0x31,
+		0x18, 0x5b, 0x71, 0xda, 0x0d, 0x9a, 0x3e, 0x2a, 0x3b, 0xf8, 0x93, 0x93, 0x11, 0xab, 0xbb, 0x29,
+		0xef, 0xa0, 0x5d, 0xf2, 0xd2, 0x99, 0xab, 0xdd, 0x7e, 0x75, 0xaf, 0x94, 0xb6, 0xba, 0x9b, 0x3b,
+		0x68, 0xf7, 0xda, 0x5e, 0x69, 0x6c, 0x57, 0xe9, 0xe8, 0x95, 0x32, 0x2d, 0x97, 0x25, 0x5c, 0xf1,
+		0x24, 0xda, 0x15, 0x4f, 0x41, 0x5a, 0xdd, 0x56, 0x34, 0xf2, 0x59, 0x68, 0x62, 0x26, 0x4b, 0x41,
+		0x54, 0xd2, 0x03, 0xd1, 0x72, 0x59, 0x62, 0x15, 0x62, 0x9b, 0x7f, 0xe7, 0x49, 0x6c, 0x55, 0x7b,
+		0xea, 0x9d, 0xbd, 0xd2, 0xa1, 0x7f, 0xde, 0x2b, 0x3d, 0xe8, 0x1b, 0xaa, 0x65, 0xee, 0xb8, 0x27,
+		0x0c, 0xe4, 0x5e, 0x32, 0xed, 0x9d, 0x39, 0xcb, 0x54, 0x77, 0x90, 0x7b, 0x42, 0x35, 0x6d, 0x34,
+		0x87, 0xbd, 0xd9, 0x99, 0xad, 0x69, 0xed, 0x25, 0xc3, 0xbd, 0xba, 0x57, 0xa2, 0x44, 0xd7, 0xf6,
+		0x4a, 0x39, 0xda, 0x14, 0x29, 0x96, 0xd9, 0xf7, 0xa0, 0x15, 0xe1, 0x8b, 0x6f, 0x94, 0x0e, 0x7d,
+		0xf5, 0x8d, 0x52, 0xec, 0x7b, 0x6f, 0x94, 0x62, 0x5f, 0x7c, 0xb3, 0x14, 0x2b, 0xbf, 0x1d, 0x83,
+		0xc2, 0x8a, 0xd3, 0xae, 0xe1, 0x8b, 0xfc, 0x0d, 0xc3, 0x21, 0x23, 0xff, 0x42, 0x0c, 0x46, 0xaa,
+		0xad, 0x96, 0x8d, 0x1c, 0x87, 0x0d, 0xfd, 0xc2, 0xd5, 0xbd, 0xd2, 0x84, 0x62, 0x59, 0xba, 0x46,
+		0x1f, 0x5e, 0x64, 0x85, 0x56, 0x5f, 0xdb, 0x2b, 0x4d, 0xd3, 0x76, 0x06, 0x54, 0x96, 0xff, 0x6f,
+		0xaf, 0xf4, 0x63, 0xc3, 0x0f, 0x81, 0xb5, 0x28, 0xf1, 0xa6, 0x07, 0x74, 0xf6, 0x95, 0x38, 0x8c,
+		0xe3, 0x49, 0x52, 0x6c, 0x57, 0x53, 0xf4, 0x1f, 0xad, 0xee, 0x8a, 0x3b, 0x90, 0x56, 0x3a, 0x66,
+		0xd7, 0x70, 0xe9, 0xbb, 0x49, 0xad, 0xf9, 0x09, 0x66, 0x91, 0x31, 0xf5, 0x3c, 0x86, 0x96, 0xcb,
+		0x12, 0xab, 0x18, 0x60, 0x9b, 0x97, 0x62, 0x90, 0x5d, 0x71, 0xda, 0x1b, 0xc6, 0x05, 0x45, 0xd3,
+		0x45, 0x1d, 0x46, 0xaa, 0x96, 0x85, 0xbb, 0xc6, 0x4c, 0x22, 0x5d, 0xdd, 0x2b, 0x8d, 0xf4, 0xcc,
+		0x90, 0x67, 0xb4, 0x9f, 0x70, 0xe8, 0xb4, 0x89, 0xfe, 0xde, 0xd4, 0xce, 0xef, 0xb7, 0xc0, 0x9f,
+		0x1f, 0xca, 0x1c, 0x6c, 0xf1, 0x92, 0xe6, 0x36, 0xd3, 0xf4, 0x23, 0x79, 0x78, 0x39, 0x0e, 0xa5,
+		0xf0, 0x0b, 0x0d, 0x0e, 0x8b, 0x8e, 0xab, 0x74, 0xac, 0xfd, 0xfe, 0x0e, 0xef, 0x2c, 0x64, 0xd7,
+		0xb9, 0x0e, 0xfe, 0xcb, 0x38, 0x07, 0xa9, 0xa6, 0xd1, 0xa2, 0x8e, 0x92, 0x90, 0x78, 0x11, 0x3f,
+		0x6c, 0x19, 0x8a, 0x61, 0x3a, 0xec, 0xeb, 0x5f, 0x5a, 0xa8, 0xfd, 0x72, 0xec, 0x60, 0x71, 0x2a,
+		0xef, 0x35, 0x45, 0xe2, 0x48, 0x23, 0xf6, 0xfc, 0x03, 0x37, 0x7a, 0xdc, 0x22, 0xc3, 0xeb, 0x0d,
+		0xc1, 0xf7, 0x92, 0x75, 0x34, 0xfc, 0x92, 0xf5, 0x0c, 0xd2, 0xf5, 0x27, 0x0d, 0xf3, 0x92, 0xb1,
+		0x1e, 0x30, 0xc9, 0x5e, 0x0e, 0xc6, 0x99, 0xad, 0xf0, 0x3f, 0xc3, 0x44, 0xba, 0xe9, 0x28, 0x1b,
+		0x96, 0xdf, 0x4e, 0x83, 0x40, 0x1a, 0xae, 0xf6, 0x16, 0x09, 0x76, 0x28, 0x25, 0xb0, 0xc6, 0x6e,
+		0x8a, 0x43, 0x31, 0xb8, 0x58, 0x03, 0x60, 0x7f, 0x88, 0x88, 0xc3, 0x6f, 0x9c, 0x34, 0x78, 0xd7,
+		0xd5, 0xbd, 0x92, 0x4f, 0x7a, 0x6d, 0xaf, 0x34, 0xee, 0x85, 0x60, 0x26, 0x2b, 0x4b, 0x59, 0x5a,
+		0x60, 0x91, 0x18, 0x2f, 0x05, 0xfe, 0x3d, 0x0d, 0x8d, 0xc4, 0x54, 0xd2, 0x5b, 0x57, 0xb4, 0x5c,
+		0x96, 0x58, 0x85, 0xa8, 0x43, 0xda, 0x71, 0x15, 0xb7, 0x4b, 0x9f, 0x7a, 0x53, 0xb5, 0x75, 0x0c,
+		0xa2, 0x92, 0x1e, 0x88, 0x96, 0xf1, 0x18, 0x4f, 0x0f, 0x3f, 0x46, 0xb2, 0xa9, 0x34, 0x09, 0x52,
+		0x62, 0x8c, 0xbe, 0xcd, 0x22, 0x35, 0xfc, 0x66, 0x71, 0x19, 0xc6, 0x48, 0xdc, 0x6b, 0xc9, 0xae,
+		0xb9, 0x83, 0x0c, 0xa7, 0x98, 0xfe, 0xe4, 0xe1, 0x86, 0x32, 0xf5, 0xda, 0xa4, 0xe5, 0xb2, 0x94,
+		0xa3, 0x2d, 0xad, 0x93, 0xa2, 0x78, 0x05, 0xa0, 0xa3, 0x5c, 0x96, 0x6d, 0xa4, 0x2b, 0xbb, 0xf4,
+		0x0f, 0xca, 0xb2, 0xb5, 0xcf, 0x7e, 0x82, 0x66, 0x7d, 0x6c, 0xbd, 0xd9, 0xec, 0xc9, 0xca, 0xf8,
+		0x54, 0x7c, 0x59, 0x22, 0xbf, 0xc5, 0x97, 0x62, 0x70, 0xa4, 0x4b, 0x02, 0x3e, 0x7b, 0x90, 0xb7,
+		0x74, 0x44, 0xa2, 0x37, 0xf6, 0x5e, 0xf6, 0xe7, 0x38, 0xd3, 0x7d, 0xa9, 0x88, 0xb7, 0x1e, 0x6b,
+		0xa7, 0x70, 0x3f, 0xaf, 0xee, 0x95, 0xf2, 0x3d, 0x12, 0x8c, 0xbc, 0xb6, 0x57, 0xba, 0x8d, 0xb6,
+		0x1b, 0x94, 0x97, 0x5f, 0xfe, 0x97, 0x52, 0x4c, 0x3a, 0xec, 0x09, 0xe7, 0xbd, 0x06, 0x31, 0x65,
+		0x25, 0x83, 0x03, 0x1e, 0x0e, 0x76, 0xe5, 0x4d, 0x48, 0x36, 0x4c, 0x53, 0x17, 0x1b, 0xc0, 0x8c,
+		0x48, 0x3f, 0x0f, 0xaf, 0x3d, 0xf2, 0x71, 0xed, 0x22, 0x31, 0x9e, 0x4a, 0x06, 0xf3, 0x7f, 0x1f,
+		0xb7, 0xf1, 0xf7, 0x09, 0xc8, 0x6f, 0x18, 0x9b, 0xa6, 0x81, 0xbf, 0x1f, 0xa0, 0x1f, 0x4b, 0xde,
+		0xda, 0xe5, 0x78, 0x2b, 0xb7, 0x36, 0xf1, 0x69, 0x28, 0xa8, 0x36, 0xa2, 0x5b, 0xf3, 0x36, 0xd2,
+		0xda, 0xdb, 0x2e, 0x59, 0xc0, 0x89, 0xda, 0x89, 0xab, 0x7b, 0xa5, 0x70, 0xd5, 0xb5, 0xbd, 0xd2,
+		0x14, 0x5b, 0x26, 0xc1, 0x8a, 0xb2, 0x94, 0xe7, 0x92, 0x27, 0x88, 0x40, 0xbc, 0x02, 0x85, 0xb0,
+		0xdb, 0x24, 0x23, 0xdd, 0xe6, 0x34, 0x73, 0x9b, 0x30, 0xd4, 0xd7, 0x6e, 0xb0, 0x82, 0x3a, 0x4e,
+		0x5e, 0x0d, 0xfa, 0x4b, 0xce, 0xbf, 0x41, 0x96, 0x37, 0x40, 0x08, 0x4c, 0xa7, 0x86, 0xf0, 0xb7,
+		0xa7, 0x23, 0x88, 0xfe, 0x64, 0x1f, 0x7b, 0x4c, 0xcd, 0xd2, 0x28, 0x3e, 0x1b, 0x9c, 0xf9, 0x5a,
+		0x81, 0xf5, 0x88, 0xab, 0x4b, 0xfc, 0xc7, 0xcd, 0xd8, 0x73, 0xff, 0x7f, 0x00, 0xf7, 0x0b, 0xdc,
+		0x20, 0xfd, 0x3e, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -551,6 +658,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UnbondingEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnbondingEntry)
+	if !ok {
+		that2, ok := that.(UnbondingEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	return true
+}
 func (m *ProtoApplication) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -673,6 +813,96 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintApps(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.CreationHeight != 0 {
+		i = encodeVarintApps(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintApps(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintApps(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingEntries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEntries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApps(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApps(v)
 	base := offset
@@ -730,6 +960,41 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *UnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovApps(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovApps(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovApps(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovApps(uint64(l))
+	return n
+}
+
+func (m *UnbondingEntries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovApps(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovApps(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1116,6 +1381,266 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApps
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingEntries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApps(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,21 +11,23 @@ import (
 type CodeType = sdk.CodeType
 
 const (
-	DefaultCodespace          sdk.CodespaceType = ModuleName
-	CodeInvalidApplication    CodeType          = 101
-	CodeInvalidInput          CodeType          = 103
-	CodeApplicationJailed     CodeType          = 104
-	CodeApplicationNotJailed  CodeType          = 105
-	CodeMissingSelfDelegation CodeType          = 106
-	CodeInvalidStatus         CodeType          = 110
-	CodeMinimumStake          CodeType          = 111
-	CodeNotEnoughCoins        CodeType          = 112
-	CodeInvalidStakeAmount    CodeType          = 115
-	CodeNoChains              CodeType          = 116
-	CodeInvalidNetworkID      CodeType          = 117
-	CodeTooManyChains         CodeType          = 118
-	CodeMaxApplications       CodeType          = 119
-	CodeMinimumEditStake      CodeType          = 120
+	DefaultCodespace           sdk.CodespaceType = ModuleName
+	CodeInvalidApplication     CodeType          = 101
+	CodeInvalidInput           CodeType          = 103
+	CodeApplicationJailed      CodeType          = 104
+	CodeApplicationNotJailed   CodeType          = 105
+	CodeMissingSelfDelegation  CodeType          = 106
+	CodeInvalidStatus          CodeType          = 110
+	CodeMinimumStake           CodeType          = 111
+	CodeNotEnoughCoins         CodeType          = 112
+	CodeInvalidStakeAmount     CodeType          = 115
+	CodeNoChains               CodeType          = 116
+	CodeInvalidNetworkID       CodeType          = 117
+	CodeTooManyChains          CodeType          = 118
+	CodeMaxApplications        CodeType          = 119
+	CodeMinimumEditStake       CodeType          = 120
+	CodePartialUnstakeInactive CodeType          = 121
+	CodeInvalidPartialUnstake  CodeType          = 122
 )
 
 func ErrTooManyChains(Codespace sdk.CodespaceType) sdk.Error {
@@ -101,3 +103,15 @@ func ErrInvalidNetworkIdentifier(codespace sdk.CodespaceType, err error) sdk.Err
 func ErrMinimumEditStake(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeMinimumEditStake, "application must edit stake with a stake greater than or equal to current stake")
 }
+
+func ErrPartialUnstakeInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodePartialUnstakeInactive, "partial unstaking is not activated yet")
+}
+
+func ErrBadPartialUnstakeAmount(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPartialUnstake, "the partial unstake amount must be positive")
+}
+
+func ErrPartialUnstakeBelowMinimum(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPartialUnstake, "application must remain staked above the minimum after a partial unstake")
+}
@@ -7,6 +7,9 @@ const (
 	EventTypeStake             = "stake"
 	EventTypeBeginUnstake      = "begin_unstake"
 	EventTypeUnstake           = "unstake"
+	EventTypePartialUnstake    = "partial_unstake"
+	EventTypeCompleteUnbonding = "complete_unbonding"
 	AttributeKeyApplication    = "application"
+	AttributeKeyCompletionTime = "completion_time"
 	AttributeValueCategory     = ModuleName
 )
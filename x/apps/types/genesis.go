@@ -2,9 +2,10 @@ package types
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	Params       Params       `json:"params" yaml:"params"`
-	Applications Applications `json:"applications" yaml:"applications"`
-	Exported     bool         `json:"exported" yaml:"exported"`
+	Params           Params           `json:"params" yaml:"params"`
+	Applications     Applications     `json:"applications" yaml:"applications"`
+	Exported         bool             `json:"exported" yaml:"exported"`
+	UnbondingEntries []UnbondingEntry `json:"unbonding_entries,omitempty" yaml:"unbonding_entries"`
 }
 
 // get raw genesis raw message for testing
@@ -15,10 +15,12 @@ const (
 )
 
 var (
-	AllApplicationsKey = []byte{0x01} // prefix for each key to a application
-	StakedAppsKey      = []byte{0x02} // prefix for each key to a staked application index, sorted by power
-	UnstakingAppsKey   = []byte{0x03} // prefix for unstaking application
-	BurnApplicationKey = []byte{0x04} // prefix for awarding applications
+	AllApplicationsKey  = []byte{0x01} // prefix for each key to a application
+	StakedAppsKey       = []byte{0x02} // prefix for each key to a staked application index, sorted by power
+	UnstakingAppsKey    = []byte{0x03} // prefix for unstaking application
+	BurnApplicationKey  = []byte{0x04} // prefix for awarding applications
+	UnbondingEntriesKey = []byte{0x05} // prefix for the partially unstaked stake of an application
+	UnbondingQueueKey   = []byte{0x06} // prefix for the applications with unbonding stake by completion time
 )
 
 // Removes the prefix bytes from a key to expose true address
@@ -37,6 +39,17 @@ func KeyForUnstakingApps(unstakingTime time.Time) []byte {
 	return append(UnstakingAppsKey, bz...) // use the unstaking time as part of the key
 }
 
+// generates the key for the unbonding entries of the application with address
+func KeyForUnbondingEntries(addr sdk.Address) []byte {
+	return append(UnbondingEntriesKey, addr.Bytes()...)
+}
+
+// generates the key for the applications with unbonding entries completing at completionTime
+func KeyForUnbondingQueue(completionTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(completionTime)
+	return append(UnbondingQueueKey, bz...)
+}
+
 // generates the key for a application in the staking set
 func KeyForAppInStakingSet(app Application) []byte {
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
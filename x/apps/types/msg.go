@@ -14,12 +14,14 @@ var (
 	_ codec.ProtoMarshaler = &MsgStake{}
 	_ sdk.ProtoMsg         = &MsgBeginUnstake{}
 	_ sdk.ProtoMsg         = &MsgUnjail{}
+	_ sdk.ProtoMsg         = &MsgPartialUnstake{}
 )
 
 const (
-	MsgAppStakeName   = "app_stake"
-	MsgAppUnstakeName = "app_begin_unstake"
-	MsgAppUnjailName  = "app_unjail"
+	MsgAppStakeName          = "app_stake"
+	MsgAppUnstakeName        = "app_begin_unstake"
+	MsgAppPartialUnstakeName = "app_partial_unstake"
+	MsgAppUnjailName         = "app_unjail"
 )
 
 type MsgStake struct {
@@ -177,6 +179,45 @@ func (msg MsgBeginUnstake) GetFee() sdk.BigInt {
 	return sdk.NewInt(AppFeeMap[msg.Type()])
 }
 
+//----------------------------------------------------------------------------------------------------------------------
+
+// GetSigners address(es) that must sign over msg.GetSignBytes()
+func (msg MsgPartialUnstake) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Address}
+}
+
+func (msg MsgPartialUnstake) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgPartialUnstake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for partially unstaking an application
+func (msg MsgPartialUnstake) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilApplicationAddr(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadPartialUnstakeAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgPartialUnstake) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgPartialUnstake) Type() string { return MsgAppPartialUnstakeName }
+
+// GetFee get fee for msg
+func (msg MsgPartialUnstake) GetFee() sdk.BigInt {
+	return sdk.NewInt(AppFeeMap[msg.Type()])
+}
+
 //----------------------------------------------------------------------------------------------------------------------
 // Route provides router key for msg
 func (msg MsgUnjail) Route() string { return RouterKey }
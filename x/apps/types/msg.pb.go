@@ -109,6 +109,48 @@ func (*MsgBeginUnstake) XXX_MessageName() string {
 	return "x.apps.MsgBeginUnstake"
 }
 
+type MsgPartialUnstake struct {
+	Address github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=Address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"application_address" yaml:"application_address"`
+	Amount  github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgPartialUnstake) Reset()         { *m = MsgPartialUnstake{} }
+func (m *MsgPartialUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialUnstake) ProtoMessage()    {}
+func (*MsgPartialUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd58e5eb64f87460, []int{2}
+}
+func (m *MsgPartialUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialUnstake.Merge(m, src)
+}
+func (m *MsgPartialUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialUnstake proto.InternalMessageInfo
+
+func (*MsgPartialUnstake) XXX_MessageName() string {
+	return "x.apps.MsgPartialUnstake"
+}
+
 type MsgUnjail struct {
 	AppAddr github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=AppAddr,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address" yaml:"address"`
 }
@@ -117,7 +159,7 @@ func (m *MsgUnjail) Reset()         { *m = MsgUnjail{} }
 func (m *MsgUnjail) String() string { return proto.CompactTextString(m) }
 func (*MsgUnjail) ProtoMessage()    {}
 func (*MsgUnjail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd58e5eb64f87460, []int{2}
+	return fileDescriptor_fd58e5eb64f87460, []int{3}
 }
 func (m *MsgUnjail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,39 +194,43 @@ func (*MsgUnjail) XXX_MessageName() string {
 func init() {
 	proto.RegisterType((*MsgProtoStake)(nil), "x.apps.MsgProtoStake")
 	proto.RegisterType((*MsgBeginUnstake)(nil), "x.apps.MsgBeginUnstake")
+	proto.RegisterType((*MsgPartialUnstake)(nil), "x.apps.MsgPartialUnstake")
 	proto.RegisterType((*MsgUnjail)(nil), "x.apps.MsgUnjail")
 }
 
 func init() { proto.RegisterFile("x/apps/msg.proto", fileDescriptor_fd58e5eb64f87460) }
 
 var fileDescriptor_fd58e5eb64f87460 = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x31, 0x8f, 0xd3, 0x30,
-	0x18, 0x8d, 0x39, 0x91, 0xa8, 0xd6, 0x1d, 0x9c, 0x02, 0x43, 0x75, 0x48, 0x71, 0x95, 0xa9, 0xcb,
-	0x25, 0xa0, 0x63, 0xba, 0xed, 0xb2, 0x01, 0xaa, 0x04, 0x41, 0xb7, 0xb0, 0x9c, 0x9c, 0x9c, 0xe5,
-	0xa6, 0x49, 0x63, 0x2b, 0x76, 0xa0, 0xd9, 0x19, 0x2a, 0xb1, 0x30, 0x32, 0x56, 0x8c, 0xfc, 0x92,
-	0x8e, 0x1d, 0x11, 0x83, 0x85, 0xda, 0x05, 0x65, 0xac, 0xc4, 0xc2, 0x84, 0x12, 0x07, 0x75, 0xa0,
-	0x43, 0xa5, 0xdb, 0xfc, 0xde, 0xf3, 0xe7, 0xf7, 0x9e, 0xfc, 0xc1, 0xd3, 0x99, 0x8f, 0x39, 0x17,
-	0xfe, 0x54, 0x50, 0x8f, 0x17, 0x4c, 0x32, 0xdb, 0x9c, 0x79, 0x0d, 0x73, 0xf6, 0x98, 0x32, 0xca,
-	0x5a, 0xca, 0x6f, 0x4e, 0x5a, 0x75, 0x7f, 0x03, 0x78, 0x32, 0x12, 0xf4, 0x75, 0x03, 0xde, 0x4a,
-	0x9c, 0x12, 0xfb, 0x39, 0xb4, 0x78, 0x19, 0xdd, 0xa4, 0xa4, 0xea, 0x83, 0x01, 0x18, 0x1e, 0x07,
-	0x4f, 0x6a, 0x85, 0x4c, 0x5e, 0x46, 0x29, 0xa9, 0xb6, 0x0a, 0x9d, 0x54, 0x78, 0x9a, 0x5d, 0xba,
-	0x1a, 0xbb, 0x61, 0x23, 0xbc, 0x22, 0x95, 0x7d, 0x01, 0xcd, 0x78, 0x8c, 0x93, 0x5c, 0xf4, 0xef,
-	0x0d, 0x8e, 0x86, 0x3d, 0x3d, 0xa4, 0x99, 0xdd, 0x90, 0xc6, 0x6e, 0xd8, 0x09, 0x36, 0x85, 0xf7,
-	0xdf, 0xe3, 0xac, 0x24, 0xfd, 0xa3, 0x01, 0x18, 0xf6, 0x82, 0x37, 0x4b, 0x85, 0x8c, 0x1f, 0x0a,
-	0x3d, 0xa5, 0x89, 0x1c, 0x97, 0x91, 0x17, 0xb3, 0xa9, 0xcf, 0x59, 0x2a, 0xcf, 0x73, 0x22, 0x3f,
-	0xb0, 0x22, 0xf5, 0x39, 0x8b, 0x53, 0x22, 0xcf, 0x63, 0x56, 0x10, 0x5f, 0x56, 0x9c, 0x08, 0x2f,
-	0x48, 0xe8, 0x8b, 0x5c, 0xd6, 0x0a, 0xe9, 0x87, 0xb6, 0x0a, 0x1d, 0x6b, 0xab, 0x16, 0xba, 0xa1,
-	0xa6, 0x2f, 0x4f, 0xe7, 0x0b, 0x64, 0x7c, 0x59, 0x20, 0xf0, 0x6b, 0x81, 0xc0, 0xfc, 0x2b, 0x02,
-	0xee, 0x37, 0x00, 0x1f, 0x8e, 0x04, 0x0d, 0x08, 0x4d, 0xf2, 0xeb, 0x5c, 0xb4, 0xcd, 0x3f, 0x02,
-	0x68, 0x5d, 0xdd, 0xde, 0x16, 0x44, 0x88, 0xae, 0xfa, 0xa4, 0x56, 0xe8, 0x11, 0xe6, 0x3c, 0x4b,
-	0x62, 0x2c, 0x13, 0x96, 0xdf, 0x60, 0x2d, 0x6f, 0x15, 0x3a, 0xd3, 0x3e, 0x7b, 0x44, 0xf7, 0x8f,
-	0x42, 0xcf, 0x0e, 0xaf, 0xd0, 0x39, 0x86, 0xff, 0xac, 0xf7, 0x84, 0xfd, 0x04, 0x60, 0x6f, 0x24,
-	0xe8, 0x75, 0x3e, 0xc1, 0x49, 0x66, 0x67, 0xd0, 0xba, 0xe2, 0xbc, 0xb9, 0xdd, 0xa5, 0x0c, 0x6b,
-	0x85, 0xac, 0x5d, 0xb2, 0x07, 0x5d, 0xb2, 0x3b, 0xa6, 0xd1, 0x16, 0xff, 0xa7, 0x09, 0x5e, 0x2e,
-	0xd7, 0x0e, 0x58, 0xad, 0x1d, 0xf0, 0x73, 0xed, 0x80, 0xcf, 0x1b, 0xc7, 0x58, 0x6d, 0x1c, 0xe3,
-	0xfb, 0xc6, 0x31, 0xde, 0x1d, 0xf4, 0x71, 0xdd, 0x82, 0xb6, 0x76, 0x91, 0xd9, 0x6e, 0xe1, 0xc5,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x7e, 0x3d, 0x78, 0xb7, 0x02, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0xa5, 0xc2, 0x51, 0x4e, 0x2d, 0x14, 0xc3, 0x10, 0x15, 0xc9, 0x17, 0x79, 0xca, 0xd2,
+	0x18, 0x54, 0xa6, 0x6e, 0xf5, 0x06, 0x28, 0x12, 0xb8, 0xea, 0xc2, 0x52, 0x5d, 0xdc, 0xd3, 0xd5,
+	0xb5, 0x73, 0x77, 0xf2, 0x9d, 0xa1, 0xde, 0x19, 0x2a, 0xb1, 0x30, 0xc2, 0x16, 0x31, 0xf2, 0x4b,
+	0x3a, 0x76, 0x44, 0x0c, 0x27, 0x94, 0x2c, 0xc8, 0x63, 0x24, 0x16, 0x26, 0x64, 0xdf, 0xa1, 0x0c,
+	0x64, 0xa8, 0x94, 0xa5, 0x9b, 0xbf, 0xf7, 0xe9, 0xde, 0xf7, 0xbe, 0xef, 0xf9, 0xc1, 0xdd, 0xcb,
+	0x10, 0x0b, 0x21, 0xc3, 0xa9, 0xa4, 0x23, 0x51, 0x70, 0xc5, 0x3d, 0xf7, 0x72, 0xd4, 0x54, 0xf6,
+	0x1e, 0x53, 0x4e, 0x79, 0x5b, 0x0a, 0x9b, 0x2f, 0xc3, 0x06, 0xbf, 0x01, 0xdc, 0x19, 0x4b, 0xfa,
+	0xba, 0x01, 0xc7, 0x0a, 0x67, 0xc4, 0x7b, 0x0e, 0xbb, 0xa2, 0x9c, 0x9c, 0x66, 0xa4, 0xea, 0x83,
+	0x01, 0x18, 0x6e, 0x47, 0x4f, 0x6a, 0x8d, 0x5c, 0x51, 0x4e, 0x32, 0x52, 0x2d, 0x35, 0xda, 0xa9,
+	0xf0, 0x34, 0x3f, 0x0c, 0x0c, 0x0e, 0xe2, 0x86, 0x78, 0x45, 0x2a, 0xef, 0x00, 0xba, 0xc9, 0x39,
+	0x4e, 0x99, 0xec, 0x77, 0x06, 0x5b, 0xc3, 0x9e, 0x79, 0x64, 0x2a, 0xab, 0x47, 0x06, 0x07, 0xb1,
+	0x25, 0x3c, 0x0a, 0xef, 0xbd, 0xc3, 0x79, 0x49, 0xfa, 0x5b, 0x03, 0x30, 0xec, 0x45, 0x6f, 0xae,
+	0x35, 0x72, 0x7e, 0x68, 0xf4, 0x94, 0xa6, 0xea, 0xbc, 0x9c, 0x8c, 0x12, 0x3e, 0x0d, 0x05, 0xcf,
+	0xd4, 0x3e, 0x23, 0xea, 0x3d, 0x2f, 0xb2, 0x50, 0xf0, 0x24, 0x23, 0x6a, 0x3f, 0xe1, 0x05, 0x09,
+	0x55, 0x25, 0x88, 0x1c, 0x45, 0x29, 0x7d, 0xc1, 0x54, 0xad, 0x91, 0x69, 0xb4, 0xd4, 0x68, 0xdb,
+	0x48, 0xb5, 0x30, 0x88, 0x4d, 0xf9, 0x70, 0xf7, 0x6a, 0x86, 0x9c, 0xcf, 0x33, 0x04, 0x7e, 0xcd,
+	0x10, 0xb8, 0xfa, 0x8a, 0x40, 0xf0, 0x0d, 0xc0, 0x07, 0x63, 0x49, 0x23, 0x42, 0x53, 0x76, 0xc2,
+	0x64, 0xeb, 0xfc, 0x03, 0x80, 0xdd, 0xa3, 0xb3, 0xb3, 0x82, 0x48, 0x69, 0xad, 0x5f, 0xd4, 0x1a,
+	0x3d, 0xc2, 0x42, 0xe4, 0x69, 0x82, 0x55, 0xca, 0xd9, 0x29, 0x36, 0xf4, 0x52, 0xa3, 0x3d, 0xa3,
+	0xb3, 0x86, 0x0c, 0xfe, 0x68, 0xf4, 0xec, 0xf6, 0x16, 0xac, 0x62, 0xfc, 0x4f, 0x7a, 0xcd, 0xb0,
+	0x5f, 0x3a, 0xf0, 0x61, 0xb3, 0x24, 0x5c, 0xa8, 0x14, 0xe7, 0x77, 0x6b, 0x5c, 0x2f, 0x83, 0x2e,
+	0x9e, 0xf2, 0x92, 0xa9, 0x7e, 0xa7, 0xdd, 0xe2, 0xf1, 0x06, 0x5b, 0xb4, 0x9d, 0x56, 0x7f, 0x8c,
+	0xc1, 0x41, 0x6c, 0x89, 0x35, 0xd9, 0x7c, 0x04, 0xb0, 0x37, 0x96, 0xf4, 0x84, 0x5d, 0xe0, 0x34,
+	0xf7, 0x72, 0xd8, 0x3d, 0x12, 0xa2, 0x19, 0xcd, 0x46, 0x12, 0xd7, 0x1a, 0x75, 0x57, 0x31, 0xdc,
+	0xb7, 0x6d, 0x37, 0xb4, 0x6e, 0x24, 0xfe, 0x9f, 0x26, 0x7a, 0x79, 0x3d, 0xf7, 0xc1, 0xcd, 0xdc,
+	0x07, 0x3f, 0xe7, 0x3e, 0xf8, 0xb4, 0xf0, 0x9d, 0x9b, 0x85, 0xef, 0x7c, 0x5f, 0xf8, 0xce, 0xdb,
+	0x5b, 0xc5, 0x61, 0x8f, 0xb7, 0x95, 0x9b, 0xb8, 0xed, 0x85, 0x1e, 0xfc, 0x1d, 0x00, 0xe0, 0xb7,
+	0xb9, 0x83, 0xd3, 0x03, 0x00, 0x00,
 }
 
 func (this *MsgProtoStake) Equal(that interface{}) bool {
@@ -246,6 +292,33 @@ func (this *MsgBeginUnstake) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgPartialUnstake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgPartialUnstake)
+	if !ok {
+		that2, ok := that.(MsgPartialUnstake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
 func (this *MsgUnjail) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -349,6 +422,46 @@ func (m *MsgBeginUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPartialUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUnjail) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -424,6 +537,21 @@ func (m *MsgBeginUnstake) Size() (n int) {
 	return n
 }
 
+func (m *MsgPartialUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsg(uint64(l))
+	return n
+}
+
 func (m *MsgUnjail) Size() (n int) {
 	if m == nil {
 		return 0
@@ -683,6 +811,127 @@ func (m *MsgBeginUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPartialUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,7 @@ const (
 	QueryAppStakedPool   = "appStakedPool"
 	QueryAppUnstakedPool = "appUnstakedPool"
 	QueryParameters      = "parameters"
+	QueryUnbonding       = "unbonding"
 )
 
 type QueryAppParams struct {
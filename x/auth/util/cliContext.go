@@ -23,7 +23,7 @@ type CLIContext struct { // TODO consider module passing clicontext instead of n
 	Passphrase    string
 	Height        int64
 	BroadcastMode BroadcastType
-	PrivateKey    crypto.PrivateKey
+	PrivateKey    crypto.Signer
 }
 
 // NewCLIContext returns a new initialized CLIContext with parameters from the
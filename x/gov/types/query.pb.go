@@ -0,0 +1,1541 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/gov/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_pokt_network_pocket_core_types "github.com/pokt-network/pocket-core/types"
+	types "github.com/pokt-network/pocket-core/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryHeightRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+}
+
+func (m *QueryHeightRequest) Reset()         { *m = QueryHeightRequest{} }
+func (m *QueryHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeightRequest) ProtoMessage()    {}
+func (*QueryHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebcbb353d2be6d16, []int{0}
+}
+func (m *QueryHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeightRequest.Merge(m, src)
+}
+func (m *QueryHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeightRequest proto.InternalMessageInfo
+
+func (m *QueryHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QueryDAOOwnerResponse struct {
+	Address github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
+}
+
+func (m *QueryDAOOwnerResponse) Reset()         { *m = QueryDAOOwnerResponse{} }
+func (m *QueryDAOOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDAOOwnerResponse) ProtoMessage()    {}
+func (*QueryDAOOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebcbb353d2be6d16, []int{1}
+}
+func (m *QueryDAOOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDAOOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDAOOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDAOOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDAOOwnerResponse.Merge(m, src)
+}
+func (m *QueryDAOOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDAOOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDAOOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDAOOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryDAOOwnerResponse) GetAddress() github_com_pokt_network_pocket_core_types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type QueryACLResponse struct {
+	Acl []ACLPair `protobuf:"bytes,1,rep,name=acl,proto3" json:"acl"`
+}
+
+func (m *QueryACLResponse) Reset()         { *m = QueryACLResponse{} }
+func (m *QueryACLResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryACLResponse) ProtoMessage()    {}
+func (*QueryACLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebcbb353d2be6d16, []int{2}
+}
+func (m *QueryACLResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryACLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryACLResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryACLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryACLResponse.Merge(m, src)
+}
+func (m *QueryACLResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryACLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryACLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryACLResponse proto.InternalMessageInfo
+
+func (m *QueryACLResponse) GetAcl() []ACLPair {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+type QueryAllParamsResponse struct {
+	AppParams    []types.Param `protobuf:"bytes,1,rep,name=app_params,json=appParams,proto3" json:"app_params"`
+	NodeParams   []types.Param `protobuf:"bytes,2,rep,name=node_params,json=nodeParams,proto3" json:"node_params"`
+	PocketParams []types.Param `protobuf:"bytes,3,rep,name=pocket_params,json=pocketParams,proto3" json:"pocket_params"`
+	GovParams    []types.Param `protobuf:"bytes,4,rep,name=gov_params,json=govParams,proto3" json:"gov_params"`
+	AuthParams   []types.Param `protobuf:"bytes,5,rep,name=auth_params,json=authParams,proto3" json:"auth_params"`
+}
+
+func (m *QueryAllParamsResponse) Reset()         { *m = QueryAllParamsResponse{} }
+func (m *QueryAllParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllParamsResponse) ProtoMessage()    {}
+func (*QueryAllParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebcbb353d2be6d16, []int{3}
+}
+func (m *QueryAllParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllParamsResponse.Merge(m, src)
+}
+func (m *QueryAllParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllParamsResponse proto.InternalMessageInfo
+
+func (m *QueryAllParamsResponse) GetAppParams() []types.Param {
+	if m != nil {
+		return m.AppParams
+	}
+	return nil
+}
+
+func (m *QueryAllParamsResponse) GetNodeParams() []types.Param {
+	if m != nil {
+		return m.NodeParams
+	}
+	return nil
+}
+
+func (m *QueryAllParamsResponse) GetPocketParams() []types.Param {
+	if m != nil {
+		return m.PocketParams
+	}
+	return nil
+}
+
+func (m *QueryAllParamsResponse) GetGovParams() []types.Param {
+	if m != nil {
+		return m.GovParams
+	}
+	return nil
+}
+
+func (m *QueryAllParamsResponse) GetAuthParams() []types.Param {
+	if m != nil {
+		return m.AuthParams
+	}
+	return nil
+}
+
+type QueryParamRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	// the acl key of the parameter, e.g. pos/StakeMinimum
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
+}
+
+func (m *QueryParamRequest) Reset()         { *m = QueryParamRequest{} }
+func (m *QueryParamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamRequest) ProtoMessage()    {}
+func (*QueryParamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebcbb353d2be6d16, []int{4}
+}
+func (m *QueryParamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamRequest.Merge(m, src)
+}
+func (m *QueryParamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamRequest proto.InternalMessageInfo
+
+func (m *QueryParamRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryParamRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryHeightRequest)(nil), "x.gov.QueryHeightRequest")
+	proto.RegisterType((*QueryDAOOwnerResponse)(nil), "x.gov.QueryDAOOwnerResponse")
+	proto.RegisterType((*QueryACLResponse)(nil), "x.gov.QueryACLResponse")
+	proto.RegisterType((*QueryAllParamsResponse)(nil), "x.gov.QueryAllParamsResponse")
+	proto.RegisterType((*QueryParamRequest)(nil), "x.gov.QueryParamRequest")
+}
+
+func init() { proto.RegisterFile("x/gov/query.proto", fileDescriptor_ebcbb353d2be6d16) }
+
+var fileDescriptor_ebcbb353d2be6d16 = []byte{
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xda, 0x4e,
+	0x10, 0xc7, 0x31, 0xfe, 0x11, 0x7e, 0x0c, 0xf4, 0x0f, 0xdb, 0xa6, 0x25, 0xa8, 0xb5, 0x91, 0x4f,
+	0x5c, 0xb0, 0xd5, 0xb4, 0x87, 0xf6, 0x94, 0xda, 0xb4, 0x52, 0x2a, 0x21, 0x25, 0x5d, 0xa9, 0x97,
+	0x5c, 0x2a, 0x07, 0x56, 0x0b, 0x32, 0x61, 0x37, 0xb6, 0x71, 0xc2, 0x5b, 0xf4, 0x65, 0xfa, 0x0e,
+	0x39, 0xe6, 0xd8, 0x93, 0x55, 0xc1, 0x0d, 0xf5, 0x09, 0x7a, 0xaa, 0xbc, 0x6b, 0x13, 0x23, 0x22,
+	0xab, 0xbd, 0x18, 0x66, 0xe6, 0x3b, 0x9f, 0xf5, 0xce, 0x7e, 0xbd, 0xd0, 0xbc, 0xb6, 0x28, 0x8b,
+	0xac, 0xcb, 0x39, 0xf1, 0x17, 0x26, 0xf7, 0x59, 0xc8, 0x50, 0xe5, 0xda, 0xa4, 0x2c, 0x6a, 0x3f,
+	0xa5, 0x8c, 0x32, 0x91, 0xb1, 0x92, 0x7f, 0xb2, 0xd8, 0x6e, 0x86, 0x0b, 0x4e, 0x82, 0xbc, 0xbe,
+	0xfd, 0x48, 0x22, 0x28, 0x8b, 0x64, 0xc2, 0x78, 0x0b, 0xe8, 0x73, 0x52, 0x3f, 0x26, 0x13, 0x3a,
+	0x0e, 0x31, 0xb9, 0x9c, 0x93, 0x20, 0x44, 0x06, 0xec, 0x8d, 0x45, 0xa2, 0xa5, 0x74, 0x94, 0xae,
+	0xea, 0xc0, 0x3a, 0xd6, 0xd3, 0x0c, 0x4e, 0x7f, 0x8d, 0x00, 0xf6, 0x45, 0xe7, 0x07, 0xfb, 0xe4,
+	0xe4, 0x6a, 0x46, 0x7c, 0x4c, 0x02, 0xce, 0x66, 0x01, 0x41, 0x67, 0x50, 0x75, 0x47, 0x23, 0x9f,
+	0x04, 0x81, 0xe8, 0x6e, 0x38, 0xef, 0xd7, 0xb1, 0x9e, 0xa5, 0x7e, 0xc7, 0xfa, 0x2b, 0x3a, 0x09,
+	0xc7, 0xf3, 0x73, 0x73, 0xc8, 0x2e, 0x2c, 0xce, 0xbc, 0xb0, 0x37, 0x23, 0xe1, 0x15, 0xf3, 0x3d,
+	0x8b, 0xb3, 0xa1, 0x47, 0xc2, 0xde, 0x90, 0xf9, 0xc4, 0x12, 0xaf, 0x6e, 0xda, 0xb2, 0x09, 0x67,
+	0xdd, 0x86, 0x0d, 0x8f, 0xc5, 0xa2, 0x76, 0x7f, 0xb0, 0x59, 0xaf, 0x07, 0xaa, 0x3b, 0x9c, 0xb6,
+	0x94, 0x8e, 0xda, 0xad, 0x1f, 0x3e, 0x34, 0xc5, 0x44, 0x4c, 0xbb, 0x3f, 0x38, 0x75, 0x27, 0xbe,
+	0x53, 0xbf, 0x89, 0xf5, 0xd2, 0x3a, 0xd6, 0x13, 0x09, 0x4e, 0x1e, 0xc6, 0xaf, 0x32, 0x3c, 0x93,
+	0x8c, 0xe9, 0xf4, 0xd4, 0xf5, 0xdd, 0x8b, 0x60, 0x43, 0x3a, 0x02, 0x70, 0x39, 0xff, 0xca, 0x45,
+	0x36, 0x05, 0x36, 0x4c, 0xf9, 0x2a, 0x42, 0xea, 0xa0, 0x14, 0x97, 0xd3, 0xe1, 0x9a, 0xcb, 0xb9,
+	0x04, 0x21, 0x07, 0xea, 0x33, 0x36, 0x22, 0x19, 0xa1, 0x7c, 0x0f, 0xe1, 0x49, 0x4a, 0xc8, 0x0b,
+	0x31, 0x24, 0x41, 0xca, 0x38, 0x86, 0x07, 0x72, 0x10, 0x19, 0x45, 0xbd, 0x87, 0xb2, 0x9f, 0x52,
+	0xb6, 0xa5, 0xb8, 0x21, 0xc3, 0x94, 0x74, 0x04, 0x40, 0x59, 0x94, 0x61, 0xfe, 0x2b, 0xda, 0xce,
+	0x9d, 0x0e, 0xd7, 0x28, 0x8b, 0xee, 0xb6, 0xe3, 0xce, 0xc3, 0x71, 0x46, 0xa8, 0x14, 0x6d, 0x27,
+	0x27, 0xc4, 0x90, 0x04, 0x92, 0x61, 0x60, 0x68, 0x8a, 0x69, 0x8b, 0xf0, 0x1f, 0xfc, 0x85, 0x0e,
+	0x40, 0xf5, 0xc8, 0xa2, 0x55, 0xee, 0x28, 0xdd, 0x9a, 0x53, 0x4d, 0x8e, 0xd0, 0x23, 0x0b, 0x9c,
+	0x3c, 0x0e, 0xbf, 0x97, 0xa1, 0x22, 0xa0, 0xa8, 0x0f, 0xff, 0x67, 0xfe, 0x43, 0x07, 0xe9, 0xd1,
+	0xef, 0xfa, 0xb9, 0xfd, 0x22, 0x5f, 0xda, 0x31, 0xec, 0x3b, 0x50, 0xed, 0xfe, 0xa0, 0xa8, 0xff,
+	0x79, 0xbe, 0x94, 0xf7, 0xde, 0x1b, 0xa8, 0x7e, 0xe1, 0xd4, 0x77, 0x47, 0xa4, 0xa8, 0x3d, 0x33,
+	0x65, 0x26, 0xfd, 0x08, 0xb5, 0x8d, 0xf9, 0x8a, 0xfa, 0x5e, 0x6e, 0x2d, 0xbb, 0x63, 0x57, 0x0b,
+	0x2a, 0x22, 0x83, 0x5a, 0x79, 0x5d, 0x7e, 0xd0, 0xed, 0xed, 0xc3, 0xfa, 0x74, 0xb3, 0xd4, 0x94,
+	0xdb, 0xa5, 0xa6, 0xfc, 0x5c, 0x6a, 0xca, 0xb7, 0x95, 0x56, 0xba, 0x5d, 0x69, 0xa5, 0x1f, 0x2b,
+	0xad, 0x74, 0x66, 0xfd, 0xcd, 0x37, 0x29, 0xef, 0x0e, 0x01, 0x3c, 0xdf, 0x13, 0xd7, 0xc7, 0xeb,
+	0x3f, 0x03, 0x00, 0x47, 0x10, 0x62, 0xb9, 0x94, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	DAOOwner(ctx context.Context, in *QueryHeightRequest, opts ...grpc.CallOption) (*QueryDAOOwnerResponse, error)
+	ACL(ctx context.Context, in *QueryHeightRequest, opts ...grpc.CallOption) (*QueryACLResponse, error)
+	Upgrade(ctx context.Context, in *QueryHeightRequest, opts ...grpc.CallOption) (*Upgrade, error)
+	AllParams(ctx context.Context, in *QueryHeightRequest, opts ...grpc.CallOption) (*QueryAllParamsResponse, error)
+	Param(ctx context.Context, in *QueryParamRequest, opts ...grpc.CallOption) (*types.Param, error)
+}
+
+type queryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueryClient(cc *grpc.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) DAOOwner(ctx context.Context, in *QueryHeightRequest, opts ...grpc.CallOption) (*QueryDAOOwnerResponse, error) {
+	out := new(QueryDAOOwnerResponse)
+	err := c.cc.Invoke(ctx, "/x.gov.Query/DAOOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ACL(ctx context.Context, in *QueryHeightRequest, opts ...grpc.CallOption) (*QueryACLResponse, error) {
+	out := new(QueryACLResponse)
+	err := c.cc.Invoke(ctx, "/x.gov.Query/ACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Upgrade(ctx context.Context, in *QueryHeightRequest, opts ...grpc.CallOption) (*Upgrade, error) {
+	out := new(Upgrade)
+	err := c.cc.Invoke(ctx, "/x.gov.Query/Upgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllParams(ctx context.Context, in *QueryHeightRequest, opts ...grpc.CallOption) (*QueryAllParamsResponse, error) {
+	out := new(QueryAllParamsResponse)
+	err := c.cc.Invoke(ctx, "/x.gov.Query/AllParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Param(ctx context.Context, in *QueryParamRequest, opts ...grpc.CallOption) (*types.Param, error) {
+	out := new(types.Param)
+	err := c.cc.Invoke(ctx, "/x.gov.Query/Param", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	DAOOwner(context.Context, *QueryHeightRequest) (*QueryDAOOwnerResponse, error)
+	ACL(context.Context, *QueryHeightRequest) (*QueryACLResponse, error)
+	Upgrade(context.Context, *QueryHeightRequest) (*Upgrade, error)
+	AllParams(context.Context, *QueryHeightRequest) (*QueryAllParamsResponse, error)
+	Param(context.Context, *QueryParamRequest) (*types.Param, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) DAOOwner(ctx context.Context, req *QueryHeightRequest) (*QueryDAOOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DAOOwner not implemented")
+}
+func (*UnimplementedQueryServer) ACL(ctx context.Context, req *QueryHeightRequest) (*QueryACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ACL not implemented")
+}
+func (*UnimplementedQueryServer) Upgrade(ctx context.Context, req *QueryHeightRequest) (*Upgrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upgrade not implemented")
+}
+func (*UnimplementedQueryServer) AllParams(ctx context.Context, req *QueryHeightRequest) (*QueryAllParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllParams not implemented")
+}
+func (*UnimplementedQueryServer) Param(ctx context.Context, req *QueryParamRequest) (*types.Param, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Param not implemented")
+}
+
+func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_DAOOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DAOOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/x.gov.Query/DAOOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DAOOwner(ctx, req.(*QueryHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/x.gov.Query/ACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ACL(ctx, req.(*QueryHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Upgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Upgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/x.gov.Query/Upgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Upgrade(ctx, req.(*QueryHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/x.gov.Query/AllParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllParams(ctx, req.(*QueryHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Param_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Param(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/x.gov.Query/Param",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Param(ctx, req.(*QueryParamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "x.gov.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DAOOwner",
+			Handler:    _Query_DAOOwner_Handler,
+		},
+		{
+			MethodName: "ACL",
+			Handler:    _Query_ACL_Handler,
+		},
+		{
+			MethodName: "Upgrade",
+			Handler:    _Query_Upgrade_Handler,
+		},
+		{
+			MethodName: "AllParams",
+			Handler:    _Query_AllParams_Handler,
+		},
+		{
+			MethodName: "Param",
+			Handler:    _Query_Param_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "x/gov/query.proto",
+}
+
+func (m *QueryHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDAOOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDAOOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDAOOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryACLResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryACLResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryACLResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Acl) > 0 {
+		for iNdEx := len(m.Acl) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Acl[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthParams) > 0 {
+		for iNdEx := len(m.AuthParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GovParams) > 0 {
+		for iNdEx := len(m.GovParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PocketParams) > 0 {
+		for iNdEx := len(m.PocketParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PocketParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NodeParams) > 0 {
+		for iNdEx := len(m.NodeParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AppParams) > 0 {
+		for iNdEx := len(m.AppParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDAOOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryACLResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Acl) > 0 {
+		for _, e := range m.Acl {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AppParams) > 0 {
+		for _, e := range m.AppParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NodeParams) > 0 {
+		for _, e := range m.NodeParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PocketParams) > 0 {
+		for _, e := range m.PocketParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GovParams) > 0 {
+		for _, e := range m.GovParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AuthParams) > 0 {
+		for _, e := range m.AuthParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDAOOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDAOOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDAOOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryACLResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryACLResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryACLResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acl = append(m.Acl, ACLPair{})
+			if err := m.Acl[len(m.Acl)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppParams = append(m.AppParams, types.Param{})
+			if err := m.AppParams[len(m.AppParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeParams = append(m.NodeParams, types.Param{})
+			if err := m.NodeParams[len(m.NodeParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocketParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PocketParams = append(m.PocketParams, types.Param{})
+			if err := m.PocketParams[len(m.PocketParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovParams = append(m.GovParams, types.Param{})
+			if err := m.GovParams[len(m.GovParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthParams = append(m.AuthParams, types.Param{})
+			if err := m.AuthParams[len(m.AuthParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
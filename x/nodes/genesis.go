@@ -49,6 +49,11 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			stakedTokens = stakedTokens.Add(validator.GetTokens())
 		}
 	}
+	// the partially unstaked tokens stay in the staked pool until released
+	for _, entry := range data.UnbondingEntries {
+		keeper.SetUnbondingEntry(ctx, entry)
+		stakedTokens = stakedTokens.Add(entry.Amount)
+	}
 	// take the staked amount and create the corresponding coins object
 	stakedCoins := sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(ctx), stakedTokens))
 	// check if the staked pool accounts exists
@@ -139,6 +144,7 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		return false
 	})
 	prevProposer := keeper.GetPreviousProposer(ctx)
+	unbondingEntries := keeper.GetAllUnbondingEntries(ctx)
 
 	return types.GenesisState{
 		Params:                   params,
@@ -149,6 +155,7 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		UnbondingEntries:         unbondingEntries,
 	}
 }
 
@@ -163,6 +170,14 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	for _, entry := range data.UnbondingEntries {
+		if entry.Address.Empty() || entry.OutputAddress.Empty() {
+			return fmt.Errorf("genesis unbonding entry is missing an address: %v", entry)
+		}
+		if entry.Amount.IsNegative() {
+			return fmt.Errorf("genesis unbonding entry cannot have a negative amount: %v", entry)
+		}
+	}
 	downtime := data.Params.SlashFractionDowntime
 	if downtime.IsNegative() || downtime.GT(sdk.OneDec()) {
 		return fmt.Errorf("Slashing fraction downtime should be less than or equal to one and greater than zero, is %s", downtime.String())
@@ -21,6 +21,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			switch msg := msg.(type) {
 			case types.MsgBeginUnstake:
 				return handleMsgBeginUnstake(ctx, msg, k)
+			case types.MsgPartialUnstake:
+				return handleMsgPartialUnstake(ctx, msg, k)
 			case types.MsgUnjail:
 				return handleMsgUnjail(ctx, msg, k)
 			case types.MsgSend:
@@ -134,6 +136,39 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keep
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgPartialUnstake(ctx sdk.Ctx, msg types.MsgPartialUnstake, k keeper.Keeper) sdk.Result {
+	defer sdk.TimeTrack(time.Now())
+
+	ctx.Logger().Info("Partial Unstake Message received from " + msg.Address.String())
+	validator, found := k.GetValidator(ctx, msg.Address)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	if err := k.ValidatePartialUnstake(ctx, validator, msg.Amount, msg.Signer); err != nil {
+		return err.Result()
+	}
+	entry, err := k.PartialUnstakeValidator(ctx, validator, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePartialUnstake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, entry.CompletionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 // Validators must submit a transaction to unjail itself after todo
 // having been jailed (and thus unstaked) for downtime
 func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgUnjail, k keeper.Keeper) sdk.Result {
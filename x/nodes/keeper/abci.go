@@ -68,5 +68,7 @@ func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	validatorUpdates := k.UpdateTendermintValidators(ctx)
 	// Unstake all mature validators from the unstakeing queue.
 	k.unstakeAllMatureValidators(ctx)
+	// Release the partially unstaked tokens that finished their unstaking period.
+	k.completeAllMatureUnbondingEntries(ctx)
 	return validatorUpdates
 }
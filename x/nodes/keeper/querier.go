@@ -19,6 +19,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryValidators(ctx, req, k)
 		case types.QueryValidator:
 			return queryValidator(ctx, req, k)
+		case types.QueryUnbonding:
+			return queryUnbonding(ctx, req, k)
 		case types.QuerySigningInfo:
 			return querySigningInfo(ctx, req, k)
 		case types.QuerySigningInfos:
@@ -117,6 +119,20 @@ func queryValidator(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.E
 	return res, nil
 }
 
+func queryUnbonding(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	entries := k.GetUnbondingEntries(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, entries)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
 func queryStakedPool(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
 	stakedTokens := k.GetStakedTokens(ctx)
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, stakedTokens)
@@ -49,13 +49,8 @@ func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Addr
 
 	//check if PIP22 is enabled, if so scale the rewards
 	if isAfterRSCAL {
-		stake := validator.GetTokens()
-		//floorstake to the lowest bin multiple or take ceiling, whicherver is smaller
-		flooredStake := sdk.MinInt(stake.Sub(stake.Mod(k.ServicerStakeFloorMultiplier(ctx))), k.ServicerStakeWeightCeiling(ctx).Sub(k.ServicerStakeWeightCeiling(ctx).Mod(k.ServicerStakeFloorMultiplier(ctx))))
-		//Convert from tokens to a BIN number
-		bin := flooredStake.Quo(k.ServicerStakeFloorMultiplier(ctx))
 		//calculate the weight value, weight will be a floatng point number so cast to DEC here and then truncate back to big int
-		weight := bin.ToDec().FracPow(k.ServicerStakeFloorMultiplierExponent(ctx), Pip22ExponentDenominator).Quo(k.ServicerStakeWeightMultiplier(ctx))
+		weight := k.ServicerStakeWeight(ctx, validator.GetTokens()).Quo(k.ServicerStakeWeightMultiplier(ctx))
 		coinsDecimal := k.RelaysToTokensMultiplier(ctx).ToDec().Mul(relays.ToDec()).Mul(weight)
 		//truncate back to int
 		coins = coinsDecimal.TruncateInt()
@@ -158,3 +153,12 @@ func (k Keeper) SetPreviousProposer(ctx sdk.Ctx, consAddr sdk.Address) {
 	}
 	_ = store.Set(types.ProposerKey, b)
 }
+
+// ServicerStakeWeight - The PIP-22 weight of the stake bin a servicer falls in, before the weight multiplier
+func (k Keeper) ServicerStakeWeight(ctx sdk.Ctx, stake sdk.BigInt) sdk.BigDec {
+	//floorstake to the lowest bin multiple or take ceiling, whicherver is smaller
+	flooredStake := sdk.MinInt(stake.Sub(stake.Mod(k.ServicerStakeFloorMultiplier(ctx))), k.ServicerStakeWeightCeiling(ctx).Sub(k.ServicerStakeWeightCeiling(ctx).Mod(k.ServicerStakeFloorMultiplier(ctx))))
+	//Convert from tokens to a BIN number
+	bin := flooredStake.Quo(k.ServicerStakeFloorMultiplier(ctx))
+	return bin.ToDec().FracPow(k.ServicerStakeFloorMultiplierExponent(ctx), Pip22ExponentDenominator)
+}
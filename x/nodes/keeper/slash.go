@@ -112,6 +112,11 @@ func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int
 	// Amount of slashing = slash slashFactor * power at time of infraction
 	amount := sdk.TokensFromConsensusPower(power)
 	slashAmount := amount.ToDec().Mul(slashFactor).TruncateInt()
+	// stake partially unstaked after the infraction is slashed first, the rest comes from the staked tokens
+	if k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.PartialUnstakeKey) {
+		unbondingBurned := k.slashUnbondingEntries(ctx, addr, infractionHeight, slashFactor)
+		slashAmount = sdk.MaxInt(slashAmount.Sub(unbondingBurned), sdk.ZeroInt())
+	}
 	// cannot decrease balance below zero
 	tokensToBurn := sdk.MinInt(slashAmount, validator.StakedTokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
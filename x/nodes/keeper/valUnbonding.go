@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+)
+
+// GetUnbondingEntries - Retrieve the partially unstaked stake of a validator that has not been released yet
+func (k Keeper) GetUnbondingEntries(ctx sdk.Ctx, addr sdk.Address) []types.UnbondingEntry {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.KeyForUnbondingEntries(addr))
+	if bz == nil {
+		return []types.UnbondingEntry{}
+	}
+	var entries types.UnbondingEntries
+	err := k.Cdc.UnmarshalBinaryLengthPrefixed(bz, &entries, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal the unbonding entries of %s: %s", addr, err.Error()))
+		return []types.UnbondingEntry{}
+	}
+	return entries.Entries
+}
+
+// SetUnbondingEntries - Store the partially unstaked stake of a validator, no entries removes the record
+func (k Keeper) SetUnbondingEntries(ctx sdk.Ctx, addr sdk.Address, entries []types.UnbondingEntry) {
+	store := ctx.KVStore(k.storeKey)
+	if len(entries) == 0 {
+		_ = store.Delete(types.KeyForUnbondingEntries(addr))
+		return
+	}
+	bz, err := k.Cdc.MarshalBinaryLengthPrefixed(&types.UnbondingEntries{Entries: entries}, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not marshal the unbonding entries of %s: %s", addr, err.Error()))
+		return
+	}
+	_ = store.Set(types.KeyForUnbondingEntries(addr), bz)
+}
+
+// GetAllUnbondingEntries - Retrieve the unbonding entries of every validator
+func (k Keeper) GetAllUnbondingEntries(ctx sdk.Ctx) (entries []types.UnbondingEntry) {
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.UnbondingEntriesKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var e types.UnbondingEntries
+		err := k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &e, ctx.BlockHeight())
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal unbonding entries at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		entries = append(entries, e.Entries...)
+	}
+	return entries
+}
+
+// SetUnbondingEntry - Add an unbonding entry to the validator and to the unbonding queue
+func (k Keeper) SetUnbondingEntry(ctx sdk.Ctx, entry types.UnbondingEntry) {
+	entries := k.GetUnbondingEntries(ctx, entry.Address)
+	k.SetUnbondingEntries(ctx, entry.Address, append(entries, entry))
+	addrs := k.getUnbondingQueue(ctx, entry.CompletionTime)
+	for _, addr := range addrs {
+		if addr.Equals(entry.Address) {
+			return
+		}
+	}
+	k.setUnbondingQueue(ctx, entry.CompletionTime, append(addrs, entry.Address))
+}
+
+// getUnbondingQueue - Retrieve the validators with unbonding entries that complete at exactly this time
+func (k Keeper) getUnbondingQueue(ctx sdk.Ctx, completionTime time.Time) (addrs sdk.Addresses) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.KeyForUnbondingQueue(completionTime))
+	if bz == nil {
+		return
+	}
+	_ = k.Cdc.UnmarshalBinaryLengthPrefixed(bz, &addrs, ctx.BlockHeight())
+	return addrs
+}
+
+// setUnbondingQueue - Store the validators with unbonding entries that complete at a certain time
+func (k Keeper) setUnbondingQueue(ctx sdk.Ctx, completionTime time.Time, addrs sdk.Addresses) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := k.Cdc.MarshalBinaryLengthPrefixed(&addrs, ctx.BlockHeight())
+	_ = store.Set(types.KeyForUnbondingQueue(completionTime), bz)
+}
+
+// ValidatePartialUnstake - Check if a staked validator can release amount of its stake
+func (k Keeper) ValidatePartialUnstake(ctx sdk.Ctx, validator types.Validator, amount sdk.BigInt, signer sdk.Address) sdk.Error {
+	if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.PartialUnstakeKey) {
+		return types.ErrPartialUnstakeInactive(k.codespace)
+	}
+	err, valid := ValidateValidatorMsgSigner(validator, signer, k)
+	if !valid {
+		return err
+	}
+	if !validator.IsStaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	if validator.IsJailed() {
+		return types.ErrValidatorJailed(k.codespace)
+	}
+	if k.IsWaitingValidator(ctx, validator.Address) {
+		return types.ErrValidatorWaitingToUnstake(k.codespace)
+	}
+	if !amount.IsPositive() {
+		return types.ErrBadPartialUnstakeAmount(k.codespace)
+	}
+	// the remaining stake must still satisfy the minimum
+	if validator.StakedTokens.Sub(amount).LT(sdk.NewInt(k.MinimumStake(ctx))) {
+		return types.ErrPartialUnstakeBelowMinimum(k.codespace)
+	}
+	return nil
+}
+
+// PartialUnstakeValidator - Remove amount from the validator's stake and start its unstaking timer
+// The tokens stay in the staked pool, and subject to slashing, until the entry completes
+func (k Keeper) PartialUnstakeValidator(ctx sdk.Ctx, validator types.Validator, amount sdk.BigInt) (types.UnbondingEntry, sdk.Error) {
+	validator, err := k.removeValidatorTokens(ctx, validator, amount)
+	if err != nil {
+		return types.UnbondingEntry{}, sdk.ErrInternal(err.Error())
+	}
+	entry := types.UnbondingEntry{
+		Address:        validator.Address,
+		OutputAddress:  k.GetOutputAddressFromValidator(validator),
+		Amount:         amount,
+		CreationHeight: ctx.BlockHeight(),
+		CompletionTime: ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx)),
+	}
+	k.SetUnbondingEntry(ctx, entry)
+	ctx.Logger().Info(fmt.Sprintf("Began unbonding %s tokens of validator %s", amount, validator.Address))
+	return entry, nil
+}
+
+// completeAllMatureUnbondingEntries - Release the tokens of all the unbonding entries that have finished their unstaking period
+func (k Keeper) completeAllMatureUnbondingEntries(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	now := ctx.BlockHeader().Time
+	iterator, _ := store.Iterator(types.UnbondingQueueKey, sdk.InclusiveEndBytes(types.KeyForUnbondingQueue(now)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var addrs sdk.Addresses
+		_ = k.Cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &addrs, ctx.BlockHeight())
+		for _, addr := range addrs {
+			k.completeMatureUnbondingEntries(ctx, addr, now)
+		}
+		_ = store.Delete(iterator.Key())
+	}
+}
+
+// completeMatureUnbondingEntries - Send the tokens of the mature unbonding entries of a validator to its output address
+func (k Keeper) completeMatureUnbondingEntries(ctx sdk.Ctx, addr sdk.Address, now time.Time) {
+	var remaining []types.UnbondingEntry
+	for _, entry := range k.GetUnbondingEntries(ctx, addr) {
+		if entry.CompletionTime.After(now) {
+			remaining = append(remaining, entry)
+			continue
+		}
+		if entry.Amount.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), entry.Amount))
+			err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, entry.OutputAddress, coins)
+			if err != nil {
+				ctx.Logger().Error(fmt.Sprintf("could not release the unbonding tokens of %s: %s", addr, err.Error()))
+				// even if error continue with the unbonding
+			}
+		}
+		ctx.Logger().Info(fmt.Sprintf("Finished unbonding %s tokens of validator %s", entry.Amount, addr))
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCompleteUnbonding,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, entry.Amount.String()),
+			),
+		})
+	}
+	k.SetUnbondingEntries(ctx, addr, remaining)
+}
+
+// slashUnbondingEntries - Slash the unbonding entries of a validator that were still staked at the infraction height
+// returns the amount of tokens burned
+func (k Keeper) slashUnbondingEntries(ctx sdk.Ctx, addr sdk.Address, infractionHeight int64, slashFactor sdk.BigDec) sdk.BigInt {
+	entries := k.GetUnbondingEntries(ctx, addr)
+	totalBurned := sdk.ZeroInt()
+	for i, entry := range entries {
+		// stake that began unbonding before the infraction did not contribute to it
+		if entry.CreationHeight < infractionHeight {
+			continue
+		}
+		burn := sdk.MinInt(entry.Amount.ToDec().Mul(slashFactor).TruncateInt(), entry.Amount)
+		if !burn.IsPositive() {
+			continue
+		}
+		if err := k.burnStakedTokens(ctx, burn); err != nil {
+			k.Logger(ctx).Error("could not burn unbonding tokens in slash: " + err.Error() + "\nfor validator " + addr.String())
+			continue
+		}
+		entries[i].Amount = entry.Amount.Sub(burn)
+		totalBurned = totalBurned.Add(burn)
+	}
+	if totalBurned.IsPositive() {
+		// entries slashed to zero are kept until they complete so the queue stays consistent
+		k.SetUnbondingEntries(ctx, addr, entries)
+	}
+	return totalBurned
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_ValidatePartialUnstake(t *testing.T) {
+	codec.UpgradeFeatureMap[codec.PartialUnstakeKey] = 1
+	defer delete(codec.UpgradeFeatureMap, codec.PartialUnstakeKey)
+	validator := getStakedValidator()
+	jailed := getStakedValidator()
+	jailed.Jailed = true
+	unstaking := getUnstakingValidator()
+	tests := []struct {
+		name      string
+		height    int64
+		validator types.Validator
+		amount    sdk.BigInt
+		signer    sdk.Address
+		want      sdk.Error
+	}{
+		{"before the upgrade", 0, validator, sdk.NewInt(100), validator.Address, types.ErrPartialUnstakeInactive(types.ModuleName)},
+		{"bad signer", 10, validator, sdk.NewInt(100), getRandomValidatorAddress(), types.ErrUnauthorizedSigner(types.ModuleName)},
+		{"not staked", 10, unstaking, sdk.NewInt(100), unstaking.Address, types.ErrValidatorStatus(types.ModuleName)},
+		{"jailed", 10, jailed, sdk.NewInt(100), jailed.Address, types.ErrValidatorJailed(types.ModuleName)},
+		{"zero amount", 10, validator, sdk.ZeroInt(), validator.Address, types.ErrBadPartialUnstakeAmount(types.ModuleName)},
+		{"below minimum", 10, validator, validator.StakedTokens, validator.Address, types.ErrPartialUnstakeBelowMinimum(types.ModuleName)},
+		{"valid", 10, validator, sdk.NewInt(100), validator.Address, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			context, _, keeper := createTestInput(t, true)
+			context = context.WithBlockHeight(tt.height)
+			err := keeper.ValidatePartialUnstake(context, tt.validator, tt.amount, tt.signer)
+			if tt.want == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.want.Code(), err.Code())
+		})
+	}
+}
+
+func TestKeeper_PartialUnstakeAndComplete(t *testing.T) {
+	codec.UpgradeFeatureMap[codec.PartialUnstakeKey] = 1
+	defer delete(codec.UpgradeFeatureMap, codec.PartialUnstakeKey)
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	amount := sdk.NewInt(5000)
+
+	entry, err := keeper.PartialUnstakeValidator(context, validator, amount)
+	assert.Nil(t, err)
+	assert.Equal(t, context.BlockHeader().Time.Add(keeper.UnStakingTime(context)), entry.CompletionTime)
+	v, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.True(t, v.StakedTokens.Equal(validator.StakedTokens.Sub(amount)))
+	assert.Len(t, keeper.GetUnbondingEntries(context, validator.Address), 1)
+
+	// not mature yet
+	keeper.completeAllMatureUnbondingEntries(context)
+	assert.Len(t, keeper.GetUnbondingEntries(context, validator.Address), 1)
+	assert.True(t, keeper.GetBalance(context, validator.Address).IsZero())
+
+	context = context.WithBlockTime(entry.CompletionTime)
+	keeper.completeAllMatureUnbondingEntries(context)
+	assert.Len(t, keeper.GetUnbondingEntries(context, validator.Address), 0)
+	assert.True(t, keeper.GetBalance(context, validator.Address).Equal(amount))
+	assert.Nil(t, keeper.GetAllUnbondingEntries(context))
+}
+
+func TestKeeper_SlashUnbondingEntries(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	addr := getRandomValidatorAddress()
+	old := types.UnbondingEntry{Address: addr, OutputAddress: addr, Amount: sdk.NewInt(1000), CreationHeight: 4, CompletionTime: time.Unix(2000, 0)}
+	recent := types.UnbondingEntry{Address: addr, OutputAddress: addr, Amount: sdk.NewInt(1000), CreationHeight: 8, CompletionTime: time.Unix(2000, 0)}
+	keeper.SetUnbondingEntry(context, old)
+	keeper.SetUnbondingEntry(context, recent)
+
+	burned := keeper.slashUnbondingEntries(context, addr, 6, sdk.NewDecWithPrec(5, 1))
+	assert.True(t, burned.Equal(sdk.NewInt(500)))
+	entries := keeper.GetUnbondingEntries(context, addr)
+	assert.Len(t, entries, 2)
+	assert.True(t, entries[0].Amount.Equal(sdk.NewInt(1000)))
+	assert.True(t, entries[1].Amount.Equal(sdk.NewInt(500)))
+}
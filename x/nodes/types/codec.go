@@ -20,10 +20,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(MsgBeginUnstake{}, "pos/8.0MsgBeginUnstake")
 	cdc.RegisterStructure(MsgProtoStake{}, "pos/8.0MsgProtoStake")
 	cdc.RegisterStructure(MsgStake{}, "pos/8.0MsgStake")
+	cdc.RegisterStructure(MsgPartialUnstake{}, "pos/MsgPartialUnstake")
 	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgUnjail{}, &MsgBeginUnstake{}, &MsgSend{}, &MsgStake{},
-		&LegacyMsgUnjail{}, &LegacyMsgBeginUnstake{}, &LegacyMsgStake{})
+		&LegacyMsgUnjail{}, &LegacyMsgBeginUnstake{}, &LegacyMsgStake{}, &MsgPartialUnstake{})
 	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgUnjail{}, &MsgBeginUnstake{}, &MsgSend{}, &MsgStake{},
-		&LegacyMsgUnjail{}, &LegacyMsgBeginUnstake{}, &LegacyMsgStake{})
+		&LegacyMsgUnjail{}, &LegacyMsgBeginUnstake{}, &LegacyMsgStake{}, &MsgPartialUnstake{})
 	cdc.RegisterInterface("nodes/validatorI", (*exported.ValidatorI)(nil), &Validator{}, &LegacyValidator{})
 	ModuleCdc = cdc
 }
@@ -37,6 +37,8 @@ const (
 	CodeUnequalOutputAddr        CodeType          = 124
 	CodeUnauthorizedSigner       CodeType          = 125
 	CodeNilSigner                CodeType          = 126
+	CodePartialUnstakeInactive   CodeType          = 127
+	CodeInvalidPartialUnstake    CodeType          = 128
 )
 
 func ErrTooManyChains(codespace sdk.CodespaceType) sdk.Error {
@@ -155,3 +157,15 @@ func ErrInvalidNetworkIdentifier(codespace sdk.CodespaceType, err error) sdk.Err
 func ErrStateConversion(codespace sdk.CodespaceType, err error) sdk.Error {
 	return sdk.NewError(codespace, CodeStateConvertError, fmt.Sprintf("unable to convert state: "+err.Error()))
 }
+
+func ErrPartialUnstakeInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodePartialUnstakeInactive, "partial unstaking is not activated yet")
+}
+
+func ErrBadPartialUnstakeAmount(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPartialUnstake, "the partial unstake amount must be positive")
+}
+
+func ErrPartialUnstakeBelowMinimum(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPartialUnstake, "validator must remain staked above the minimum after a partial unstake")
+}
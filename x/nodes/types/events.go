@@ -8,6 +8,8 @@ const (
 	EventTypeBeginUnstake            = "begin_unstake"
 	EventTypeWaitingToBeginUnstaking = "waiting_to_begin_unstaking"
 	EventTypeUnstake                 = "unstake"
+	EventTypePartialUnstake          = "partial_unstake"
+	EventTypeCompleteUnbonding       = "complete_unbonding"
 	EventTypeProposerReward          = "proposer_reward"
 	EventTypeDAOAllocation           = "dao_allocation"
 	EventTypeSlash                   = "slash"
@@ -22,5 +24,6 @@ const (
 	AttributeValueDoubleSign         = "double_sign"
 	AttributeValueMissingSignature   = "missing_signature"
 	AttributeKeyValidator            = "validator"
+	AttributeKeyCompletionTime       = "completion_time"
 	AttributeValueCategory           = ModuleName
 )
@@ -1,17 +1,19 @@
 package types
 
 const (
-	StakeFee   = 10000
-	UnstakeFee = 10000
-	UnjailFee  = 10000
-	SendFee    = 10000
+	StakeFee          = 10000
+	UnstakeFee        = 10000
+	PartialUnstakeFee = 10000
+	UnjailFee         = 10000
+	SendFee           = 10000
 )
 
 var (
 	NodeFeeMap = map[string]int64{
-		MsgStakeName:   StakeFee,
-		MsgUnstakeName: UnstakeFee,
-		MsgUnjailName:  UnjailFee,
-		MsgSendName:    SendFee,
+		MsgStakeName:          StakeFee,
+		MsgUnstakeName:        UnstakeFee,
+		MsgPartialUnstakeName: PartialUnstakeFee,
+		MsgUnjailName:         UnjailFee,
+		MsgSendName:           SendFee,
 	}
 )
@@ -29,6 +29,8 @@ var ( // Keys for store prefixes
 	AwardValidatorKey               = []byte{0x51} // prefix for awarding validators
 	BurnValidatorKey                = []byte{0x52} // prefix for awarding validators
 	WaitingToBeginUnstakingKey      = []byte{0x43} // prefix for waiting validators
+	UnbondingEntriesKey             = []byte{0x44} // prefix for the partially unstaked stake of a validator
+	UnbondingQueueKey               = []byte{0x45} // prefix for the validators with unbonding stake by completion time
 )
 
 func KeyForValidatorByNetworkID(addr sdk.Address, networkID []byte) []byte {
@@ -59,6 +61,17 @@ func KeyForUnstakingValidators(unstakingTime time.Time) []byte {
 	return append(UnstakingValidatorsKey, bz...) // use the unstaking time as part of the key
 }
 
+// generates the key for the unbonding entries of the validator with address
+func KeyForUnbondingEntries(addr sdk.Address) []byte {
+	return append(UnbondingEntriesKey, addr.Bytes()...)
+}
+
+// generates the key for the validators with unbonding entries completing at completionTime
+func KeyForUnbondingQueue(completionTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(completionTime)
+	return append(UnbondingQueueKey, bz...)
+}
+
 // generates the key for a validator in the staking set
 func KeyForValidatorInStakingSet(validator Validator) []byte {
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
@@ -256,6 +256,45 @@ func (m *LegacyMsgUnjail) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LegacyMsgUnjail proto.InternalMessageInfo
 
+type MsgPartialUnstake struct {
+	Address github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=Address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"validator_address" yaml:"validator_address"`
+	Signer  github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=Signer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"signer_address,omitempty" yaml:"signer_address"`
+	Amount  github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgPartialUnstake) Reset()         { *m = MsgPartialUnstake{} }
+func (m *MsgPartialUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialUnstake) ProtoMessage()    {}
+func (*MsgPartialUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0de9b62fa75e413f, []int{6}
+}
+func (m *MsgPartialUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialUnstake.Merge(m, src)
+}
+func (m *MsgPartialUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialUnstake proto.InternalMessageInfo
+
 type MsgSend struct {
 	FromAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=FromAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"from_address" yaml:"from_address"`
 	ToAddress   github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=ToAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"to_address" yaml:"to_address"`
@@ -266,7 +305,7 @@ func (m *MsgSend) Reset()         { *m = MsgSend{} }
 func (m *MsgSend) String() string { return proto.CompactTextString(m) }
 func (*MsgSend) ProtoMessage()    {}
 func (*MsgSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0de9b62fa75e413f, []int{6}
+	return fileDescriptor_0de9b62fa75e413f, []int{7}
 }
 func (m *MsgSend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,56 +358,58 @@ func init() {
 	proto.RegisterType((*LegacyMsgBeginUnstake)(nil), "x.nodes.LegacyMsgBeginUnstake")
 	proto.RegisterType((*MsgUnjail)(nil), "x.nodes.MsgUnjail")
 	proto.RegisterType((*LegacyMsgUnjail)(nil), "x.nodes.LegacyMsgUnjail")
+	proto.RegisterType((*MsgPartialUnstake)(nil), "x.nodes.MsgPartialUnstake")
 	proto.RegisterType((*MsgSend)(nil), "x.nodes.MsgSend")
 }
 
 func init() { proto.RegisterFile("x/nodes/msg.proto", fileDescriptor_0de9b62fa75e413f) }
 
 var fileDescriptor_0de9b62fa75e413f = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6b, 0x13, 0x4f,
-	0x1c, 0xcd, 0xa4, 0xdf, 0x26, 0x64, 0x9a, 0xb4, 0x74, 0xfb, 0x2d, 0x2c, 0x0a, 0x99, 0xb2, 0x22,
-	0xf4, 0x60, 0x13, 0xa5, 0xb7, 0xde, 0x1a, 0x51, 0x10, 0x0d, 0xd6, 0xc4, 0x8a, 0x88, 0x50, 0xb7,
-	0x9b, 0xe9, 0x74, 0xbb, 0x3f, 0x66, 0xd9, 0x99, 0x8d, 0xc9, 0x45, 0xc4, 0x53, 0x2f, 0x42, 0x8f,
-	0x7a, 0x2b, 0x5e, 0xf4, 0x4f, 0x29, 0x78, 0xe9, 0xb1, 0x78, 0x18, 0xa4, 0xbd, 0xc8, 0x1e, 0x73,
-	0x14, 0x0f, 0x92, 0x9d, 0xdd, 0x6e, 0x36, 0x07, 0x29, 0x29, 0xa8, 0x07, 0x6f, 0x99, 0xf7, 0x99,
-	0x99, 0xf7, 0xf6, 0xbd, 0x4f, 0x3e, 0x0c, 0x9c, 0xef, 0xd5, 0x5d, 0xda, 0xc1, 0xac, 0xee, 0x30,
-	0x52, 0xf3, 0x7c, 0xca, 0xa9, 0x52, 0xec, 0xd5, 0x22, 0xe8, 0xca, 0xff, 0x84, 0x12, 0x1a, 0x61,
-	0xf5, 0xe1, 0x2f, 0x59, 0xd6, 0x4e, 0xa6, 0x60, 0xa5, 0xc9, 0xc8, 0xc6, 0x70, 0xd1, 0xe6, 0xba,
-	0x85, 0x95, 0x75, 0x58, 0xda, 0x08, 0xb6, 0x6d, 0xd3, 0xb0, 0x70, 0x5f, 0x05, 0x4b, 0x60, 0xb9,
-	0xdc, 0xb8, 0x16, 0x0a, 0x04, 0xbd, 0x08, 0xdc, 0xb2, 0x70, 0x7f, 0x20, 0xd0, 0x7c, 0x5f, 0x77,
-	0xec, 0x35, 0x2d, 0xc5, 0xb4, 0x56, 0x7a, 0x4a, 0x59, 0x85, 0x85, 0xdb, 0xbb, 0xba, 0xe9, 0x32,
-	0x35, 0xbf, 0x34, 0xb5, 0x5c, 0x6a, 0x5c, 0x0d, 0x05, 0x2a, 0x18, 0x11, 0x32, 0x10, 0xa8, 0x22,
-	0xcf, 0xca, 0xb5, 0xd6, 0x8a, 0xb7, 0x2a, 0x04, 0x4e, 0x77, 0x75, 0x3b, 0xc0, 0xea, 0xd4, 0x12,
-	0x58, 0x2e, 0x35, 0x1e, 0x1d, 0x09, 0x94, 0xfb, 0x22, 0xd0, 0x4d, 0x62, 0xf2, 0xdd, 0x60, 0xbb,
-	0x66, 0x50, 0xa7, 0xee, 0x51, 0x8b, 0xaf, 0xb8, 0x98, 0xbf, 0xa4, 0xbe, 0x55, 0xf7, 0xa8, 0x61,
-	0x61, 0xbe, 0x62, 0x50, 0x1f, 0xd7, 0x79, 0xdf, 0xc3, 0xac, 0xd6, 0x30, 0xc9, 0x3d, 0x97, 0x87,
-	0x02, 0xc9, 0x8b, 0x06, 0x02, 0x95, 0x25, 0x55, 0xb4, 0xd4, 0x5a, 0x12, 0x56, 0xee, 0x40, 0xd8,
-	0xc6, 0x7e, 0xd7, 0x34, 0xf0, 0xa6, 0x6f, 0xab, 0xff, 0x45, 0x6c, 0xd7, 0x43, 0x81, 0x66, 0x98,
-	0x44, 0xb7, 0x02, 0xdf, 0x1e, 0x08, 0xa4, 0xc8, 0xb3, 0x23, 0xa0, 0xd6, 0x1a, 0x39, 0xa8, 0x1c,
-	0x00, 0x58, 0x79, 0x18, 0x70, 0x2f, 0xe0, 0xeb, 0x9d, 0x8e, 0x8f, 0x19, 0x53, 0xa7, 0x23, 0xb3,
-	0xf6, 0x42, 0x81, 0x54, 0x1a, 0x15, 0xb6, 0x74, 0x59, 0xb9, 0x41, 0x1d, 0x93, 0x63, 0xc7, 0xe3,
-	0x43, 0xeb, 0x16, 0xe5, 0xbd, 0xd9, 0x1d, 0xda, 0x77, 0x81, 0x6e, 0x5d, 0xfc, 0x4b, 0x63, 0xc6,
-	0x56, 0x56, 0xc0, 0x5a, 0x79, 0xff, 0x10, 0xe5, 0xde, 0x1d, 0x22, 0xf0, 0xed, 0x10, 0x01, 0xed,
-	0x73, 0x1e, 0x2e, 0x3c, 0xc0, 0x44, 0x37, 0xfa, 0xff, 0x02, 0x9e, 0x20, 0xe0, 0x31, 0x37, 0x3f,
-	0xe6, 0xe1, 0x5c, 0x93, 0x91, 0x06, 0x26, 0xa6, 0xbb, 0xe9, 0xb2, 0xc8, 0xc9, 0xd7, 0x00, 0x16,
-	0x93, 0xf0, 0xa5, 0x91, 0x3b, 0xa1, 0x40, 0xf3, 0x5d, 0xdd, 0x36, 0x3b, 0x3a, 0xa7, 0x7e, 0x92,
-	0xee, 0x40, 0x20, 0xf5, 0x5c, 0x68, 0xb6, 0x34, 0x61, 0xf0, 0x09, 0xad, 0xf2, 0x06, 0xc0, 0x42,
-	0xdb, 0x24, 0x2e, 0xf6, 0xd5, 0x7c, 0xda, 0x7e, 0x2c, 0x42, 0x7e, 0xd5, 0x7e, 0xd9, 0x1d, 0x13,
-	0xaa, 0x88, 0x99, 0xc7, 0x9c, 0xfa, 0x04, 0xe0, 0xe2, 0x79, 0xdf, 0xfd, 0x65, 0x7e, 0x8d, 0x49,
-	0x7d, 0x9b, 0x87, 0xa5, 0x26, 0x23, 0x9b, 0xee, 0x9e, 0x6e, 0xda, 0x4a, 0x0f, 0x56, 0x9e, 0x24,
-	0x7c, 0xc3, 0xfd, 0xb1, 0xc6, 0x56, 0x28, 0x50, 0x31, 0x55, 0x36, 0x2b, 0x95, 0x5d, 0xf2, 0x8f,
-	0x9b, 0x21, 0x52, 0x7a, 0x63, 0x21, 0xbe, 0x08, 0x05, 0x9a, 0xcd, 0x46, 0xf4, 0x5b, 0xa2, 0x7b,
-	0x0f, 0xe0, 0xdc, 0x79, 0x74, 0x7f, 0xda, 0x95, 0x31, 0x6d, 0x3f, 0xf2, 0xb0, 0xd8, 0x64, 0xa4,
-	0x8d, 0xdd, 0x8e, 0xf2, 0x0a, 0xce, 0xdc, 0xf5, 0xa9, 0x93, 0xed, 0xa5, 0xe7, 0xa1, 0x40, 0xe5,
-	0x1d, 0x9f, 0x3a, 0x23, 0x96, 0x2d, 0x48, 0x59, 0xa3, 0xe8, 0x84, 0xda, 0x46, 0x09, 0x95, 0x2e,
-	0x2c, 0x3d, 0xa6, 0x09, 0xbb, 0x8c, 0xec, 0xe9, 0x70, 0x84, 0x72, 0x3a, 0xc2, 0x1d, 0x8f, 0xd0,
-	0x14, 0x9b, 0x90, 0x39, 0xa5, 0x52, 0x2c, 0x58, 0xd0, 0x1d, 0x1a, 0xb8, 0x3c, 0x9e, 0xa1, 0xed,
-	0x4b, 0xcc, 0xd0, 0xf8, 0xa6, 0x74, 0x5e, 0xcb, 0xb5, 0xd6, 0x8a, 0x0b, 0x6b, 0xe5, 0xc4, 0xfa,
-	0xfd, 0x0f, 0x08, 0x34, 0xee, 0x1f, 0x9d, 0x56, 0xc1, 0xf1, 0x69, 0x15, 0x7c, 0x3d, 0xad, 0x82,
-	0x83, 0xb3, 0x6a, 0xee, 0xf8, 0xac, 0x9a, 0x3b, 0x39, 0xab, 0xe6, 0x9e, 0x5d, 0xe8, 0x93, 0x92,
-	0x87, 0x49, 0x24, 0x62, 0xbb, 0x10, 0x3d, 0x3e, 0x56, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x6a,
-	0x0a, 0x12, 0xe0, 0xb0, 0x08, 0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6b, 0x13, 0x41,
+	0x18, 0xcd, 0x24, 0x36, 0x21, 0xd3, 0xa4, 0x25, 0x5b, 0x0b, 0x8b, 0x42, 0xa6, 0xac, 0x08, 0x3d,
+	0xd8, 0x44, 0xe9, 0xad, 0xb7, 0x46, 0x14, 0x44, 0x83, 0x75, 0x63, 0x45, 0x44, 0xa8, 0xdb, 0xcd,
+	0x74, 0xbb, 0xdd, 0x1f, 0xb3, 0xec, 0xcc, 0xc6, 0xe4, 0x22, 0xe2, 0xa9, 0x17, 0xa1, 0x27, 0xd1,
+	0x5b, 0xf1, 0xa2, 0x7f, 0x4a, 0xc1, 0x4b, 0x8f, 0xc5, 0xc3, 0x20, 0xed, 0x45, 0xf6, 0x98, 0xa3,
+	0x78, 0x90, 0xec, 0xec, 0x36, 0xd9, 0x1c, 0xa4, 0xa4, 0x50, 0x3d, 0xf4, 0xb6, 0xf3, 0xbe, 0x99,
+	0x79, 0x6f, 0xdf, 0x9b, 0xfd, 0x76, 0x60, 0xa5, 0x5b, 0x77, 0x49, 0x1b, 0xd3, 0xba, 0x43, 0x8d,
+	0x9a, 0xe7, 0x13, 0x46, 0xa4, 0x42, 0xb7, 0x16, 0x41, 0xd7, 0xae, 0x1a, 0xc4, 0x20, 0x11, 0x56,
+	0x1f, 0x3c, 0x89, 0xb2, 0x72, 0x94, 0x83, 0xe5, 0x26, 0x35, 0xd6, 0x06, 0x83, 0x16, 0xd3, 0x2c,
+	0x2c, 0xad, 0xc2, 0xe2, 0x5a, 0xb0, 0x69, 0x9b, 0xba, 0x85, 0x7b, 0x32, 0x58, 0x00, 0x8b, 0xa5,
+	0xc6, 0x8d, 0x90, 0x23, 0xe8, 0x45, 0xe0, 0x86, 0x85, 0x7b, 0x7d, 0x8e, 0x2a, 0x3d, 0xcd, 0xb1,
+	0x57, 0x94, 0x21, 0xa6, 0xa8, 0xc3, 0x55, 0xd2, 0x32, 0xcc, 0xdf, 0xdd, 0xd6, 0x4c, 0x97, 0xca,
+	0xd9, 0x85, 0xdc, 0x62, 0xb1, 0x71, 0x3d, 0xe4, 0x28, 0xaf, 0x47, 0x48, 0x9f, 0xa3, 0xb2, 0x58,
+	0x2b, 0xc6, 0x8a, 0x1a, 0x4f, 0x95, 0x0c, 0x38, 0xd5, 0xd1, 0xec, 0x00, 0xcb, 0xb9, 0x05, 0xb0,
+	0x58, 0x6c, 0x3c, 0x39, 0xe0, 0x28, 0xf3, 0x9d, 0xa3, 0xdb, 0x86, 0xc9, 0xb6, 0x83, 0xcd, 0x9a,
+	0x4e, 0x9c, 0xba, 0x47, 0x2c, 0xb6, 0xe4, 0x62, 0xf6, 0x9a, 0xf8, 0x56, 0xdd, 0x23, 0xba, 0x85,
+	0xd9, 0x92, 0x4e, 0x7c, 0x5c, 0x67, 0x3d, 0x0f, 0xd3, 0x5a, 0xc3, 0x34, 0x1e, 0xb8, 0x2c, 0xe4,
+	0x48, 0x6c, 0xd4, 0xe7, 0xa8, 0x24, 0xa8, 0xa2, 0xa1, 0xa2, 0x0a, 0x58, 0xba, 0x07, 0x61, 0x0b,
+	0xfb, 0x1d, 0x53, 0xc7, 0xeb, 0xbe, 0x2d, 0x5f, 0x89, 0xd8, 0x6e, 0x86, 0x1c, 0x4d, 0x53, 0x81,
+	0x6e, 0x04, 0xbe, 0xdd, 0xe7, 0x48, 0x12, 0x6b, 0x47, 0x40, 0x45, 0x1d, 0x59, 0x28, 0xed, 0x01,
+	0x58, 0x7e, 0x1c, 0x30, 0x2f, 0x60, 0xab, 0xed, 0xb6, 0x8f, 0x29, 0x95, 0xa7, 0x22, 0xb3, 0x76,
+	0x42, 0x8e, 0x64, 0x12, 0x15, 0x36, 0x34, 0x51, 0xb9, 0x45, 0x1c, 0x93, 0x61, 0xc7, 0x63, 0x03,
+	0xeb, 0xe6, 0xc5, 0xbe, 0xe9, 0x19, 0xca, 0x2f, 0x8e, 0xee, 0x9c, 0xfd, 0x4d, 0x63, 0x46, 0x35,
+	0x2d, 0x60, 0xa5, 0xb4, 0xbb, 0x8f, 0x32, 0x1f, 0xf7, 0x11, 0xf8, 0xb9, 0x8f, 0x80, 0xf2, 0x2d,
+	0x0b, 0xe7, 0x1e, 0x61, 0x43, 0xd3, 0x7b, 0x97, 0x01, 0x4f, 0x10, 0xf0, 0x98, 0x9b, 0x5f, 0xb2,
+	0x70, 0xb6, 0x49, 0x8d, 0x06, 0x36, 0x4c, 0x77, 0xdd, 0xa5, 0x91, 0x93, 0x6f, 0x01, 0x2c, 0x24,
+	0xe1, 0x0b, 0x23, 0xb7, 0x42, 0x8e, 0x2a, 0x1d, 0xcd, 0x36, 0xdb, 0x1a, 0x23, 0x7e, 0x92, 0x6e,
+	0x9f, 0x23, 0xf9, 0x54, 0x68, 0xba, 0x34, 0x61, 0xf0, 0x09, 0xad, 0xf4, 0x0e, 0xc0, 0x7c, 0xcb,
+	0x34, 0x5c, 0xec, 0xcb, 0xd9, 0xe1, 0xf1, 0xa3, 0x11, 0xf2, 0xb7, 0xe3, 0x97, 0x9e, 0x31, 0xa1,
+	0x8a, 0x98, 0x79, 0xcc, 0xa9, 0xaf, 0x00, 0xce, 0x9f, 0x9e, 0xbb, 0xff, 0xcc, 0xaf, 0x31, 0xa9,
+	0xef, 0xb3, 0xb0, 0xd8, 0xa4, 0xc6, 0xba, 0xbb, 0xa3, 0x99, 0xb6, 0xd4, 0x85, 0xe5, 0x67, 0x09,
+	0xdf, 0x60, 0x7e, 0xac, 0x51, 0x0d, 0x39, 0x2a, 0x0c, 0x95, 0xcd, 0x08, 0x65, 0xe7, 0xfc, 0x70,
+	0x53, 0x44, 0x52, 0x77, 0x2c, 0xc4, 0x57, 0x21, 0x47, 0x33, 0xe9, 0x88, 0x2e, 0x24, 0xba, 0x4f,
+	0x00, 0xce, 0x9e, 0x46, 0xf7, 0xaf, 0x5d, 0x19, 0xd3, 0xf6, 0x21, 0x07, 0x2b, 0x83, 0x46, 0xa6,
+	0xf9, 0xcc, 0xd4, 0xec, 0xcb, 0x4f, 0x30, 0x95, 0xa3, 0x64, 0xc1, 0xbc, 0xe6, 0x90, 0xc0, 0x65,
+	0x71, 0x77, 0x6d, 0x9d, 0xa3, 0xbb, 0xc6, 0x3b, 0x0d, 0x3b, 0xb9, 0x18, 0x2b, 0x6a, 0x5c, 0x18,
+	0x0b, 0xe6, 0x77, 0x16, 0x16, 0x9a, 0xd4, 0x68, 0x61, 0xb7, 0x2d, 0xbd, 0x81, 0xd3, 0xf7, 0x7d,
+	0xe2, 0xa4, 0x13, 0x79, 0x19, 0x72, 0x54, 0xda, 0xf2, 0x89, 0x33, 0x12, 0xc6, 0x9c, 0xd8, 0x79,
+	0x14, 0x9d, 0xd0, 0x81, 0x51, 0x42, 0xa9, 0x03, 0x8b, 0x4f, 0x49, 0xc2, 0x2e, 0xd2, 0x78, 0x3e,
+	0xf8, 0xb7, 0x31, 0x32, 0xc2, 0x1d, 0xff, 0xdb, 0x18, 0x39, 0x27, 0xf3, 0x90, 0xea, 0x82, 0xed,
+	0x4f, 0xac, 0xdf, 0xfd, 0x8c, 0x40, 0xe3, 0xe1, 0xc1, 0x71, 0x15, 0x1c, 0x1e, 0x57, 0xc1, 0x8f,
+	0xe3, 0x2a, 0xd8, 0x3b, 0xa9, 0x66, 0x0e, 0x4f, 0xaa, 0x99, 0xa3, 0x93, 0x6a, 0xe6, 0xc5, 0x99,
+	0x5e, 0x29, 0xb9, 0x31, 0x46, 0x22, 0x36, 0xf3, 0xd1, 0xad, 0x70, 0xf9, 0xcf, 0x00, 0x4c, 0xa7,
+	0xf7, 0xf8, 0x49, 0x0a, 0x00, 0x00,
 }
 
 func (this *MsgProtoStake) Equal(that interface{}) bool {
@@ -552,6 +593,36 @@ func (this *LegacyMsgUnjail) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgPartialUnstake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgPartialUnstake)
+	if !ok {
+		that2, ok := that.(MsgPartialUnstake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.Signer, that1.Signer) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
 func (this *MsgSend) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -835,6 +906,53 @@ func (m *LegacyMsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPartialUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1007,6 +1125,25 @@ func (m *LegacyMsgUnjail) Size() (n int) {
 	return n
 }
 
+func (m *MsgPartialUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsg(uint64(l))
+	return n
+}
+
 func (m *MsgSend) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1852,6 +1989,161 @@ func (m *LegacyMsgUnjail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPartialUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -119,6 +119,93 @@ func (m *LegacyProtoValidator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LegacyProtoValidator proto.InternalMessageInfo
 
+// UnbondingEntry defines an amount of stake removed from a staked validator that is released after the unstaking time
+type UnbondingEntry struct {
+	Address       github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=Address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address" yaml:"address"`
+	OutputAddress github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,2,opt,name=OutputAddress,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"output_address" yaml:"output_address"`
+	Amount        github_com_pokt_network_pocket_core_types.BigInt  `protobuf:"bytes,3,opt,name=Amount,proto3,customtype=github.com/pokt-network/pocket-core/types.BigInt" json:"amount"`
+	// height at which the partial unstake was requested, unbonding stake is slashed for infractions at or before it
+	CreationHeight int64     `protobuf:"varint,4,opt,name=CreationHeight,proto3" json:"creation_height" yaml:"creation_height"`
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=CompletionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *UnbondingEntry) Reset()         { *m = UnbondingEntry{} }
+func (m *UnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntry) ProtoMessage()    {}
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63cb49073b61e33a, []int{2}
+}
+func (m *UnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntry.Merge(m, src)
+}
+func (m *UnbondingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntry proto.InternalMessageInfo
+
+type UnbondingEntries struct {
+	Entries []UnbondingEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"entries"`
+}
+
+func (m *UnbondingEntries) Reset()         { *m = UnbondingEntries{} }
+func (m *UnbondingEntries) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntries) ProtoMessage()    {}
+func (*UnbondingEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_63cb49073b61e33a, []int{3}
+}
+func (m *UnbondingEntries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntries.Merge(m, src)
+}
+func (m *UnbondingEntries) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntries proto.InternalMessageInfo
+
+func (m *UnbondingEntries) GetEntries() []UnbondingEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 // ValidatorSigningInfo defines the signing info for a validator
 type ValidatorSigningInfo struct {
 	Address github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"address"`
@@ -136,7 +223,7 @@ type ValidatorSigningInfo struct {
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
 func (*ValidatorSigningInfo) ProtoMessage() {}
 func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_63cb49073b61e33a, []int{2}
+	return fileDescriptor_63cb49073b61e33a, []int{4}
 }
 func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,61 +297,73 @@ func (m *ValidatorSigningInfo) GetJailedBlocksCounter() int64 {
 func init() {
 	proto.RegisterType((*ProtoValidator)(nil), "x.nodes.ProtoValidator")
 	proto.RegisterType((*LegacyProtoValidator)(nil), "x.nodes.LegacyProtoValidator")
+	proto.RegisterType((*UnbondingEntry)(nil), "x.nodes.UnbondingEntry")
+	proto.RegisterType((*UnbondingEntries)(nil), "x.nodes.UnbondingEntries")
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "x.nodes.ValidatorSigningInfo")
 }
 
 func init() { proto.RegisterFile("x/nodes/nodes.proto", fileDescriptor_63cb49073b61e33a) }
 
 var fileDescriptor_63cb49073b61e33a = []byte{
-	// 767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0xbf, 0x6f, 0xf3, 0x44,
-	0x18, 0xc7, 0x63, 0xda, 0x24, 0xcd, 0x25, 0x14, 0xe1, 0xb4, 0xc2, 0xaa, 0x50, 0x2e, 0x32, 0x03,
-	0x19, 0x68, 0x0c, 0x74, 0xa2, 0x12, 0x12, 0x75, 0x17, 0x4a, 0x2b, 0x51, 0xb9, 0x2d, 0x43, 0x17,
-	0xcb, 0xb1, 0x2f, 0xce, 0xd5, 0x3f, 0xce, 0xf2, 0x9d, 0xa1, 0xf9, 0x0f, 0x60, 0xeb, 0xd8, 0x31,
-	0x7f, 0x4e, 0xc7, 0x2e, 0x48, 0x88, 0xe1, 0x40, 0xad, 0x84, 0x90, 0xc7, 0xb0, 0x31, 0x21, 0xdf,
-	0x39, 0x24, 0xa9, 0xf2, 0xbe, 0xaa, 0xaa, 0x77, 0xec, 0x52, 0xfb, 0x3e, 0xf7, 0xdc, 0xf3, 0xbd,
-	0xfa, 0xf9, 0x0c, 0x01, 0xed, 0x6b, 0x23, 0x26, 0x1e, 0xa2, 0xf2, 0x6f, 0x3f, 0x49, 0x09, 0x23,
-	0x6a, 0xfd, 0xba, 0x2f, 0x96, 0x3b, 0x5b, 0x3e, 0xf1, 0x89, 0x60, 0x46, 0xf1, 0x26, 0xb7, 0x77,
-	0xa0, 0x4f, 0x88, 0x1f, 0x22, 0x43, 0xac, 0x06, 0xd9, 0xd0, 0x60, 0x38, 0x42, 0x94, 0x39, 0x51,
-	0x52, 0x16, 0x74, 0x9e, 0x16, 0x78, 0x59, 0xea, 0x30, 0x4c, 0x62, 0xb9, 0xaf, 0xff, 0x53, 0x05,
-	0x9b, 0xa7, 0xc5, 0xdb, 0x0f, 0x4e, 0x88, 0x3d, 0x87, 0x91, 0x54, 0x0d, 0x41, 0xfd, 0xc0, 0xf3,
-	0x52, 0x44, 0xa9, 0xa6, 0x74, 0x95, 0x5e, 0xcb, 0xb4, 0x72, 0x0e, 0xeb, 0x8e, 0x44, 0x53, 0x0e,
-	0x37, 0xc7, 0x4e, 0x14, 0xee, 0xeb, 0x25, 0xd0, 0xff, 0xe5, 0xf0, 0x0b, 0x1f, 0xb3, 0x51, 0x36,
-	0xe8, 0xbb, 0x24, 0x32, 0x12, 0x12, 0xb0, 0xdd, 0x18, 0xb1, 0x9f, 0x48, 0x1a, 0x18, 0x09, 0x71,
-	0x03, 0xc4, 0x76, 0x5d, 0x92, 0x22, 0x83, 0x8d, 0x13, 0x44, 0xfb, 0x65, 0x67, 0x6b, 0x16, 0xa1,
-	0x1e, 0x80, 0xc6, 0x69, 0x36, 0x08, 0xb1, 0x7b, 0x8c, 0xc6, 0xda, 0x7b, 0x22, 0xef, 0x93, 0x9c,
-	0x43, 0x90, 0x08, 0x68, 0x07, 0x68, 0x3c, 0xe5, 0xf0, 0x43, 0x19, 0x39, 0x67, 0xba, 0x35, 0x3f,
-	0xa5, 0xea, 0xa0, 0x76, 0xe5, 0xe0, 0x10, 0x79, 0xda, 0x5a, 0x57, 0xe9, 0x6d, 0x98, 0x20, 0xe7,
-	0xb0, 0x24, 0x56, 0xf9, 0x2c, 0x6a, 0x28, 0x73, 0x58, 0x46, 0xb5, 0xf5, 0xae, 0xd2, 0xab, 0xca,
-	0x1a, 0x49, 0xac, 0xf2, 0x59, 0xd4, 0x1c, 0x8e, 0x1c, 0x1c, 0x53, 0xad, 0xda, 0x5d, 0xeb, 0x35,
-	0x64, 0x8d, 0x2b, 0x88, 0x55, 0xee, 0xa8, 0x06, 0x00, 0x67, 0x28, 0xfd, 0x11, 0xbb, 0xe8, 0xc2,
-	0x3a, 0xd1, 0x6a, 0x5d, 0xa5, 0xd7, 0x30, 0x3f, 0xc8, 0x39, 0x6c, 0x52, 0x49, 0xed, 0x2c, 0x0d,
-	0xad, 0x85, 0x12, 0x75, 0x08, 0x5a, 0x67, 0xcc, 0x09, 0x90, 0x77, 0x4e, 0x02, 0x14, 0x53, 0xad,
-	0x2e, 0x8e, 0x98, 0x77, 0x1c, 0x56, 0x7e, 0xe7, 0xf0, 0xf3, 0xe7, 0x7f, 0x39, 0x13, 0xfb, 0x47,
-	0x31, 0x2b, 0xae, 0xc4, 0x44, 0x27, 0x6b, 0xa9, 0xaf, 0xfa, 0x8b, 0x02, 0x3e, 0xba, 0x88, 0x29,
-	0x73, 0x02, 0x1c, 0xfb, 0x87, 0x24, 0x4a, 0x42, 0x54, 0x8c, 0xf9, 0x1c, 0x47, 0x48, 0xdb, 0xe8,
-	0x2a, 0xbd, 0xe6, 0x97, 0x3b, 0x7d, 0xe9, 0x42, 0x7f, 0xe6, 0x42, 0xff, 0x7c, 0x26, 0x8b, 0xb9,
-	0x57, 0xdc, 0x27, 0xe7, 0x70, 0x33, 0x9b, 0xb5, 0xb0, 0x0b, 0x93, 0xa6, 0x1c, 0x6e, 0xcb, 0x4f,
-	0xbf, 0xcc, 0xf5, 0x9b, 0x3f, 0xa0, 0x62, 0xbd, 0x29, 0x4f, 0xbd, 0x51, 0xc0, 0xfb, 0xdf, 0x67,
-	0x2c, 0xc9, 0xd8, 0x4c, 0xa4, 0x86, 0x18, 0xec, 0x55, 0xce, 0xa1, 0x46, 0xc4, 0x86, 0x5d, 0xea,
-	0xf3, 0x19, 0x89, 0x30, 0x43, 0x51, 0xc2, 0xc6, 0xf3, 0xac, 0xe5, 0x8a, 0x17, 0x0a, 0xb6, 0x7c,
-	0x81, 0xfd, 0xd6, 0xcf, 0x13, 0x58, 0xb9, 0x9d, 0x40, 0xe5, 0xef, 0x09, 0x54, 0xf4, 0xbf, 0xd6,
-	0xc1, 0xd6, 0x09, 0xf2, 0x1d, 0x77, 0xfc, 0xea, 0xfe, 0xab, 0xfb, 0xef, 0xd2, 0xfd, 0x27, 0xa2,
-	0xfd, 0xba, 0x0e, 0xb6, 0xfe, 0xb7, 0xeb, 0x0c, 0xfb, 0x31, 0x8e, 0xfd, 0xa3, 0x78, 0x48, 0xd4,
-	0x4b, 0x30, 0xb3, 0xaa, 0x14, 0xed, 0x9b, 0x05, 0xd1, 0x5e, 0xa8, 0x55, 0x79, 0x5a, 0xfd, 0x0e,
-	0xb4, 0x28, 0x73, 0x52, 0x66, 0x8f, 0x10, 0xf6, 0x47, 0x4c, 0x98, 0xb5, 0x66, 0x7e, 0x9a, 0x73,
-	0xb8, 0xc4, 0xa7, 0x1c, 0xb6, 0xe5, 0x3f, 0xb8, 0x48, 0x75, 0xab, 0x29, 0x96, 0xdf, 0x8a, 0x95,
-	0xfa, 0x35, 0xa8, 0x1e, 0xc5, 0x1e, 0xba, 0x16, 0x7a, 0x95, 0x4d, 0x70, 0x01, 0x6c, 0x32, 0x1c,
-	0x52, 0xb4, 0xd0, 0x64, 0x91, 0xea, 0x96, 0x3c, 0xa5, 0xc6, 0xa0, 0x25, 0x25, 0xb4, 0xb3, 0x98,
-	0xe1, 0x50, 0x08, 0xf8, 0xf6, 0x69, 0x18, 0xe5, 0x34, 0x96, 0xce, 0xcd, 0x53, 0x16, 0xa9, 0x9c,
-	0x44, 0x53, 0xa2, 0x8b, 0x82, 0xa8, 0x11, 0xd8, 0x8e, 0x30, 0xa5, 0xc8, 0xb3, 0x07, 0x21, 0x71,
-	0x03, 0x6a, 0xbb, 0x24, 0x8b, 0x19, 0x4a, 0xb5, 0xaa, 0xb8, 0xfe, 0x57, 0x39, 0x87, 0xab, 0x0b,
-	0xa6, 0x1c, 0x7e, 0x2c, 0x13, 0x56, 0x6e, 0xeb, 0x56, 0x5b, 0x72, 0x53, 0xe0, 0x43, 0x49, 0x8b,
-	0xb8, 0xf2, 0x42, 0x4f, 0xe2, 0x6a, 0xf3, 0xb8, 0x95, 0x05, 0xf3, 0xb8, 0x95, 0xdb, 0xba, 0xd5,
-	0x96, 0x7c, 0x29, 0x6e, 0x7f, 0xe3, 0x76, 0x02, 0x2b, 0x85, 0x57, 0xe6, 0xf1, 0xdd, 0x43, 0x47,
-	0xb9, 0x7f, 0xe8, 0x28, 0x7f, 0x3e, 0x74, 0x94, 0x9b, 0xc7, 0x4e, 0xe5, 0xfe, 0xb1, 0x53, 0xf9,
-	0xed, 0xb1, 0x53, 0xb9, 0x7c, 0x96, 0x38, 0xb3, 0x5f, 0x1a, 0x42, 0xa0, 0x41, 0x4d, 0x8c, 0x61,
-	0xef, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x43, 0xc2, 0x5b, 0xa7, 0x81, 0x08, 0x00, 0x00,
+	// 920 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x4d, 0x6f, 0xe3, 0xc4,
+	0x1f, 0xc7, 0xe3, 0x7f, 0x9e, 0xda, 0x49, 0xfe, 0x29, 0xb8, 0x5d, 0xd6, 0xaa, 0x50, 0x26, 0x32,
+	0x07, 0x72, 0xa0, 0x09, 0xec, 0x8a, 0x03, 0x95, 0x90, 0xa8, 0x2b, 0x24, 0xca, 0xae, 0xc4, 0xca,
+	0x6d, 0xf6, 0xd0, 0x4b, 0x70, 0xec, 0x89, 0x3b, 0x1b, 0x7b, 0xc6, 0xb2, 0xc7, 0xd0, 0xbc, 0x01,
+	0x04, 0xb7, 0x1e, 0xf7, 0xd8, 0xf7, 0xc2, 0x65, 0x8f, 0x7b, 0x41, 0x42, 0x1c, 0x06, 0xd4, 0x4a,
+	0x08, 0xf9, 0x18, 0x6e, 0x9c, 0x90, 0x67, 0xc6, 0x9b, 0x38, 0x14, 0x76, 0xb5, 0x3c, 0x9c, 0xf6,
+	0x12, 0xdb, 0xdf, 0xdf, 0xc3, 0x77, 0x3c, 0xbf, 0x8f, 0x1d, 0x83, 0xed, 0xf3, 0x21, 0xa1, 0x1e,
+	0x4a, 0xe4, 0xef, 0x20, 0x8a, 0x29, 0xa3, 0x7a, 0xf3, 0x7c, 0x20, 0x2e, 0x77, 0x77, 0x7c, 0xea,
+	0x53, 0xa1, 0x0d, 0xf3, 0x33, 0x19, 0xde, 0x85, 0x3e, 0xa5, 0x7e, 0x80, 0x86, 0xe2, 0x6a, 0x92,
+	0x4e, 0x87, 0x0c, 0x87, 0x28, 0x61, 0x4e, 0x18, 0xa9, 0x84, 0xee, 0x7a, 0x82, 0x97, 0xc6, 0x0e,
+	0xc3, 0x94, 0xc8, 0xb8, 0xf9, 0x6b, 0x1d, 0x74, 0x1e, 0xe4, 0x67, 0x0f, 0x9d, 0x00, 0x7b, 0x0e,
+	0xa3, 0xb1, 0x1e, 0x80, 0xe6, 0x81, 0xe7, 0xc5, 0x28, 0x49, 0x0c, 0xad, 0xa7, 0xf5, 0xdb, 0x96,
+	0x9d, 0x71, 0xd8, 0x74, 0xa4, 0xb4, 0xe0, 0xb0, 0x33, 0x77, 0xc2, 0x60, 0xdf, 0x54, 0x82, 0xf9,
+	0x1b, 0x87, 0xef, 0xf9, 0x98, 0x9d, 0xa5, 0x93, 0x81, 0x4b, 0xc3, 0x61, 0x44, 0x67, 0x6c, 0x8f,
+	0x20, 0xf6, 0x25, 0x8d, 0x67, 0xc3, 0x88, 0xba, 0x33, 0xc4, 0xf6, 0x5c, 0x1a, 0xa3, 0x21, 0x9b,
+	0x47, 0x28, 0x19, 0xa8, 0xce, 0x76, 0x61, 0xa1, 0x1f, 0x80, 0xcd, 0x07, 0xe9, 0x24, 0xc0, 0xee,
+	0x3d, 0x34, 0x37, 0xfe, 0x27, 0xfc, 0xde, 0xca, 0x38, 0x04, 0x91, 0x10, 0xc7, 0x33, 0x34, 0x5f,
+	0x70, 0xf8, 0xba, 0xb4, 0x5c, 0x6a, 0xa6, 0xbd, 0xac, 0xd2, 0x4d, 0xd0, 0x78, 0xe4, 0xe0, 0x00,
+	0x79, 0x46, 0xb5, 0xa7, 0xf5, 0x37, 0x2c, 0x90, 0x71, 0xa8, 0x14, 0x5b, 0x1d, 0xf3, 0x9c, 0x84,
+	0x39, 0x2c, 0x4d, 0x8c, 0x5a, 0x4f, 0xeb, 0xd7, 0x65, 0x8e, 0x54, 0x6c, 0x75, 0xcc, 0x73, 0x0e,
+	0xcf, 0x1c, 0x4c, 0x12, 0xa3, 0xde, 0xab, 0xf6, 0x37, 0x65, 0x8e, 0x2b, 0x14, 0x5b, 0x45, 0xf4,
+	0x21, 0x00, 0xc7, 0x28, 0xfe, 0x02, 0xbb, 0x68, 0x64, 0xdf, 0x37, 0x1a, 0x3d, 0xad, 0xbf, 0x69,
+	0x6d, 0x65, 0x1c, 0xb6, 0x12, 0xa9, 0x8e, 0xd3, 0x38, 0xb0, 0x57, 0x52, 0xf4, 0x29, 0x68, 0x1f,
+	0x33, 0x67, 0x86, 0xbc, 0x13, 0x3a, 0x43, 0x24, 0x31, 0x9a, 0xa2, 0xc4, 0x7a, 0xc2, 0x61, 0xe5,
+	0x07, 0x0e, 0xdf, 0x7d, 0xf1, 0x9d, 0xb3, 0xb0, 0x7f, 0x44, 0x58, 0xbe, 0x24, 0x26, 0x3a, 0xd9,
+	0xa5, 0xbe, 0xfa, 0x37, 0x1a, 0xb8, 0x3d, 0x22, 0x09, 0x73, 0x66, 0x98, 0xf8, 0x87, 0x34, 0x8c,
+	0x02, 0x94, 0x8f, 0xf9, 0x04, 0x87, 0xc8, 0xd8, 0xe8, 0x69, 0xfd, 0xd6, 0x9d, 0xdd, 0x81, 0x64,
+	0x61, 0x50, 0xb0, 0x30, 0x38, 0x29, 0x60, 0xb1, 0xee, 0xe6, 0xeb, 0xc9, 0x38, 0xec, 0xa4, 0x45,
+	0x8b, 0x71, 0x4e, 0xd2, 0x82, 0xc3, 0x5b, 0x72, 0xeb, 0xcb, 0xba, 0x79, 0xf1, 0x23, 0xd4, 0xec,
+	0x3f, 0xf3, 0xd3, 0x2f, 0x34, 0xf0, 0xff, 0xcf, 0x52, 0x16, 0xa5, 0xac, 0x00, 0x69, 0x53, 0x0c,
+	0xf6, 0x51, 0xc6, 0xa1, 0x41, 0x45, 0x60, 0xac, 0xf0, 0x79, 0x87, 0x86, 0x98, 0xa1, 0x30, 0x62,
+	0xf3, 0xa5, 0x57, 0x39, 0xe3, 0x25, 0x01, 0x2b, 0x2f, 0x60, 0xbf, 0xfd, 0xf5, 0x25, 0xac, 0x3c,
+	0xbe, 0x84, 0xda, 0x2f, 0x97, 0x50, 0x33, 0x7f, 0xae, 0x81, 0x9d, 0xfb, 0xc8, 0x77, 0xdc, 0xf9,
+	0x2b, 0xf6, 0x5f, 0xb1, 0xff, 0x4f, 0xb2, 0xbf, 0x06, 0xda, 0xb7, 0x35, 0xd0, 0x19, 0x91, 0x09,
+	0x25, 0x1e, 0x26, 0xfe, 0xc7, 0x84, 0xc5, 0xf3, 0xff, 0x18, 0xb1, 0xaf, 0xfe, 0xf0, 0x28, 0x4a,
+	0xce, 0x3e, 0xcf, 0x6f, 0xb8, 0xfc, 0xa0, 0xfd, 0xab, 0x0f, 0xa0, 0x7e, 0x0a, 0x1a, 0x07, 0x21,
+	0x4d, 0x09, 0x33, 0xaa, 0x7f, 0x9f, 0x02, 0x47, 0x74, 0xb2, 0x55, 0x47, 0x7d, 0x04, 0x3a, 0x87,
+	0x31, 0x12, 0x7f, 0x6b, 0x9f, 0x20, 0xec, 0x9f, 0x31, 0x01, 0x7a, 0xd5, 0xda, 0xcb, 0x38, 0xdc,
+	0x72, 0x55, 0x64, 0x7c, 0x26, 0x42, 0x0b, 0x0e, 0xdf, 0x90, 0x77, 0xb9, 0x16, 0x30, 0xed, 0xb5,
+	0x26, 0xfa, 0x1c, 0x74, 0xd6, 0x60, 0xaa, 0x3f, 0x17, 0xa6, 0xf7, 0x15, 0x4c, 0x5b, 0xee, 0xb3,
+	0xca, 0x82, 0xa6, 0xc2, 0xb6, 0x1c, 0x90, 0x38, 0x75, 0xfe, 0x92, 0xa2, 0x87, 0xe0, 0xb5, 0x12,
+	0x44, 0x18, 0x25, 0xba, 0x05, 0x9a, 0xea, 0xd4, 0xd0, 0x7a, 0xd5, 0x7e, 0xeb, 0xce, 0xed, 0x81,
+	0xfa, 0x54, 0x18, 0x94, 0x81, 0xb3, 0xb6, 0xd4, 0x92, 0x9a, 0x48, 0xe6, 0xdb, 0x45, 0xa1, 0xf9,
+	0x5d, 0x0d, 0xec, 0x3c, 0x7b, 0xf7, 0x1d, 0x63, 0x9f, 0x60, 0xe2, 0x1f, 0x91, 0x29, 0xd5, 0x4f,
+	0x41, 0xd3, 0x29, 0x31, 0xfa, 0xd1, 0x0a, 0xa3, 0x2f, 0x49, 0xa4, 0xaa, 0xd6, 0x3f, 0x05, 0xed,
+	0x84, 0x39, 0x31, 0x53, 0xdb, 0x2e, 0x78, 0xac, 0x5a, 0x6f, 0x67, 0x1c, 0x96, 0xf4, 0x05, 0x87,
+	0xdb, 0x72, 0xc3, 0x56, 0x55, 0xd3, 0x6e, 0x89, 0x4b, 0x35, 0xa1, 0x0f, 0x41, 0xfd, 0x88, 0x78,
+	0xe8, 0xdc, 0xa8, 0x2e, 0x9b, 0xe0, 0x5c, 0x18, 0xd3, 0xe9, 0x34, 0x41, 0x2b, 0x4d, 0x56, 0x55,
+	0xd3, 0x96, 0x55, 0x3a, 0x01, 0x6d, 0xf9, 0x8a, 0x1c, 0xa7, 0x84, 0xe1, 0xc0, 0xa8, 0x3d, 0x77,
+	0xbc, 0x43, 0xb5, 0x97, 0xa5, 0xba, 0xa5, 0xcb, 0xaa, 0x2a, 0x07, 0xdb, 0x92, 0xd2, 0x28, 0x57,
+	0xf4, 0x10, 0xdc, 0x0a, 0x71, 0x92, 0x20, 0x6f, 0x3c, 0x09, 0xa8, 0x3b, 0x4b, 0xc6, 0x6e, 0x8e,
+	0x2f, 0x8a, 0x05, 0x57, 0x55, 0xeb, 0x83, 0x8c, 0xc3, 0x9b, 0x13, 0x16, 0x1c, 0xbe, 0x29, 0x1d,
+	0x6e, 0x0c, 0x9b, 0xf6, 0xb6, 0xd4, 0x2d, 0x21, 0x1f, 0x4a, 0x35, 0xb7, 0x53, 0x0b, 0x5a, 0xb3,
+	0x6b, 0x2c, 0xed, 0x6e, 0x4c, 0x58, 0xda, 0xdd, 0x18, 0x36, 0xed, 0x6d, 0xa9, 0x97, 0xec, 0xf6,
+	0x37, 0x1e, 0x5f, 0xc2, 0x4a, 0xce, 0xab, 0x75, 0xef, 0xc9, 0x55, 0x57, 0x7b, 0x7a, 0xd5, 0xd5,
+	0x7e, 0xba, 0xea, 0x6a, 0x17, 0xd7, 0xdd, 0xca, 0xd3, 0xeb, 0x6e, 0xe5, 0xfb, 0xeb, 0x6e, 0xe5,
+	0xf4, 0x85, 0xc0, 0x29, 0xbe, 0x83, 0x05, 0x40, 0x93, 0x86, 0x18, 0xc3, 0xdd, 0xdf, 0x07, 0x00,
+	0xde, 0xf9, 0x83, 0xcf, 0x1f, 0x0b, 0x00, 0x00,
 }
 
 func (this *ProtoValidator) Equal(that interface{}) bool {
@@ -370,6 +469,42 @@ func (this *LegacyProtoValidator) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UnbondingEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnbondingEntry)
+	if !ok {
+		that2, ok := that.(UnbondingEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.OutputAddress, that1.OutputAddress) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	return true
+}
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -588,6 +723,103 @@ func (m *LegacyProtoValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintNodes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.CreationHeight != 0 {
+		i = encodeVarintNodes(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNodes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OutputAddress) > 0 {
+		i -= len(m.OutputAddress)
+		copy(dAtA[i:], m.OutputAddress)
+		i = encodeVarintNodes(dAtA, i, uint64(len(m.OutputAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNodes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingEntries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEntries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNodes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -618,12 +850,12 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintNodes(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintNodes(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x22
 	if m.Index != 0 {
@@ -735,6 +967,45 @@ func (m *LegacyProtoValidator) Size() (n int) {
 	return n
 }
 
+func (m *UnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNodes(uint64(l))
+	}
+	l = len(m.OutputAddress)
+	if l > 0 {
+		n += 1 + l + sovNodes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovNodes(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovNodes(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovNodes(uint64(l))
+	return n
+}
+
+func (m *UnbondingEntries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovNodes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ValidatorSigningInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1384,6 +1655,300 @@ func (m *LegacyProtoValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputAddress = append(m.OutputAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OutputAddress == nil {
+				m.OutputAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingEntries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, UnbondingEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNodes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,7 @@ const (
 	QuerySigningInfos   = "signingInfos"
 	QueryAccountBalance = "account_balance"
 	QueryAccount        = "account"
+	QueryUnbonding      = "unbonding"
 )
 
 type QueryValidatorParams struct {
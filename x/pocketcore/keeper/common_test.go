@@ -49,12 +49,12 @@ var (
 )
 
 func TestMain(m *testing.M) {
-	m.Run()
+	code := m.Run()
 	err := os.RemoveAll("data")
 	if err != nil {
 		panic(err)
 	}
-	os.Exit(0)
+	os.Exit(code)
 }
 
 type simulateRelayKeys struct {
@@ -47,9 +47,7 @@ func TestKeeper_ValidateProof(t *testing.T) { // happy path only todo
 	// generate the pseudorandom proof
 	neededLeafIndex, er := keeper.getPseudorandomIndex(mockCtx, maxRelays, header, mockCtx)
 	assert.Nil(t, er)
-	merkleProofs, _ := evidence.GenerateMerkleProof(0, int(neededLeafIndex), maxRelays)
-	// get leaf and cousin node
-	leafNode := types.GetProof(header, types.RelayEvidence, neededLeafIndex, types.GlobalEvidenceCache)
+	merkleProofs, leafNode := evidence.GenerateMerkleProof(0, int(neededLeafIndex), maxRelays)
 	// create proof message
 	proofMsg := types.MsgProof{
 		MerkleProof:  merkleProofs,
@@ -75,7 +75,7 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk
 		Proof:    relay.Proof,
 	}
 	// sign the response
-	sig, er := node.PrivateKey.Sign(resp.Hash())
+	sig, er := node.Signer.SignRelayResponse(*resp)
 	if er != nil {
 		ctx.Logger().Error(
 			fmt.Sprintf("could not sign response for address: %s with hash: %v, with error: %s",
@@ -50,6 +50,40 @@ func (k Keeper) HandleDispatch(ctx sdk.Ctx, header types.SessionHeader) (*types.
 	}, BlockHeight: ctx.BlockHeight()}, nil
 }
 
+// "CalculateSession" - Regenerates the session of any past session block height from historical state.
+// A session block height of 0 calculates the latest session
+func (k Keeper) CalculateSession(ctx sdk.Ctx, header types.SessionHeader) (*types.SessionCalculation, sdk.Error) {
+	if header.SessionBlockHeight == 0 {
+		header.SessionBlockHeight = k.GetLatestSessionBlockHeight(ctx)
+	}
+	err := header.ValidateHeader()
+	if err != nil {
+		return nil, err
+	}
+	// the session block height must be the first block of a session that already started
+	if header.SessionBlockHeight > ctx.BlockHeight() || (header.SessionBlockHeight-1)%k.posKeeper.BlocksPerSession(ctx) != 0 {
+		return nil, types.NewInvalidBlockHeightError(types.ModuleName)
+	}
+	sessionCtx, er := ctx.PrevCtx(header.SessionBlockHeight)
+	if er != nil {
+		return nil, sdk.ErrInternal(er.Error())
+	}
+	// the candidates are cross checked against the last block of the session, like claims are
+	sessionEndHeight := header.SessionBlockHeight + k.posKeeper.BlocksPerSession(sessionCtx) - 1
+	if sessionEndHeight > ctx.BlockHeight() {
+		sessionEndHeight = ctx.BlockHeight()
+	}
+	sessionEndCtx, er := ctx.PrevCtx(sessionEndHeight)
+	if er != nil {
+		return nil, sdk.ErrInternal(er.Error())
+	}
+	blockHashBz, er := sessionCtx.BlockHash(k.Cdc, sessionCtx.BlockHeight())
+	if er != nil {
+		return nil, sdk.ErrInternal(er.Error())
+	}
+	return types.CalculateSession(sessionCtx, sessionEndCtx, k.posKeeper, header, hex.EncodeToString(blockHashBz), int(k.SessionNodeCount(sessionCtx)))
+}
+
 // "IsSessionBlock" - Returns true if current block, is a session block (beginning of a session)
 func (k Keeper) IsSessionBlock(ctx sdk.Ctx) bool {
 	return ctx.BlockHeight()%k.posKeeper.BlocksPerSession(ctx) == 1
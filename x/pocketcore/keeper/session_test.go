@@ -60,3 +60,43 @@ func TestKeeper_IsPocketSupportedBlockchain(t *testing.T) {
 	assert.True(t, keeper.IsPocketSupportedBlockchain(ctx, "ethereum"))
 	assert.False(t, keeper.IsPocketSupportedBlockchain(ctx, notSB))
 }
+
+func TestKeeper_CalculateSession(t *testing.T) {
+	ctx, _, _, _, keeper, keys, _ := createTestInput(t, false)
+	appPubKey := getRandomPrivateKey().PublicKey().RawString()
+	ethereum := hex.EncodeToString([]byte{01})
+	sessionBlockHeight := keeper.GetLatestSessionBlockHeight(ctx)
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("PrevCtx", sessionBlockHeight).Return(ctx, nil)
+	mockCtx.On("PrevCtx", ctx.BlockHeight()).Return(ctx, nil)
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	// the latest session by default
+	res, err := keeper.CalculateSession(mockCtx, types.SessionHeader{ApplicationPubKey: appPubKey, Chain: ethereum})
+	assert.Nil(t, err)
+	assert.Empty(t, res.Error)
+	assert.Equal(t, sessionBlockHeight, res.SessionHeader.SessionBlockHeight)
+	assert.Len(t, res.SessionNodes, 5)
+	selected := 0
+	for _, c := range res.Candidates {
+		if c.Selected {
+			assert.Equal(t, res.SessionNodes[selected], c.Address)
+			selected++
+		}
+	}
+	assert.Equal(t, 5, selected)
+	// matches the dispatched session
+	header := res.SessionHeader
+	dispatch, err := keeper.HandleDispatch(mockCtx, header)
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(dispatch.Session.SessionKey), res.SessionKey)
+	// not a session block
+	_, err = keeper.CalculateSession(mockCtx, types.SessionHeader{ApplicationPubKey: appPubKey, Chain: ethereum, SessionBlockHeight: sessionBlockHeight + 1})
+	assert.NotNil(t, err)
+	// in the future
+	_, err = keeper.CalculateSession(mockCtx, types.SessionHeader{ApplicationPubKey: appPubKey, Chain: ethereum, SessionBlockHeight: ctx.BlockHeight() + 1})
+	assert.NotNil(t, err)
+}
@@ -116,7 +116,17 @@ func ActivateAdditionalParameters(ctx sdk.Ctx, am AppModule) {
 func (am AppModule) EndBlock(ctx sdk.Ctx, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// get blocks per session
 	blocksPerSession := am.keeper.BlocksPerSession(ctx)
-
+	// the servicers sending their claims and proofs at this block
+	var nodes []*types.PocketNode
+	for _, node := range types.GlobalPocketNodes {
+		address := node.GetAddress()
+		if (ctx.BlockHeight()+int64(address[0]))%blocksPerSession == 1 && ctx.BlockHeight() != 1 {
+			nodes = append(nodes, node)
+		}
+	}
+	if len(nodes) == 0 {
+		return []abci.ValidatorUpdate{}
+	}
 	// run go routine because cannot access TmNode during end-block period
 	go func() {
 		// use this sleep timer to bypass the beginBlock lock over transactions
@@ -137,15 +147,33 @@ func (am AppModule) EndBlock(ctx sdk.Ctx, _ abci.RequestEndBlock) []abci.Validat
 			return
 		}
 
-		for _, node := range types.GlobalPocketNodes {
-			address := node.GetAddress()
-			if (ctx.BlockHeight()+int64(address[0]))%blocksPerSession == 1 && ctx.BlockHeight() != 1 {
-				// auto send the proofs
-				am.keeper.SendClaimTx(ctx, am.keeper, am.keeper.TmNode, node, ClaimTx)
-				// auto claim the proofs
-				am.keeper.SendProofTx(ctx, am.keeper.TmNode, node, ProofTx)
-				// clear session cache and db
-				types.ClearSessionCache(node.SessionStore)
+		// the claims and proofs are computed and sent by the scheduler workers, with the committed state of this block:
+		// the end block context is the live state of the next blocks by the time a job runs
+		scheduler := types.GlobalClaimScheduler()
+		height := ctx.BlockHeight()
+		for _, node := range nodes {
+			node := node
+			scheduled := scheduler.Schedule(types.ClaimJob{
+				Address: node.GetAddress(),
+				Height:  height,
+				Run: func() {
+					// without a header, the context is loaded from the committed version of the height
+					jobCtx, err := ctx.WithBlockHeader(abci.Header{}).PrevCtx(height)
+					if err != nil {
+						ctx.Logger().Error(fmt.Sprintf("could not load the committed state at height %d (cannot submit claims/proofs for %s): %s", height, node.GetAddress(), err.Error()))
+						return
+					}
+					// auto send the proofs
+					am.keeper.SendClaimTx(jobCtx, am.keeper, am.keeper.TmNode, node, ClaimTx)
+					// auto claim the proofs
+					am.keeper.SendProofTx(jobCtx, am.keeper.TmNode, node, ProofTx)
+					// clear session cache and db
+					types.ClearSessionCache(node.SessionStore)
+				},
+			})
+			if !scheduled {
+				ctx.Logger().Info("the claim scheduler is stopped (cannot submit claims/proofs while shutting down)")
+				return
 			}
 		}
 	}()
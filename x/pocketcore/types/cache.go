@@ -1,15 +1,15 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"github.com/tendermint/tendermint/config"
 	"log"
-	"path/filepath"
 	"sync"
-	"syscall"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	db "github.com/tendermint/tm-db"
 	"github.com/willf/bloom"
@@ -20,12 +20,17 @@ var (
 	ConfigOnce sync.Once
 )
 
+const QuarantineDBSuffix = "_quarantine"
+
 // "CacheStorage" - Contains an LRU cache and a database instance w/ mutex
 type CacheStorage struct {
-	Cache   *sdk.Cache // lru cache
-	DB      db.DB      // persisted
-	l       sync.Mutex // lock
-	SealMap *sync.Map
+	Cache      *sdk.Cache   // lru cache
+	DB         EvidenceDB   // persisted
+	Codec      *RecordCodec // checksum or encryption of the persisted records
+	Quarantine EvidenceDB   // corrupt records moved out of the persisted db
+	l          sync.Mutex   // lock
+	SealMap    *sync.Map
+	name       string
 }
 
 type CacheObject interface {
@@ -36,27 +41,108 @@ type CacheObject interface {
 	HashString() string
 }
 
-// "Init" - Initializes a cache storage object
-func (cs *CacheStorage) Init(dir, name string, options config.LevelDBOptions, maxEntries int, inMemoryDB bool) {
+// "Init" - Initializes a cache storage object with one of the evidence db backends
+func (cs *CacheStorage) Init(dir, name string, backend string, options config.LevelDBOptions, maxEntries int) {
 	// init the lru cache with a max entries
 	cs.Cache = sdk.NewCache(maxEntries)
+	cs.name = name
+	// integrity only, unless an encryption key is set
+	cs.Codec = &RecordCodec{}
 	// intialize the db
 	var err error
-	if inMemoryDB {
-		cs.DB = db.NewGoLevelMemDBWithCapacity(maxEntries)
-		return
+	cs.DB, err = NewEvidenceDB(backend, name, dir, options, maxEntries)
+	if err != nil {
+		panic(err)
 	}
-	cs.DB, err = sdk.NewLevelDB(name, dir, options.ToGoLevelDBOpts())
+	cs.Quarantine, err = NewEvidenceDB(backend, name+QuarantineDBSuffix, dir, options, 0)
 	if err != nil {
-		if err == syscall.EWOULDBLOCK {
-			message := fmt.Sprintf("can't open files needed for execution. Another instance may be running. path: %s\n", filepath.Join(dir, name+".db"))
-			panic(errors.New(message))
-		}
 		panic(err)
 	}
 	cs.SealMap = &sync.Map{}
 }
 
+// "SetEncryptionKey" - Encrypts the records persisted from now on, MigrateRecords seals the records already on disk
+func (cs *CacheStorage) SetEncryptionKey(key []byte) error {
+	codec, err := NewRecordCodec(key)
+	if err != nil {
+		return err
+	}
+	cs.l.Lock()
+	defer cs.l.Unlock()
+	cs.Codec = codec
+	return nil
+}
+
+// "SetSyncInterval" - Groups the syncs to disk of the writes made in the interval, for the backends that sync
+// every write
+func (cs *CacheStorage) SetSyncInterval(interval time.Duration) {
+	for _, evidenceDB := range []EvidenceDB{cs.DB, cs.Quarantine} {
+		if ldb, ok := evidenceDB.(*LogDB); ok {
+			ldb.SetSyncInterval(interval)
+		}
+	}
+}
+
+// "MigrateRecords" - Rewrites the records of the db in the current format once: the records written before the
+// record format the first time, and the plaintext records once encryption is enabled. The version migrated to is
+// kept in the db, so a record that loses its header afterwards is rejected as corrupt instead of being migrated.
+// Returns an error for encrypted records without an encryption key
+func (cs *CacheStorage) MigrateRecords() error {
+	cs.l.Lock()
+	defer cs.l.Unlock()
+	version := cs.Codec.version()
+	marker, err := cs.DB.Get(recordFormatKey)
+	if err != nil {
+		return err
+	}
+	if len(marker) == 1 && marker[0] == recordVersionSealed && version != recordVersionSealed {
+		// the sealed records can't be read back without the key, they would all be quarantined
+		return fmt.Errorf("the records are encrypted, the evidence encryption must be enabled to read them")
+	}
+	if len(marker) == 1 && marker[0] == version {
+		return nil
+	}
+	headerless := len(marker) == 0
+	it, err := cs.DB.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	// collected first, as not every backend allows writes while iterating
+	var keys, values [][]byte
+	for ; it.Valid(); it.Next() {
+		key, record := it.Key(), it.Value()
+		var value []byte
+		switch {
+		case bytes.Equal(key, recordFormatKey):
+			continue
+		case len(record) < recordHeaderLength || record[0] != recordPrefix:
+			if !headerless {
+				continue
+			}
+			value = record
+		case record[1] == recordVersionChecksum && version == recordVersionSealed:
+			if value, err = (&RecordCodec{}).Decode(key, record); err != nil {
+				continue // quarantined once read
+			}
+		default:
+			continue
+		}
+		keys = append(keys, append([]byte{}, key...))
+		values = append(values, append([]byte{}, value...))
+	}
+	it.Close()
+	for i, key := range keys {
+		record, err := cs.Codec.Encode(key, values[i])
+		if err != nil {
+			return err
+		}
+		if err := cs.DB.Set(key, record); err != nil {
+			return err
+		}
+	}
+	return cs.DB.Set(recordFormatKey, []byte{version})
+}
+
 // "Get" - Returns the value from a key
 func (cs *CacheStorage) Get(key []byte, object CacheObject) (interface{}, bool) {
 	cs.l.Lock()
@@ -70,14 +156,19 @@ func (cs *CacheStorage) GetWithoutLock(key []byte, object CacheObject) (interfac
 		return res, true
 	}
 	// not in cache, so search database
-	bz, _ := cs.DB.Get(key)
-	if len(bz) == 0 {
+	record, _ := cs.DB.Get(key)
+	if len(record) == 0 {
+		return nil, false
+	}
+	bz, err := cs.Codec.Decode(key, record)
+	if err != nil {
+		cs.quarantine(key, record, err)
 		return nil, false
 	}
 	res, err := object.UnmarshalObject(bz)
 	if err != nil {
-		fmt.Printf("Error in CacheStorage.Get(): %s\n", err.Error())
-		return nil, true
+		cs.quarantine(key, record, err)
+		return nil, false
 	}
 	// add to cache
 	cs.Cache.Add(hex.EncodeToString(key), res)
@@ -208,8 +299,12 @@ func (cs *CacheStorage) FlushToDBWithoutLock() error {
 		if err != nil {
 			return fmt.Errorf("error flushing database, couldn't hex decode key: %s", err.Error())
 		}
+		record, err := cs.Codec.Encode(kBz, bz)
+		if err != nil {
+			return fmt.Errorf("error flushing database, encoding record: %s", err.Error())
+		}
 		// set to DB
-		_ = cs.DB.Set(kBz, bz)
+		_ = cs.DB.Set(kBz, record)
 	}
 	return nil
 }
@@ -224,18 +319,87 @@ func (cs *CacheStorage) Clear() {
 	iter, _ := cs.DB.Iterator(nil, nil)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
+		if bytes.Equal(iter.Key(), recordFormatKey) {
+			continue
+		}
 		_ = cs.DB.Delete(iter.Key())
 	}
 }
 
-// "Iterator" - Returns an iterator for all of the items in the stores
+// "Iterator" - Returns an iterator for all of the items in the stores, the values are the verified (and decrypted)
+// marshalled objects and corrupt records are quarantined and skipped
 func (cs *CacheStorage) Iterator() (db.Iterator, error) {
 	err := cs.FlushToDB()
 	if err != nil {
 		fmt.Printf("unable to flush to db before iterator created in cacheStorage Iterator(): %s", err.Error())
 	}
+	it, err := cs.DB.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	ri := &recordIterator{Iterator: it, cs: cs}
+	ri.skipCorrupt()
+	return ri, nil
+}
 
-	return cs.DB.Iterator(nil, nil)
+// "recordIterator" - Decodes the records of the underlying db iterator
+type recordIterator struct {
+	db.Iterator
+	cs    *CacheStorage
+	value []byte
+}
+
+func (ri *recordIterator) Next() {
+	ri.Iterator.Next()
+	ri.skipCorrupt()
+}
+
+func (ri *recordIterator) Value() []byte {
+	return ri.value
+}
+
+func (ri *recordIterator) skipCorrupt() {
+	for ; ri.Iterator.Valid(); ri.Iterator.Next() {
+		if bytes.Equal(ri.Iterator.Key(), recordFormatKey) {
+			continue
+		}
+		value, err := ri.cs.Codec.Decode(ri.Iterator.Key(), ri.Iterator.Value())
+		if err == nil {
+			ri.value = value
+			return
+		}
+		ri.cs.quarantine(ri.Iterator.Key(), ri.Iterator.Value(), err)
+	}
+	ri.value = nil
+}
+
+// "quarantine" - Moves a corrupt record out of the persisted db so it is no longer used for claims and proofs,
+// and reports it through the service metrics
+func (cs *CacheStorage) quarantine(key, record []byte, err error) {
+	fmt.Printf("ERROR: quarantining corrupt record %X in the %s cache storage: %s\n", key, cs.Name(), err.Error())
+	if cs.Quarantine != nil {
+		if err := cs.Quarantine.Set(key, record); err != nil {
+			fmt.Printf("ERROR: unable to quarantine record %X: %s\n", key, err.Error())
+			return
+		}
+	}
+	_ = cs.DB.Delete(key)
+	if m := GlobalServiceMetric(); m != nil {
+		m.AddCorruptRecordFor(cs.Name())
+	}
+}
+
+// "Name" - Returns the name of the cache storage
+func (cs *CacheStorage) Name() string {
+	if cs.name == "" {
+		return "session"
+	}
+	return cs.name
+}
+
+// "QuarantineIterator" - Returns an iterator of the raw corrupt records moved out of the storage
+func (cs *CacheStorage) QuarantineIterator() (db.Iterator, error) {
+	return cs.Quarantine.Iterator(nil, nil)
 }
 
 // "GetSession" - Returns a session (value) from the stores using a header (key)
@@ -362,6 +526,7 @@ func SetEvidence(evidence Evidence, evidenceStore *CacheStorage) {
 
 // "SealEvidence" - Locks/sets the evidence from the stores
 func SealEvidence(evidence Evidence, storage *CacheStorage) (Evidence, bool) {
+	evidence.sealed = true
 	co, ok := storage.Seal(evidence)
 	if !ok {
 		return Evidence{}, ok
@@ -405,6 +570,130 @@ func EvidenceIterator(evidenceStore *CacheStorage) EvidenceIt {
 	}
 }
 
+// "EvidenceStoreStats" - The evidence held in an evidence store
+type EvidenceStoreStats struct {
+	Evidences  int64 `json:"evidences"`  // the pieces of evidence, one per session and evidence type
+	Sealed     int64 `json:"sealed"`     // the evidence no longer written, claimed or at the max relays of the session
+	Relays     int64 `json:"relays"`     // the relay proofs
+	Challenges int64 `json:"challenges"` // the challenge proofs
+}
+
+// the numbers of the ProtoEvidence fields read by evidenceRecordSummary, from ProtoEvidence in
+// proto/x/pocketcore/pocket.proto
+const (
+	protoEvidenceSessionHeaderField = 2
+	protoEvidenceNumOfProofsField   = 3
+	protoEvidenceTypeField          = 5
+)
+
+// "GetEvidenceStoreStats" - Counts the evidence and proofs of the store, without flushing the cache to the db. Only
+// the header, the proof count and the type of the persisted records are decoded, not the bloom filters and proofs.
+// The store is locked while counting, so the cache and the records are counted at the same state
+func GetEvidenceStoreStats(evidenceStore *CacheStorage) (stats EvidenceStoreStats) {
+	count := func(header SessionHeader, numOfProofs int64, evidenceType EvidenceType) {
+		stats.Evidences++
+		if _, sealed := evidenceStore.SealMap.Load(header.HashString()); sealed {
+			stats.Sealed++
+		}
+		switch evidenceType {
+		case RelayEvidence:
+			stats.Relays += numOfProofs
+		case ChallengeEvidence:
+			stats.Challenges += numOfProofs
+		}
+	}
+	// the cached evidence is newer than its record
+	cached := make(map[string]struct{})
+	evidenceStore.l.Lock()
+	defer evidenceStore.l.Unlock()
+	for _, k := range evidenceStore.Cache.Keys() {
+		key, _ := k.(string)
+		v, ok := evidenceStore.Cache.Peek(key)
+		if !ok {
+			continue
+		}
+		if evidence, ok := v.(Evidence); ok {
+			cached[key] = struct{}{}
+			count(evidence.SessionHeader, evidence.NumOfProofs, evidence.EvidenceType)
+		}
+	}
+	it, err := evidenceStore.DB.Iterator(nil, nil)
+	if err != nil {
+		return
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if bytes.Equal(it.Key(), recordFormatKey) {
+			continue
+		}
+		if _, ok := cached[hex.EncodeToString(it.Key())]; ok {
+			continue
+		}
+		// the corrupt records are quarantined when they are read, not while counting
+		bz, err := evidenceStore.Codec.Decode(it.Key(), it.Value())
+		if err != nil {
+			continue
+		}
+		header, numOfProofs, evidenceType, err := evidenceRecordSummary(bz)
+		if err != nil {
+			continue
+		}
+		count(header, numOfProofs, evidenceType)
+	}
+	return
+}
+
+// "evidenceRecordSummary" - Reads the header, the proof count and the type of a marshalled ProtoEvidence, skipping
+// the bloom filter, the proofs and the merkle leaves
+func evidenceRecordSummary(bz []byte) (header SessionHeader, numOfProofs int64, evidenceType EvidenceType, err error) {
+	for len(bz) > 0 {
+		tag, n := proto.DecodeVarint(bz)
+		if n == 0 {
+			return header, 0, 0, fmt.Errorf("invalid evidence record tag")
+		}
+		bz = bz[n:]
+		field, wireType := tag>>3, tag&7
+		switch wireType {
+		case proto.WireVarint:
+			v, n := proto.DecodeVarint(bz)
+			if n == 0 {
+				return header, 0, 0, fmt.Errorf("invalid evidence record field %d", field)
+			}
+			bz = bz[n:]
+			switch field {
+			case protoEvidenceNumOfProofsField:
+				numOfProofs = int64(v)
+			case protoEvidenceTypeField:
+				evidenceType = EvidenceType(int32(v))
+			}
+		case proto.WireBytes:
+			l, n := proto.DecodeVarint(bz)
+			if n == 0 || l > uint64(len(bz)-n) {
+				return header, 0, 0, fmt.Errorf("invalid evidence record field %d", field)
+			}
+			value := bz[n : n+int(l)]
+			bz = bz[n+int(l):]
+			if field == protoEvidenceSessionHeaderField {
+				if err = header.Unmarshal(value); err != nil {
+					return header, 0, 0, err
+				}
+			}
+		case proto.WireFixed64, proto.WireFixed32:
+			size := 8
+			if wireType == proto.WireFixed32 {
+				size = 4
+			}
+			if len(bz) < size {
+				return header, 0, 0, fmt.Errorf("invalid evidence record field %d", field)
+			}
+			bz = bz[size:]
+		default:
+			return header, 0, 0, fmt.Errorf("invalid wire type %d of evidence record field %d", wireType, field)
+		}
+	}
+	return
+}
+
 // "GetProof" - Returns the Proof object from a specific piece of GOBEvidence at a certain index
 func GetProof(header SessionHeader, evidenceType EvidenceType, index int64, evidenceStore *CacheStorage) Proof {
 	// retrieve the GOBEvidence
@@ -428,6 +717,10 @@ func SetProof(header SessionHeader, evidenceType EvidenceType, p Proof, max sdk.
 	if err != nil {
 		log.Fatalf("could not set proof object: %s", err.Error())
 	}
+	// sealed evidence is not written anymore, don't update its merkle leaves
+	if evidenceStore.IsSealed(evidence) {
+		return
+	}
 	// add proof
 	evidence.AddProof(p)
 	// set GOBEvidence back
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	"github.com/pokt-network/pocket-core/types"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// a leading zero byte is never a valid amino or protobuf field key, so records written before the
+	// record format are told apart and migrated once
+	recordPrefix          byte = 0x00
+	recordVersionChecksum byte = 0x01
+	recordVersionSealed   byte = 0x02
+	recordHeaderLength         = 2
+	recordKeyLength            = 32
+	recordKeyInfo              = "pocket-core evidence encryption"
+)
+
+var (
+	// recordFormatKey holds the record version the db was last migrated to, it is shorter than any record key
+	recordFormatKey = []byte("\x00record-format")
+
+	ErrCorruptRecord     = errors.New("the cache record failed the integrity check")
+	ErrPlaintextRecord   = errors.New("the cache record is not encrypted while encryption is enabled")
+	ErrEncryptedRecord   = errors.New("the cache record is encrypted and no encryption key is configured")
+	ErrUnsupportedRecord = errors.New("unsupported cache record version")
+)
+
+// "RecordCodec" - Wraps the cache objects persisted to the database with a checksum, or with authenticated
+// encryption when an encryption key is provided. The record key is bound to the record, so a record moved under
+// another key fails the check as well
+type RecordCodec struct {
+	aead cipher.AEAD
+}
+
+// "NewRecordCodec" - Returns a record codec, a nil key only protects the integrity of the records
+func NewRecordCodec(encryptionKey []byte) (*RecordCodec, error) {
+	if encryptionKey == nil {
+		return &RecordCodec{}, nil
+	}
+	if len(encryptionKey) != recordKeyLength {
+		return nil, fmt.Errorf("invalid evidence encryption key length: expected %d bytes, got %d", recordKeyLength, len(encryptionKey))
+	}
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &RecordCodec{aead: aead}, nil
+}
+
+// "IsEncrypted" - Returns true if the records are encrypted at rest
+func (rc *RecordCodec) IsEncrypted() bool {
+	return rc != nil && rc.aead != nil
+}
+
+// "Encode" - Returns the record persisted for the key and the marshalled cache object
+func (rc *RecordCodec) Encode(key, value []byte) ([]byte, error) {
+	if !rc.IsEncrypted() {
+		checksum := recordChecksum(key, value)
+		record := make([]byte, 0, recordHeaderLength+len(checksum)+len(value))
+		record = append(record, recordPrefix, recordVersionChecksum)
+		record = append(record, checksum...)
+		return append(record, value...), nil
+	}
+	nonce := make([]byte, rc.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	record := make([]byte, 0, recordHeaderLength+len(nonce)+len(value)+rc.aead.Overhead())
+	record = append(record, recordPrefix, recordVersionSealed)
+	record = append(record, nonce...)
+	return rc.aead.Seal(record, nonce, value, key), nil
+}
+
+// "Decode" - Verifies the record persisted for the key and returns the marshalled cache object. A record without
+// the header is corrupt, as the records written before the record format are migrated when the storage starts
+func (rc *RecordCodec) Decode(key, record []byte) ([]byte, error) {
+	if len(record) < recordHeaderLength || record[0] != recordPrefix {
+		return nil, ErrCorruptRecord
+	}
+	body := record[recordHeaderLength:]
+	switch record[1] {
+	case recordVersionChecksum:
+		if rc.IsEncrypted() {
+			// the plaintext records are sealed when encryption is enabled
+			return nil, ErrPlaintextRecord
+		}
+		if len(body) < sha256.Size {
+			return nil, ErrCorruptRecord
+		}
+		checksum, value := body[:sha256.Size], body[sha256.Size:]
+		if !bytes.Equal(checksum, recordChecksum(key, value)) {
+			return nil, ErrCorruptRecord
+		}
+		return value, nil
+	case recordVersionSealed:
+		if !rc.IsEncrypted() {
+			return nil, ErrEncryptedRecord
+		}
+		if len(body) < rc.aead.NonceSize() {
+			return nil, ErrCorruptRecord
+		}
+		nonce, ciphertext := body[:rc.aead.NonceSize()], body[rc.aead.NonceSize():]
+		value, err := rc.aead.Open(nil, nonce, ciphertext, key)
+		if err != nil {
+			return nil, ErrCorruptRecord
+		}
+		return value, nil
+	default:
+		return nil, ErrUnsupportedRecord
+	}
+}
+
+// "version" - Returns the version of the records encoded
+func (rc *RecordCodec) version() byte {
+	if rc.IsEncrypted() {
+		return recordVersionSealed
+	}
+	return recordVersionChecksum
+}
+
+func recordChecksum(key, value []byte) []byte {
+	h := sha256.New()
+	h.Write(key)
+	h.Write(value)
+	return h.Sum(nil)
+}
+
+// "EvidenceEncryptionKey" - Returns the key used to encrypt the evidence and session records of a node.
+// The key is read from the configured key file (generated on first use), or derived from the node's private key
+func EvidenceEncryptionKey(c types.PocketConfig, pk crypto.PrivateKey) ([]byte, error) {
+	if c.EvidenceEncryptionKeyFile == "" {
+		if pk == nil {
+			return nil, errors.New("cannot derive the evidence encryption key without a private key")
+		}
+		key := make([]byte, recordKeyLength)
+		_, err := io.ReadFull(hkdf.New(sha256.New, pk.RawBytes(), nil, []byte(recordKeyInfo)), key)
+		return key, err
+	}
+	path := c.EvidenceEncryptionKeyFile
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.DataDir, path)
+	}
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		key := make([]byte, recordKeyLength)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(path, []byte(hex.EncodeToString(key)), 0600); err != nil {
+			return nil, fmt.Errorf("unable to write the evidence encryption key file %s: %s", path, err.Error())
+		}
+		return key, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the evidence encryption key file %s: %s", path, err.Error())
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, fmt.Errorf("the evidence encryption key file %s is not hex encoded: %s", path, err.Error())
+	}
+	if len(key) != recordKeyLength {
+		return nil, fmt.Errorf("invalid evidence encryption key length in %s: expected %d bytes, got %d", path, recordKeyLength, len(key))
+	}
+	return key, nil
+}
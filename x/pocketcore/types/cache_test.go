@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"github.com/gogo/protobuf/proto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/libs/log"
@@ -22,12 +23,12 @@ func InitCacheTest() {
 
 func TestMain(m *testing.M) {
 	InitCacheTest()
-	m.Run()
+	code := m.Run()
 	err := os.RemoveAll("data")
 	if err != nil {
 		panic(err)
 	}
-	os.Exit(0)
+	os.Exit(code)
 }
 
 func TestIsUniqueProof(t *testing.T) {
@@ -115,6 +116,63 @@ func TestAllEvidence_Iterator(t *testing.T) {
 	assert.Equal(t, 1, int(count))
 }
 
+func TestAllEvidence_Stats(t *testing.T) {
+	ClearEvidence(GlobalEvidenceCache)
+	appPubKey := getRandomPubKey().RawString()
+	ethereum := hex.EncodeToString([]byte{0001})
+	header := SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              ethereum,
+		SessionBlockHeight: 1,
+	}
+	for i := int64(0); i < 3; i++ {
+		SetProof(header, RelayEvidence, RelayProof{Entropy: i, SessionBlockHeight: 1, Blockchain: ethereum}, sdk.NewInt(100000), GlobalEvidenceCache)
+	}
+	header2 := header
+	header2.SessionBlockHeight = 101
+	SetProof(header2, RelayEvidence, RelayProof{Entropy: 1, SessionBlockHeight: 101, Blockchain: ethereum}, sdk.NewInt(100000), GlobalEvidenceCache)
+	evidence, err := GetEvidence(header2, RelayEvidence, sdk.ZeroInt(), GlobalEvidenceCache)
+	assert.Nil(t, err)
+	_, ok := SealEvidence(evidence, GlobalEvidenceCache)
+	assert.True(t, ok)
+	// counted from the cache, without flushing it
+	assert.Equal(t, EvidenceStoreStats{Evidences: 2, Sealed: 1, Relays: 4}, GetEvidenceStoreStats(GlobalEvidenceCache))
+	assert.Equal(t, 2, GlobalEvidenceCache.Cache.Len())
+	// and from the records
+	assert.Nil(t, GlobalEvidenceCache.FlushToDB())
+	assert.Equal(t, EvidenceStoreStats{Evidences: 2, Sealed: 1, Relays: 4}, GetEvidenceStoreStats(GlobalEvidenceCache))
+	SetProof(header, RelayEvidence, RelayProof{Entropy: 3, SessionBlockHeight: 1, Blockchain: ethereum}, sdk.NewInt(100000), GlobalEvidenceCache)
+	assert.Equal(t, EvidenceStoreStats{Evidences: 2, Sealed: 1, Relays: 5}, GetEvidenceStoreStats(GlobalEvidenceCache))
+	ClearEvidence(GlobalEvidenceCache)
+	assert.Equal(t, EvidenceStoreStats{}, GetEvidenceStoreStats(GlobalEvidenceCache))
+}
+
+func TestEvidenceRecordSummary(t *testing.T) {
+	// the field numbers follow the generated ProtoEvidence
+	fields := make(map[string]int)
+	for _, prop := range proto.GetProperties(reflect.TypeOf(ProtoEvidence{})).Prop {
+		fields[prop.OrigName] = prop.Tag
+	}
+	assert.Equal(t, protoEvidenceSessionHeaderField, fields["sessionHeader"])
+	assert.Equal(t, protoEvidenceNumOfProofsField, fields["numOfProofs"])
+	assert.Equal(t, protoEvidenceTypeField, fields["evidenceType"])
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	bz, err := (&ProtoEvidence{
+		BloomBytes:    []byte("bloom"),
+		SessionHeader: &header,
+		NumOfProofs:   7,
+		EvidenceType:  ChallengeEvidence,
+	}).Marshal()
+	assert.Nil(t, err)
+	h, numOfProofs, evidenceType, err := evidenceRecordSummary(bz)
+	assert.Nil(t, err)
+	assert.Equal(t, header, h)
+	assert.Equal(t, int64(7), numOfProofs)
+	assert.Equal(t, ChallengeEvidence, evidenceType)
+	_, _, _, err = evidenceRecordSummary(bz[:len(bz)-1])
+	assert.NotNil(t, err)
+}
+
 func TestAllEvidence_DeleteEvidence(t *testing.T) {
 	appPubKey := getRandomPubKey().RawString()
 	servicerPubKey := getRandomPubKey().RawString()
@@ -196,6 +254,119 @@ func TestAllEvidence_GetTotalProofs(t *testing.T) {
 	assert.Equal(t, totalRelays, int64(2))
 }
 
+func TestAllEvidence_QuarantineCorruptRecord(t *testing.T) {
+	ClearEvidence(GlobalEvidenceCache)
+	appPubKey := getRandomPubKey().RawString()
+	ethereum := hex.EncodeToString([]byte{0001})
+	header := SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              ethereum,
+		SessionBlockHeight: 1,
+	}
+	proof := RelayProof{
+		Entropy:            0,
+		RequestHash:        header.HashString(), // fake
+		SessionBlockHeight: 1,
+		ServicerPubKey:     getRandomPubKey().RawString(),
+		Blockchain:         ethereum,
+		Token: AAT{
+			Version:              "0.0.1",
+			ApplicationPublicKey: appPubKey,
+			ClientPublicKey:      getRandomPubKey().RawString(),
+			ApplicationSignature: "",
+		},
+		Signature: "",
+	}
+	SetProof(header, RelayEvidence, proof, sdk.NewInt(100000), GlobalEvidenceCache)
+	assert.Nil(t, GlobalEvidenceCache.FlushToDB())
+	key, err := KeyForEvidence(header, RelayEvidence)
+	assert.Nil(t, err)
+	// flip a byte of the persisted record
+	record, err := GlobalEvidenceCache.DB.Get(key)
+	assert.Nil(t, err)
+	record[len(record)-1] ^= 0xff
+	assert.Nil(t, GlobalEvidenceCache.DB.Set(key, record))
+	// the corrupt record is not used and is moved to the quarantine
+	e, err := GetEvidence(header, RelayEvidence, sdk.NewInt(100000), GlobalEvidenceCache)
+	assert.Nil(t, err)
+	assert.Zero(t, e.NumOfProofs)
+	has, err := GlobalEvidenceCache.DB.Has(key)
+	assert.Nil(t, err)
+	assert.False(t, has)
+	quarantined, err := GlobalEvidenceCache.Quarantine.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, record, quarantined)
+}
+
+func TestAllEvidence_Encryption(t *testing.T) {
+	storage := &CacheStorage{}
+	storage.Init("", "", EvidenceDBBackendMemory, sdk.DefaultTestingPocketConfig().TendermintConfig.LevelDBOptions, 1)
+	key, err := EvidenceEncryptionKey(sdk.PocketConfig{}, GetRandomPrivateKey())
+	assert.Nil(t, err)
+	assert.Nil(t, storage.SetEncryptionKey(key))
+	session := NewTestSession(t, hex.EncodeToString(Hash([]byte("foo"))))
+	SetSession(session, storage)
+	assert.Nil(t, storage.FlushToDB())
+	record, err := storage.DB.Get(session.SessionHeader.Hash())
+	assert.Nil(t, err)
+	assert.NotContains(t, string(record), session.SessionHeader.ApplicationPubKey)
+	s, found := GetSession(session.SessionHeader, storage)
+	assert.True(t, found)
+	assert.Equal(t, session, s)
+	// without the key the record can't be read
+	_, err = (&RecordCodec{}).Decode(session.SessionHeader.Hash(), record)
+	assert.Equal(t, ErrEncryptedRecord, err)
+	// the record is bound to its key
+	_, err = storage.Codec.Decode(Hash([]byte("bar")), record)
+	assert.Equal(t, ErrCorruptRecord, err)
+}
+
+func TestCacheStorage_MigrateRecords(t *testing.T) {
+	storage := &CacheStorage{}
+	storage.Init("", "", EvidenceDBBackendMemory, sdk.DefaultTestingPocketConfig().TendermintConfig.LevelDBOptions, 1)
+	session := NewTestSession(t, hex.EncodeToString(Hash([]byte("foo"))))
+	bz, err := session.MarshalObject()
+	assert.Nil(t, err)
+	// written before the record format
+	assert.Nil(t, storage.DB.Set(session.SessionHeader.Hash(), bz))
+	_, err = storage.Codec.Decode(session.SessionHeader.Hash(), bz)
+	assert.Equal(t, ErrCorruptRecord, err)
+	assert.Nil(t, storage.MigrateRecords())
+	s, found := GetSession(session.SessionHeader, storage)
+	assert.True(t, found)
+	assert.Equal(t, session, s)
+	// once migrated, a record without the header is rejected
+	session2 := NewTestSession(t, hex.EncodeToString(Hash([]byte("bar"))))
+	bz2, err := session2.MarshalObject()
+	assert.Nil(t, err)
+	assert.Nil(t, storage.DB.Set(session2.SessionHeader.Hash(), bz2))
+	assert.Nil(t, storage.MigrateRecords())
+	_, found = GetSession(session2.SessionHeader, storage)
+	assert.False(t, found)
+	// the plaintext records are sealed once encryption is enabled, then rejected without the header
+	key, err := EvidenceEncryptionKey(sdk.PocketConfig{}, GetRandomPrivateKey())
+	assert.Nil(t, err)
+	assert.Nil(t, storage.SetEncryptionKey(key))
+	record, err := storage.DB.Get(session.SessionHeader.Hash())
+	assert.Nil(t, err)
+	_, err = storage.Codec.Decode(session.SessionHeader.Hash(), record)
+	assert.Equal(t, ErrPlaintextRecord, err)
+	assert.Nil(t, storage.MigrateRecords())
+	record, err = storage.DB.Get(session.SessionHeader.Hash())
+	assert.Nil(t, err)
+	assert.NotContains(t, string(record), session.SessionHeader.ApplicationPubKey)
+	storage.Cache.Purge()
+	s, found = GetSession(session.SessionHeader, storage)
+	assert.True(t, found)
+	assert.Equal(t, session, s)
+	// the encrypted records are not given up once encryption is disabled
+	storage.Codec = &RecordCodec{}
+	assert.NotNil(t, storage.MigrateRecords())
+	marker, err := storage.DB.Get(recordFormatKey)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{recordVersionSealed}, marker)
+}
+
 func TestSetGetSession(t *testing.T) {
 	session := NewTestSession(t, hex.EncodeToString(Hash([]byte("foo"))))
 	session2 := NewTestSession(t, hex.EncodeToString(Hash([]byte("bar"))))
@@ -249,3 +420,53 @@ func NewTestSession(t *testing.T, chain string) Session {
 		SessionNodes: vals,
 	}
 }
+
+func BenchmarkCacheStorage_LevelDB(b *testing.B) {
+	benchmarkCacheStorage(b, EvidenceDBBackendLevelDB)
+}
+
+func BenchmarkCacheStorage_LogDB(b *testing.B) {
+	benchmarkCacheStorage(b, EvidenceDBBackendLog)
+}
+
+func BenchmarkCacheStorage_MemDB(b *testing.B) {
+	benchmarkCacheStorage(b, EvidenceDBBackendMemory)
+}
+
+// benchmarkCacheStorage sets relay proofs the same way the relays do, with a cache small enough to flush to the db
+func benchmarkCacheStorage(b *testing.B, backend string) {
+	storage := &CacheStorage{}
+	storage.Init(b.TempDir(), "bench_evidence", backend, sdk.DefaultTestingPocketConfig().TendermintConfig.LevelDBOptions, 10)
+	defer storage.DB.Close()
+	defer storage.Quarantine.Close()
+	appPubKey := getRandomPubKey().RawString()
+	servicerPubKey := getRandomPubKey().RawString()
+	clientPubKey := getRandomPubKey().RawString()
+	ethereum := hex.EncodeToString([]byte{0001})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		header := SessionHeader{
+			ApplicationPubKey:  appPubKey,
+			Chain:              ethereum,
+			SessionBlockHeight: int64(i%100) + 1,
+		}
+		proof := RelayProof{
+			Entropy:            int64(i),
+			RequestHash:        header.HashString(), // fake
+			SessionBlockHeight: header.SessionBlockHeight,
+			ServicerPubKey:     servicerPubKey,
+			Blockchain:         ethereum,
+			Token: AAT{
+				Version:              "0.0.1",
+				ApplicationPublicKey: appPubKey,
+				ClientPublicKey:      clientPubKey,
+				ApplicationSignature: "",
+			},
+			Signature: "",
+		}
+		SetProof(header, RelayEvidence, proof, sdk.NewInt(100000), storage)
+	}
+	if err := storage.FlushToDB(); err != nil {
+		b.Fatal(err)
+	}
+}
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// how long the shutdown waits for the running jobs, they are not interrupted
+const claimSchedulerStopTimeout = 10 * time.Second
+
+var (
+	globalClaimScheduler *ClaimScheduler
+	claimSchedulerLock   sync.Mutex
+)
+
+// "ClaimJob" - The claims and proofs of a servicer, scheduled by the block at height and run with its context
+type ClaimJob struct {
+	Address sdk.Address
+	Height  int64
+	Run     func()
+}
+
+// "ClaimScheduler" - Runs the claim and proof jobs of the servicers off the block processing, with at most workers
+// jobs at once. A servicer has at most one job running and one waiting, the newest: the servicers wait for a worker
+// in turn, so one servicer with a lot of evidence doesn't delay the others
+type ClaimScheduler struct {
+	l       sync.Mutex
+	cond    *sync.Cond
+	pending map[string]ClaimJob // the job waiting of each servicer
+	queue   []string            // the servicers waiting, in turn
+	running map[string]bool     // the servicers with a job running
+	stopped bool
+	workers sync.WaitGroup
+	logger  log.Logger
+}
+
+// "NewClaimScheduler" - Starts a scheduler with the workers
+func NewClaimScheduler(workers int, logger log.Logger) *ClaimScheduler {
+	if workers < 1 {
+		workers = 1
+	}
+	s := &ClaimScheduler{
+		pending: make(map[string]ClaimJob),
+		running: make(map[string]bool),
+		logger:  logger,
+	}
+	s.cond = sync.NewCond(&s.l)
+	s.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go s.work()
+	}
+	return s
+}
+
+// "Schedule" - Queues the job of the servicer, replacing the job it has waiting. Returns false once stopped
+func (s *ClaimScheduler) Schedule(job ClaimJob) bool {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if s.stopped {
+		return false
+	}
+	key := job.Address.String()
+	if waiting, ok := s.pending[key]; ok {
+		// the servicer keeps its turn, with the context of the newest block
+		if job.Height >= waiting.Height {
+			s.pending[key] = job
+		}
+		s.dropped(1)
+		return true
+	}
+	s.pending[key] = job
+	s.queue = append(s.queue, key)
+	s.updateMetrics()
+	s.cond.Signal()
+	return true
+}
+
+// "work" - Runs the jobs until the scheduler is stopped
+func (s *ClaimScheduler) work() {
+	defer s.workers.Done()
+	for {
+		job, ok := s.next()
+		if !ok {
+			return
+		}
+		s.run(job)
+		s.l.Lock()
+		delete(s.running, job.Address.String())
+		s.updateMetrics()
+		// the next job of the servicer may be waiting for this one
+		s.cond.Broadcast()
+		s.l.Unlock()
+	}
+}
+
+// "next" - Waits for the first servicer in turn without a job running
+func (s *ClaimScheduler) next() (ClaimJob, bool) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	for {
+		if s.stopped {
+			return ClaimJob{}, false
+		}
+		for i, key := range s.queue {
+			if s.running[key] {
+				continue
+			}
+			s.queue = append(s.queue[:i], s.queue[i+1:]...)
+			job := s.pending[key]
+			delete(s.pending, key)
+			s.running[key] = true
+			s.updateMetrics()
+			return job, true
+		}
+		s.cond.Wait()
+	}
+}
+
+// "run" - Runs the job, a panic only fails the job of this servicer
+func (s *ClaimScheduler) run(job ClaimJob) {
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Error(fmt.Sprintf("the claim and proof job of %s at height %d panicked: %v\n%s", job.Address, job.Height, r, debug.Stack()))
+		}
+		if m := GlobalServiceMetric(); m != nil {
+			m.AddClaimJobTimeFor(float64(time.Since(start).Milliseconds()), &job.Address)
+		}
+	}()
+	job.Run()
+}
+
+// "Stop" - Drops the jobs waiting and waits for the running jobs to end, up to the timeout
+func (s *ClaimScheduler) Stop(timeout time.Duration) {
+	s.l.Lock()
+	if s.stopped {
+		s.l.Unlock()
+		return
+	}
+	s.stopped = true
+	s.dropped(len(s.pending))
+	s.pending = make(map[string]ClaimJob)
+	s.queue = nil
+	s.updateMetrics()
+	s.cond.Broadcast()
+	s.l.Unlock()
+	done := make(chan struct{})
+	go func() {
+		s.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		s.logger.Error("claim and proof jobs still running at shutdown")
+	}
+}
+
+// "Len" - The number of jobs waiting and running
+func (s *ClaimScheduler) Len() (waiting, running int) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return len(s.pending), len(s.running)
+}
+
+// CONTRACT: called with the lock
+func (s *ClaimScheduler) updateMetrics() {
+	if m := GlobalServiceMetric(); m != nil {
+		m.SetClaimJobs(len(s.pending), len(s.running))
+	}
+}
+
+// CONTRACT: called with the lock
+func (s *ClaimScheduler) dropped(n int) {
+	if m := GlobalServiceMetric(); m != nil && n > 0 {
+		m.AddDroppedClaimJobs(n)
+	}
+}
+
+// "InitClaimScheduler" - Starts the claim scheduler of the node, stopping the previous one
+func InitClaimScheduler(workers int, logger log.Logger) {
+	claimSchedulerLock.Lock()
+	defer claimSchedulerLock.Unlock()
+	if globalClaimScheduler != nil {
+		globalClaimScheduler.Stop(claimSchedulerStopTimeout)
+	}
+	globalClaimScheduler = NewClaimScheduler(workers, logger)
+}
+
+// "GlobalClaimScheduler" - The claim scheduler of the node, started with the configured workers if needed
+func GlobalClaimScheduler() *ClaimScheduler {
+	claimSchedulerLock.Lock()
+	defer claimSchedulerLock.Unlock()
+	if globalClaimScheduler == nil {
+		globalClaimScheduler = NewClaimScheduler(GlobalPocketConfig.ClaimProofWorkers, log.NewNopLogger())
+	}
+	return globalClaimScheduler
+}
+
+// "StopClaimScheduler" - Stops the claim scheduler of the node before shutdown
+func StopClaimScheduler() {
+	claimSchedulerLock.Lock()
+	defer claimSchedulerLock.Unlock()
+	if globalClaimScheduler != nil {
+		globalClaimScheduler.Stop(claimSchedulerStopTimeout)
+	}
+}
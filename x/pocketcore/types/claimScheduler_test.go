@@ -0,0 +1,56 @@
+package types
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestClaimScheduler(t *testing.T) {
+	s := NewClaimScheduler(2, log.NewNopLogger())
+	servicer := sdk.Address(getRandomPubKey().Address())
+	other := sdk.Address(getRandomPubKey().Address())
+	var l sync.Mutex
+	var ran []int64
+	release := make(chan struct{})
+	job := func(address sdk.Address, height int64, block bool) ClaimJob {
+		return ClaimJob{Address: address, Height: height, Run: func() {
+			if block {
+				<-release
+			}
+			l.Lock()
+			ran = append(ran, height)
+			l.Unlock()
+		}}
+	}
+	// the servicer runs one job at a time, and only the newest one waits
+	assert.True(t, s.Schedule(job(servicer, 1, true)))
+	assert.Eventually(t, func() bool { _, running := s.Len(); return running == 1 }, time.Second, time.Millisecond)
+	assert.True(t, s.Schedule(job(servicer, 2, false)))
+	assert.True(t, s.Schedule(job(servicer, 3, false)))
+	assert.True(t, s.Schedule(job(servicer, 2, false)))
+	waiting, running := s.Len()
+	assert.Equal(t, 1, waiting)
+	assert.Equal(t, 1, running)
+	// the other servicers don't wait for it
+	assert.True(t, s.Schedule(job(other, 10, false)))
+	assert.Eventually(t, func() bool { l.Lock(); defer l.Unlock(); return len(ran) == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, []int64{10}, ran)
+	close(release)
+	assert.Eventually(t, func() bool { l.Lock(); defer l.Unlock(); return len(ran) == 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, []int64{10, 1, 3}, ran)
+	// a panic only fails its job
+	assert.True(t, s.Schedule(ClaimJob{Address: other, Height: 11, Run: func() { panic("claim") }}))
+	assert.True(t, s.Schedule(job(servicer, 4, false)))
+	assert.Eventually(t, func() bool { l.Lock(); defer l.Unlock(); return len(ran) == 4 }, time.Second, time.Millisecond)
+	// stopped, the jobs are refused
+	s.Stop(time.Second)
+	assert.False(t, s.Schedule(job(servicer, 5, false)))
+	waiting, running = s.Len()
+	assert.Zero(t, waiting)
+	assert.Zero(t, running)
+}
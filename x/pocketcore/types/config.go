@@ -35,6 +35,7 @@ func InitConfig(chains *HostedBlockchains, logger log.Logger, c types.Config) {
 		GlobalTenderMintConfig.NodeKey = types.DefaultPVSNameLean
 	}
 	SetRPCTimeout(c.PocketConfig.RPCTimeout)
+	InitClaimScheduler(c.PocketConfig.ClaimProofWorkers, logger)
 }
 
 func ConvertEvidenceToProto(config types.Config) error {
@@ -94,6 +94,12 @@ const (
 	CodeInvalidExpirationHeightErr       = 88
 	CodeInvalidMerkleRangeError          = 89
 	CodeEvidenceSealed                   = 90
+	CodeChainTimeoutError                = 91
+	CodeRequestTooLargeError             = 92
+	CodeResponseTooLargeError            = 93
+	CodeHTTPMethodNotAllowedError        = 94
+	CodePathNotAllowedError              = 95
+	CodeRPCMethodNotAllowedError         = 96
 )
 
 var (
@@ -186,12 +192,42 @@ var (
 	InvalidExpirationHeightErr       = errors.New("the expiration height included in the claim message is invalid (should not be set)")
 	InvalidMerkleRangeError          = errors.New("the merkle hash range is invalid")
 	SealedEvidenceError              = errors.New("the evidence is sealed, either max relays reached or claim already submitted")
+	ChainTimeoutError                = errors.New("the hosted chain did not respond within the timeout of the chain")
+	RequestTooLargeError             = errors.New("the relay payload exceeds the max request bytes of the chain")
+	ResponseTooLargeError            = errors.New("the hosted chain response exceeds the max response bytes of the chain")
+	HTTPMethodNotAllowedError        = errors.New("the http method of the relay is not allowed for the chain: ")
+	PathNotAllowedError              = errors.New("the path of the relay is not allowed for the chain: ")
+	RPCMethodNotAllowedError         = errors.New("the json rpc method of the relay is not allowed for the chain: ")
 )
 
 func NewSealedEvidenceError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEvidenceSealed, SealedEvidenceError.Error())
 }
 
+func NewChainTimeoutError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeChainTimeoutError, ChainTimeoutError.Error())
+}
+
+func NewRequestTooLargeError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRequestTooLargeError, RequestTooLargeError.Error())
+}
+
+func NewResponseTooLargeError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeResponseTooLargeError, ResponseTooLargeError.Error())
+}
+
+func NewHTTPMethodNotAllowedError(codespace sdk.CodespaceType, method string) sdk.Error {
+	return sdk.NewError(codespace, CodeHTTPMethodNotAllowedError, HTTPMethodNotAllowedError.Error()+method)
+}
+
+func NewPathNotAllowedError(codespace sdk.CodespaceType, path string) sdk.Error {
+	return sdk.NewError(codespace, CodePathNotAllowedError, PathNotAllowedError.Error()+path)
+}
+
+func NewRPCMethodNotAllowedError(codespace sdk.CodespaceType, method string) sdk.Error {
+	return sdk.NewError(codespace, CodeRPCMethodNotAllowedError, RPCMethodNotAllowedError.Error()+method)
+}
+
 func NewUnsupportedBlockchainError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeUnsupportedBlockchainError, UnsupportedBlockchainError.Error())
 }
@@ -15,6 +15,8 @@ type Evidence struct {
 	NumOfProofs   int64                    `json:"num_of_proofs"` // the total number of proofs in the evidence
 	Proofs        Proofs                   `json:"proofs"`        // a slice of Proof objects (Proof per relay or challenge)
 	EvidenceType  EvidenceType             `json:"evidence_type"`
+	MerkleLeaves  *MerkleLeaves            `json:"-"` // the sorted leaves of the merkle tree, updated as proofs are added
+	sealed        bool                     // the merkle leaves are persisted with the sealed evidence only
 }
 
 func (e Evidence) IsSealable() bool {
@@ -33,12 +35,17 @@ func (e *Evidence) GenerateMerkleRoot(height int64, maxRelays int64, storage *Ca
 		ev.NumOfProofs = maxRelays
 	}
 	// generate the root object
-	root, _ = GenerateRoot(height, ev.Proofs)
+	root = ev.merkleLeaves().Root(height)
 	return
 }
 
 // "AddProof" - Adds a proof obj to the GOBEvidence field
 func (e *Evidence) AddProof(p Proof) {
+	// add proof to the merkle leaves
+	if e.MerkleLeaves == nil {
+		e.MerkleLeaves = NewMerkleLeaves(e.Proofs)
+	}
+	e.MerkleLeaves.Insert(MerkleLeaf{Hash: merkleHash(p.Bytes()), Index: int64(len(e.Proofs))})
 	// add proof to GOBEvidence
 	e.Proofs = append(e.Proofs, p)
 	// increment total proof count
@@ -54,11 +61,24 @@ func (e *Evidence) GenerateMerkleProof(height int64, index int, maxRelays int64)
 		e.NumOfProofs = maxRelays
 	}
 	// generate the merkle proof
-	proof, leaf = GenerateProofs(height, e.Proofs, index)
-	// set the evidence in memory
+	proof, proofIndex := e.merkleLeaves().Proof(height, index)
+	leaf = e.Proofs[proofIndex]
 	return
 }
 
+// "merkleLeaves" - Returns the merkle leaves of the proofs, hashed and sorted again only if they are missing
+// or were not updated with the proofs
+func (e Evidence) merkleLeaves() *MerkleLeaves {
+	leaves := e.MerkleLeaves
+	if leaves.Len() > len(e.Proofs) {
+		leaves = leaves.Truncate(len(e.Proofs))
+	}
+	if leaves.Len() != len(e.Proofs) {
+		return NewMerkleLeaves(e.Proofs)
+	}
+	return leaves
+}
+
 // "Evidence" - A proof of work/burn for nodes.
 type evidence struct {
 	BloomBytes    []byte                   `json:"bloom_bytes"`
@@ -167,12 +187,18 @@ func (e *Evidence) ToProto() (*ProtoEvidence, error) {
 	if err != nil {
 		return nil, err
 	}
+	// the evidence still written may be flushed on every relay, its leaves are sorted again once read back instead
+	var leaves []MerkleLeaf
+	if e.sealed {
+		leaves = e.MerkleLeaves.Sorted()
+	}
 	return &ProtoEvidence{
 		BloomBytes:    encodedBloom,
 		SessionHeader: &e.SessionHeader,
 		NumOfProofs:   e.NumOfProofs,
 		Proofs:        e.Proofs.ToProofI(),
 		EvidenceType:  e.EvidenceType,
+		MerkleLeaves:  leaves,
 	}, nil
 }
 
@@ -182,12 +208,15 @@ func (pe *ProtoEvidence) FromProto() (Evidence, error) {
 	if err != nil {
 		return Evidence{}, fmt.Errorf("could not unmarshal into ProtoEvidence from cache, bloom bytes gob decode: %s", err.Error())
 	}
+	proofs := pe.Proofs.FromProofI()
 	return Evidence{
 		Bloom:         bloomFilter,
 		SessionHeader: *pe.SessionHeader,
 		NumOfProofs:   pe.NumOfProofs,
-		Proofs:        pe.Proofs.FromProofI(),
-		EvidenceType:  pe.EvidenceType}, nil
+		Proofs:        proofs,
+		EvidenceType:  pe.EvidenceType,
+		MerkleLeaves:  MerkleLeavesFromSorted(pe.MerkleLeaves, len(proofs)),
+		sealed:        len(pe.MerkleLeaves) != 0}, nil
 }
 
 func (e Evidence) MarshalObject() ([]byte, error) {
@@ -0,0 +1,160 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/config"
+	db "github.com/tendermint/tm-db"
+)
+
+const (
+	EvidenceDBBackendLevelDB = "goleveldb" // default, compacting key value store
+	EvidenceDBBackendLog     = "logdb"     // append only log, for write heavy evidence
+	EvidenceDBBackendMemory  = "memdb"     // memory only, nothing is persisted
+
+	levelDBExtension = ".db"
+)
+
+// "EvidenceDB" - The persisted storage behind a CacheStorage
+type EvidenceDB interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+	Set(key, value []byte) error
+	Delete(key []byte) error
+	// Iterator returns the items in [start, end) in ascending order of the keys
+	Iterator(start, end []byte) (db.Iterator, error)
+	Close() error
+}
+
+// "ValidateEvidenceDBBackend" - Returns an error if the backend is unknown
+func ValidateEvidenceDBBackend(backend string) error {
+	switch backend {
+	case EvidenceDBBackendLevelDB, EvidenceDBBackendLog, EvidenceDBBackendMemory:
+		return nil
+	default:
+		return fmt.Errorf("unknown evidence db backend %s, expected one of %s, %s, %s", backend,
+			EvidenceDBBackendLevelDB, EvidenceDBBackendLog, EvidenceDBBackendMemory)
+	}
+}
+
+// "NewEvidenceDB" - Opens the evidence db with the name in dir using the backend
+func NewEvidenceDB(backend, name, dir string, options config.LevelDBOptions, maxEntries int) (EvidenceDB, error) {
+	switch backend {
+	case EvidenceDBBackendLevelDB, "":
+		evidenceDB, err := sdk.NewLevelDB(name, dir, options.ToGoLevelDBOpts())
+		if err == syscall.EWOULDBLOCK {
+			return nil, fmt.Errorf("can't open files needed for execution. Another instance may be running. path: %s", filepath.Join(dir, name+levelDBExtension))
+		}
+		return evidenceDB, err
+	case EvidenceDBBackendLog:
+		return NewLogDB(name, dir)
+	case EvidenceDBBackendMemory:
+		return db.NewGoLevelMemDBWithCapacity(maxEntries), nil
+	default:
+		return nil, ValidateEvidenceDBBackend(backend)
+	}
+}
+
+// "evidenceDBNames" - Returns the names of the evidence dbs (including the per node and quarantine dbs)
+// persisted in dir with the backend
+func evidenceDBNames(backend, name, dir string) ([]string, error) {
+	var ext string
+	switch backend {
+	case EvidenceDBBackendLevelDB:
+		ext = levelDBExtension
+	case EvidenceDBBackendLog:
+		ext = logDBExtension
+	default:
+		return nil, fmt.Errorf("the %s evidence db backend is not persisted", backend)
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, name+"*"+ext))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(paths))
+	for _, p := range paths {
+		names = append(names, strings.TrimSuffix(filepath.Base(p), ext))
+	}
+	return names, nil
+}
+
+// "ConvertEvidenceDB" - Copies every evidence db persisted with the from backend into the to backend.
+// The records are copied as is, so checksums and encryption carry over. The source dbs are left in place
+// and the node must be stopped while converting
+func ConvertEvidenceDB(c sdk.Config, from, to string, progress func(name string, records int)) error {
+	for _, backend := range []string{from, to} {
+		if err := ValidateEvidenceDBBackend(backend); err != nil {
+			return err
+		}
+		if backend == EvidenceDBBackendMemory {
+			return errors.New("cannot convert from or to the memory only evidence db backend")
+		}
+	}
+	if from == to {
+		return fmt.Errorf("the evidence db is already using the %s backend", from)
+	}
+	dir, options := c.PocketConfig.DataDir, c.TendermintConfig.LevelDBOptions
+	names, err := evidenceDBNames(from, c.PocketConfig.EvidenceDBName, dir)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no %s evidence db named %s found in %s", from, c.PocketConfig.EvidenceDBName, dir)
+	}
+	for _, name := range names {
+		records, err := convertEvidenceDB(from, to, name, dir, options)
+		if err != nil {
+			return fmt.Errorf("error converting the %s evidence db: %s", name, err.Error())
+		}
+		if progress != nil {
+			progress(name, records)
+		}
+	}
+	return nil
+}
+
+func convertEvidenceDB(from, to, name, dir string, options config.LevelDBOptions) (records int, err error) {
+	if existing, _ := evidenceDBNames(to, name, dir); containsString(existing, name) {
+		return 0, fmt.Errorf("a %s evidence db named %s already exists in %s", to, name, dir)
+	}
+	src, err := NewEvidenceDB(from, name, dir, options, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	dst, err := NewEvidenceDB(to, name, dir, options, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if closeErr := dst.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	it, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if err = dst.Set(it.Key(), it.Value()); err != nil {
+			return records, err
+		}
+		records++
+	}
+	return records, it.Error()
+}
+
+func containsString(s []string, v string) bool {
+	for _, item := range s {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
@@ -1,15 +1,48 @@
 package types
 
 import (
+	"fmt"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"sync"
 )
 
 // HostedBlockchain" - An object that represents a local hosted non-native blockchain
 type HostedBlockchain struct {
-	ID        string    `json:"id"`         // network identifier of the hosted blockchain
-	URL       string    `json:"url"`        // url of the hosted blockchain
-	BasicAuth BasicAuth `json:"basic_auth"` // basic http auth optinal
+	ID          string            `json:"id"`                     // network identifier of the hosted blockchain
+	URL         string            `json:"url"`                    // url of the hosted blockchain
+	BasicAuth   BasicAuth         `json:"basic_auth"`             // basic http auth optinal
+	Cache       *RelayCacheConfig `json:"cache,omitempty"`        // response cache of deterministic methods optional
+	RelayPolicy *RelayPolicy      `json:"relay_policy,omitempty"` // timeouts, size limits, allowlists and headers optional
+}
+
+// "Policy" - Returns the relay policy of the hosted blockchain, the empty policy doesn't restrict
+func (c HostedBlockchain) Policy() RelayPolicy {
+	if c.RelayPolicy == nil {
+		return RelayPolicy{}
+	}
+	return *c.RelayPolicy
+}
+
+// "Redacted" - Returns a copy of the hosted blockchain to show, without the values of the static headers
+func (c HostedBlockchain) Redacted() HostedBlockchain {
+	if c.RelayPolicy != nil {
+		policy := c.RelayPolicy.Redacted()
+		c.RelayPolicy = &policy
+	}
+	return c
+}
+
+// "ValidateConfig" - Validates the optional settings of the hosted blockchain
+func (c HostedBlockchain) ValidateConfig() error {
+	if c.Cache != nil {
+		if err := c.Cache.Validate(); err != nil {
+			return fmt.Errorf("invalid cache of chain %s: %s", c.ID, err.Error())
+		}
+	}
+	if err := c.Policy().Validate(); err != nil {
+		return fmt.Errorf("invalid relay policy of chain %s: %s", c.ID, err.Error())
+	}
+	return nil
 }
 
 type BasicAuth struct {
@@ -19,8 +52,10 @@ type BasicAuth struct {
 
 // HostedBlockchains" - An object that represents the local hosted non-native blockchains
 type HostedBlockchains struct {
-	M map[string]HostedBlockchain // M[addr] -> addr, url
-	L sync.RWMutex
+	M      map[string]HostedBlockchain // M[addr] -> addr, url
+	L      sync.RWMutex
+	caches map[string]*RelayCache // response caches by chain id
+	cacheL sync.Mutex
 }
 
 // "Contains" - Checks to see if the hosted chain is within the HostedBlockchains object
@@ -53,6 +88,26 @@ func (c *HostedBlockchains) GetChainURL(id string) (url string, err sdk.Error) {
 	return chain.URL, nil
 }
 
+// "GetRelayCache" - Returns the response cache of the hosted chain, or nil if the chain has none
+func (c *HostedBlockchains) GetRelayCache(id string) *RelayCache {
+	chain, err := c.GetChain(id)
+	if err != nil || chain.Cache == nil {
+		return nil
+	}
+	c.cacheL.Lock()
+	defer c.cacheL.Unlock()
+	if c.caches == nil {
+		c.caches = make(map[string]*RelayCache)
+	}
+	// a reloaded chain config starts over with an empty cache
+	cache, ok := c.caches[id]
+	if !ok || !cache.config.Equal(*chain.Cache) {
+		cache = NewRelayCache(*chain.Cache)
+		c.caches[id] = cache
+	}
+	return cache
+}
+
 // "Validate" - Validates the hosted blockchain object
 func (c *HostedBlockchains) Validate() error {
 	c.L.RLock()
@@ -67,6 +122,9 @@ func (c *HostedBlockchains) Validate() error {
 		if err := NetworkIdentifierVerification(chain.ID); err != nil {
 			return err
 		}
+		if err := chain.ValidateConfig(); err != nil {
+			return NewInvalidHostedChainError(ModuleName)
+		}
 	}
 	return nil
 }
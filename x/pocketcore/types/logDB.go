@@ -0,0 +1,527 @@
+package types
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	db "github.com/tendermint/tm-db"
+)
+
+const (
+	logDBExtension = ".logdb"
+	logDBFileName  = "evidence.log"
+	// the log is only rewritten once it is big enough and mostly made of overwritten or deleted records
+	logDBCompactMinSize = 64 << 20
+	logDBFlagTombstone  = byte(1)
+	// crc32 + flags + two max length uvarints
+	logDBMaxHeaderLength = 4 + 1 + 2*binary.MaxVarintLen64
+)
+
+var errLogDBClosed = errors.New("the log db is closed")
+
+// "LogDB" - An append only, log structured key value store. Every write is appended to a single log file and
+// the latest offset of each key is kept in memory, so writes never wait on a background compaction like leveldb.
+// The log is rewritten in the background with only the live records when most of it is garbage, which stays cheap
+// for evidence as it is deleted once the proofs are submitted. Every write is synced to disk, unless a sync interval
+// is set to group the syncs of the writes made in between
+type LogDB struct {
+	mtx          sync.RWMutex
+	dir          string
+	file         *os.File
+	size         int64 // the length of the log
+	live         int64 // the length of the live records in the log
+	index        map[string]logDBEntry
+	syncInterval time.Duration
+	dirty        bool          // written since the last sync
+	stopSync     chan struct{} // stops the sync loop of the interval
+	syncDone     chan struct{}
+	compactDone  chan struct{} // closed once the running compaction is done
+}
+
+type logDBEntry struct {
+	offset      int64 // the start of the record
+	length      int64 // the length of the record
+	valueOffset int64
+	valueLength int
+}
+
+var _ EvidenceDB = &LogDB{}
+
+// "NewLogDB" - Opens or creates the log db with the name in dir, a partially written record at the end of the log
+// (e.g. after a crash) is truncated
+func NewLogDB(name, dir string) (*LogDB, error) {
+	ldb := &LogDB{
+		dir:   filepath.Join(dir, name+logDBExtension),
+		index: make(map[string]logDBEntry),
+	}
+	if err := os.MkdirAll(ldb.dir, 0755); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(ldb.logPath(), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	ldb.file = file
+	if err := ldb.load(); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if ldb.shouldCompact() {
+		if err := ldb.compact(); err != nil {
+			_ = ldb.file.Close()
+			return nil, err
+		}
+	}
+	return ldb, nil
+}
+
+func (ldb *LogDB) logPath() string {
+	return filepath.Join(ldb.dir, logDBFileName)
+}
+
+// "load" - Rebuilds the index from the log
+func (ldb *LogDB) load() error {
+	r := bufio.NewReader(io.NewSectionReader(ldb.file, 0, 1<<62))
+	var offset int64
+	for {
+		flags, key, value, length, err := readLogDBRecord(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// an incomplete or corrupt tail, drop everything after the last valid record
+			fmt.Printf("ERROR: truncating the log db %s at offset %d: %s\n", ldb.dir, offset, err.Error())
+			if err := ldb.file.Truncate(offset); err != nil {
+				return err
+			}
+			break
+		}
+		ldb.apply(key, flags, logDBEntry{
+			offset:      offset,
+			length:      length,
+			valueOffset: offset + length - int64(len(value)),
+			valueLength: len(value),
+		})
+		offset += length
+	}
+	ldb.size = offset
+	_, err := ldb.file.Seek(offset, io.SeekStart)
+	return err
+}
+
+// "apply" - Updates the index with a record appended to the log
+func (ldb *LogDB) apply(key []byte, flags byte, entry logDBEntry) {
+	if old, ok := ldb.index[string(key)]; ok {
+		ldb.live -= old.length
+		delete(ldb.index, string(key))
+	}
+	if flags&logDBFlagTombstone != 0 {
+		return
+	}
+	ldb.index[string(key)] = entry
+	ldb.live += entry.length
+}
+
+func encodeLogDBRecord(flags byte, key, value []byte) []byte {
+	record := make([]byte, 4, logDBMaxHeaderLength+len(key)+len(value))
+	record = append(record, flags)
+	record = appendUvarint(record, uint64(len(key)))
+	record = appendUvarint(record, uint64(len(value)))
+	record = append(record, key...)
+	record = append(record, value...)
+	binary.BigEndian.PutUint32(record[:4], crc32.ChecksumIEEE(record[4:]))
+	return record
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+// "readLogDBRecord" - Reads and verifies the next record of the log, returns io.EOF at the end of the log
+func readLogDBRecord(r *bufio.Reader) (flags byte, key, value []byte, length int64, err error) {
+	var header [5]byte
+	if n, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.EOF && n == 0 {
+			return 0, nil, nil, 0, io.EOF
+		}
+		return 0, nil, nil, 0, io.ErrUnexpectedEOF
+	}
+	flags = header[4]
+	keyLength, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, nil, nil, 0, io.ErrUnexpectedEOF
+	}
+	valueLength, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, nil, nil, 0, io.ErrUnexpectedEOF
+	}
+	if keyLength+valueLength > 1<<32 {
+		return 0, nil, nil, 0, errors.New("invalid record length")
+	}
+	body := make([]byte, keyLength+valueLength)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, nil, 0, io.ErrUnexpectedEOF
+	}
+	key, value = body[:keyLength], body[keyLength:]
+	// recompute the record to verify the checksum
+	record := encodeLogDBRecord(flags, key, value)
+	if binary.BigEndian.Uint32(header[:4]) != binary.BigEndian.Uint32(record[:4]) {
+		return 0, nil, nil, 0, errors.New("record checksum mismatch")
+	}
+	return flags, key, value, int64(len(record)), nil
+}
+
+// "append" - Writes a record at the end of the log and indexes it, CONTRACT: used with the write lock
+func (ldb *LogDB) append(flags byte, key, value []byte) error {
+	if ldb.file == nil {
+		return errLogDBClosed
+	}
+	record := encodeLogDBRecord(flags, key, value)
+	if _, err := ldb.file.Write(record); err != nil {
+		// drop a partially written record so the log stays readable
+		_ = ldb.file.Truncate(ldb.size)
+		_, _ = ldb.file.Seek(ldb.size, io.SeekStart)
+		return err
+	}
+	length := int64(len(record))
+	ldb.apply(key, flags, logDBEntry{
+		offset:      ldb.size,
+		length:      length,
+		valueOffset: ldb.size + length - int64(len(value)),
+		valueLength: len(value),
+	})
+	ldb.size += length
+	if ldb.syncInterval == 0 {
+		if err := ldb.file.Sync(); err != nil {
+			return err
+		}
+	} else {
+		ldb.dirty = true
+	}
+	if ldb.compactDone == nil && ldb.shouldCompact() {
+		done := make(chan struct{})
+		ldb.compactDone = done
+		go func() {
+			defer close(done)
+			if err := ldb.compact(); err != nil {
+				fmt.Printf("ERROR: unable to compact the log db %s: %s\n", ldb.dir, err.Error())
+			}
+			ldb.mtx.Lock()
+			ldb.compactDone = nil
+			ldb.mtx.Unlock()
+		}()
+	}
+	return nil
+}
+
+// "SetSyncInterval" - Syncs the log to disk every interval instead of on every write, zero syncs every write.
+// A crash loses at most the writes of the last interval
+func (ldb *LogDB) SetSyncInterval(interval time.Duration) {
+	ldb.stopSyncLoop()
+	ldb.mtx.Lock()
+	defer ldb.mtx.Unlock()
+	ldb.syncInterval = interval
+	if interval <= 0 || ldb.file == nil {
+		ldb.syncInterval = 0
+		return
+	}
+	ldb.stopSync, ldb.syncDone = make(chan struct{}), make(chan struct{})
+	go ldb.syncLoop(interval, ldb.stopSync, ldb.syncDone)
+}
+
+func (ldb *LogDB) syncLoop(interval time.Duration, stop, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			ldb.mtx.Lock()
+			if ldb.dirty && ldb.file != nil {
+				if err := ldb.file.Sync(); err != nil {
+					fmt.Printf("ERROR: unable to sync the log db %s: %s\n", ldb.dir, err.Error())
+				} else {
+					ldb.dirty = false
+				}
+			}
+			ldb.mtx.Unlock()
+		}
+	}
+}
+
+// "stopSyncLoop" - Stops the sync loop of the interval if running, CONTRACT: used without the lock
+func (ldb *LogDB) stopSyncLoop() {
+	ldb.mtx.Lock()
+	stop, done := ldb.stopSync, ldb.syncDone
+	ldb.stopSync, ldb.syncDone = nil, nil
+	ldb.mtx.Unlock()
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+func (ldb *LogDB) shouldCompact() bool {
+	return ldb.size >= logDBCompactMinSize && ldb.live*2 < ldb.size
+}
+
+// "compact" - Rewrites the log with only the live records, CONTRACT: used without the lock.
+// The live records of a snapshot of the index are copied without the lock, as the records are never modified once
+// appended, so the writes only wait for the records appended since the snapshot to be copied
+func (ldb *LogDB) compact() error {
+	ldb.mtx.RLock()
+	if ldb.file == nil {
+		ldb.mtx.RUnlock()
+		return errLogDBClosed
+	}
+	file, size := ldb.file, ldb.size
+	snapshot := make(map[string]logDBEntry, len(ldb.index))
+	for k, entry := range ldb.index {
+		snapshot[k] = entry
+	}
+	ldb.mtx.RUnlock()
+	tmpPath := ldb.logPath() + ".compact"
+	tmp, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	fail := func(err error) error {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	// keep the log order of the live records
+	keys := make([]string, 0, len(snapshot))
+	for k := range snapshot {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return snapshot[keys[i]].offset < snapshot[keys[j]].offset })
+	w := bufio.NewWriter(tmp)
+	var offset int64
+	for _, k := range keys {
+		entry := snapshot[k]
+		if _, err := io.Copy(w, io.NewSectionReader(file, entry.offset, entry.length)); err != nil {
+			return fail(err)
+		}
+		entry.valueOffset += offset - entry.offset
+		entry.offset = offset
+		snapshot[k] = entry
+		offset += entry.length
+	}
+	ldb.mtx.Lock()
+	defer ldb.mtx.Unlock()
+	if ldb.file != file {
+		return fail(errLogDBClosed)
+	}
+	// the records appended since the snapshot follow in their order, so the log still replays to the same index
+	tail := ldb.size - size
+	if _, err := io.Copy(w, io.NewSectionReader(ldb.file, size, tail)); err != nil {
+		return fail(err)
+	}
+	if err := w.Flush(); err != nil {
+		return fail(err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return fail(err)
+	}
+	index := make(map[string]logDBEntry, len(ldb.index))
+	for k, entry := range ldb.index {
+		if entry.offset < size {
+			// unchanged since the snapshot
+			index[k] = snapshot[k]
+			continue
+		}
+		entry.offset += offset - size
+		entry.valueOffset += offset - size
+		index[k] = entry
+	}
+	if err := os.Rename(tmpPath, ldb.logPath()); err != nil {
+		return fail(err)
+	}
+	// the rename is only durable once the directory is synced
+	if err := syncDir(ldb.dir); err != nil {
+		fmt.Printf("ERROR: unable to sync the log db directory %s: %s\n", ldb.dir, err.Error())
+	}
+	_ = ldb.file.Close()
+	ldb.file = tmp
+	ldb.dirty = false
+	ldb.index = index
+	ldb.size = offset + tail
+	_, err = ldb.file.Seek(ldb.size, io.SeekStart)
+	return err
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if closeErr := d.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// "Get" - Returns the value of the key, nil if not found
+func (ldb *LogDB) Get(key []byte) ([]byte, error) {
+	ldb.mtx.RLock()
+	defer ldb.mtx.RUnlock()
+	if ldb.file == nil {
+		return nil, errLogDBClosed
+	}
+	entry, ok := ldb.index[string(key)]
+	if !ok {
+		return nil, nil
+	}
+	value := make([]byte, entry.valueLength)
+	if _, err := ldb.file.ReadAt(value, entry.valueOffset); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// "Has" - Returns true if the key is set
+func (ldb *LogDB) Has(key []byte) (bool, error) {
+	ldb.mtx.RLock()
+	defer ldb.mtx.RUnlock()
+	_, ok := ldb.index[string(key)]
+	return ok, nil
+}
+
+// "Set" - Appends the value of the key to the log
+func (ldb *LogDB) Set(key, value []byte) error {
+	if value == nil {
+		return errors.New("value cannot be nil")
+	}
+	ldb.mtx.Lock()
+	defer ldb.mtx.Unlock()
+	return ldb.append(0, key, value)
+}
+
+// "Delete" - Appends a tombstone of the key to the log
+func (ldb *LogDB) Delete(key []byte) error {
+	ldb.mtx.Lock()
+	defer ldb.mtx.Unlock()
+	if _, ok := ldb.index[string(key)]; !ok {
+		return nil
+	}
+	return ldb.append(logDBFlagTombstone, key, nil)
+}
+
+// "Iterator" - Returns the items in [start, end) in ascending order of the keys.
+// The keys are captured when the iterator is created and the values are read when reached, the keys deleted in
+// between are skipped
+func (ldb *LogDB) Iterator(start, end []byte) (db.Iterator, error) {
+	ldb.mtx.RLock()
+	defer ldb.mtx.RUnlock()
+	if ldb.file == nil {
+		return nil, errLogDBClosed
+	}
+	keys := make([][]byte, 0, len(ldb.index))
+	for k := range ldb.index {
+		key := []byte(k)
+		if (start == nil || bytes.Compare(key, start) >= 0) && (end == nil || bytes.Compare(key, end) < 0) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	return &logDBIterator{ldb: ldb, keys: keys, start: start, end: end}, nil
+}
+
+// "Close" - Waits for the running compaction, then syncs and closes the log
+func (ldb *LogDB) Close() error {
+	ldb.stopSyncLoop()
+	ldb.mtx.RLock()
+	compactDone := ldb.compactDone
+	ldb.mtx.RUnlock()
+	if compactDone != nil {
+		<-compactDone
+	}
+	ldb.mtx.Lock()
+	defer ldb.mtx.Unlock()
+	if ldb.file == nil {
+		return nil
+	}
+	err := ldb.file.Sync()
+	if closeErr := ldb.file.Close(); err == nil {
+		err = closeErr
+	}
+	ldb.file = nil
+	return err
+}
+
+type logDBIterator struct {
+	ldb        *LogDB
+	keys       [][]byte
+	start, end []byte
+	i          int
+	value      []byte
+	loaded     bool
+	err        error
+}
+
+func (it *logDBIterator) Domain() (start []byte, end []byte) {
+	return it.start, it.end
+}
+
+func (it *logDBIterator) Valid() bool {
+	it.load()
+	return it.i < len(it.keys)
+}
+
+// "load" - Reads the value of the current key, skipping the keys deleted since the iterator was created
+func (it *logDBIterator) load() {
+	for ; !it.loaded && it.i < len(it.keys); it.i++ {
+		value, err := it.ldb.Get(it.keys[it.i])
+		if err != nil {
+			it.err = err
+		}
+		if value != nil {
+			it.value, it.loaded = value, true
+			return
+		}
+	}
+}
+
+func (it *logDBIterator) Next() {
+	if !it.Valid() {
+		panic("next called on an invalid log db iterator")
+	}
+	it.i++
+	it.value, it.loaded = nil, false
+}
+
+func (it *logDBIterator) Key() []byte {
+	if !it.Valid() {
+		panic("key called on an invalid log db iterator")
+	}
+	return it.keys[it.i]
+}
+
+func (it *logDBIterator) Value() []byte {
+	if !it.Valid() {
+		panic("value called on an invalid log db iterator")
+	}
+	return it.value
+}
+
+func (it *logDBIterator) Error() error {
+	return it.err
+}
+
+func (it *logDBIterator) Close() {}
@@ -0,0 +1,236 @@
+package types
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogDB_SetGetDelete(t *testing.T) {
+	dir := t.TempDir()
+	ldb, err := NewLogDB("test", dir)
+	assert.Nil(t, err)
+	assert.Nil(t, ldb.Set([]byte("b"), []byte("1")))
+	assert.Nil(t, ldb.Set([]byte("a"), []byte("2")))
+	assert.Nil(t, ldb.Set([]byte("c"), []byte("3")))
+	assert.Nil(t, ldb.Set([]byte("b"), []byte("4")))
+	assert.Nil(t, ldb.Delete([]byte("c")))
+	value, err := ldb.Get([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("4"), value)
+	value, err = ldb.Get([]byte("c"))
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+	has, err := ldb.Has([]byte("a"))
+	assert.Nil(t, err)
+	assert.True(t, has)
+	// iterate in key order
+	it, err := ldb.Iterator(nil, nil)
+	assert.Nil(t, err)
+	var keys, values []string
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+		values = append(values, string(it.Value()))
+	}
+	it.Close()
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.Equal(t, []string{"2", "4"}, values)
+	it, err = ldb.Iterator([]byte("b"), nil)
+	assert.Nil(t, err)
+	assert.True(t, it.Valid())
+	assert.Equal(t, []byte("b"), it.Key())
+	it.Close()
+	// reopen from the log
+	assert.Nil(t, ldb.Close())
+	ldb, err = NewLogDB("test", dir)
+	assert.Nil(t, err)
+	value, err = ldb.Get([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("4"), value)
+	has, err = ldb.Has([]byte("c"))
+	assert.Nil(t, err)
+	assert.False(t, has)
+	assert.Nil(t, ldb.Close())
+	_, err = ldb.Get([]byte("b"))
+	assert.Equal(t, errLogDBClosed, err)
+}
+
+func TestLogDB_IteratorSkipsDeleted(t *testing.T) {
+	ldb, err := NewLogDB("test", t.TempDir())
+	assert.Nil(t, err)
+	defer ldb.Close()
+	for _, k := range []string{"a", "b", "c"} {
+		assert.Nil(t, ldb.Set([]byte(k), []byte(k)))
+	}
+	it, err := ldb.Iterator(nil, nil)
+	assert.Nil(t, err)
+	// deleted after the iterator is created
+	assert.Nil(t, ldb.Delete([]byte("b")))
+	assert.Nil(t, ldb.Delete([]byte("c")))
+	var keys []string
+	for ; it.Valid(); it.Next() {
+		assert.NotNil(t, it.Value())
+		keys = append(keys, string(it.Key()))
+	}
+	it.Close()
+	assert.Equal(t, []string{"a"}, keys)
+}
+
+func TestLogDB_SyncInterval(t *testing.T) {
+	dir := t.TempDir()
+	ldb, err := NewLogDB("test", dir)
+	assert.Nil(t, err)
+	ldb.SetSyncInterval(10 * time.Millisecond)
+	assert.Nil(t, ldb.Set([]byte("a"), []byte("1")))
+	assert.Eventually(t, func() bool {
+		ldb.mtx.RLock()
+		defer ldb.mtx.RUnlock()
+		return !ldb.dirty
+	}, time.Second, 10*time.Millisecond)
+	ldb.SetSyncInterval(0)
+	assert.Nil(t, ldb.Set([]byte("b"), []byte("2")))
+	assert.False(t, ldb.dirty)
+	assert.Nil(t, ldb.Close())
+	ldb, err = NewLogDB("test", dir)
+	assert.Nil(t, err)
+	value, err := ldb.Get([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("2"), value)
+	assert.Nil(t, ldb.Close())
+}
+
+func TestLogDB_TruncateIncompleteRecord(t *testing.T) {
+	dir := t.TempDir()
+	ldb, err := NewLogDB("test", dir)
+	assert.Nil(t, err)
+	assert.Nil(t, ldb.Set([]byte("a"), []byte("1")))
+	assert.Nil(t, ldb.Set([]byte("b"), []byte("2")))
+	size := ldb.size
+	assert.Nil(t, ldb.Close())
+	// simulate a crash in the middle of a write
+	path := filepath.Join(dir, "test"+logDBExtension, logDBFileName)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	assert.Nil(t, err)
+	_, err = f.Write(encodeLogDBRecord(0, []byte("c"), []byte("3"))[:6])
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+	ldb, err = NewLogDB("test", dir)
+	assert.Nil(t, err)
+	assert.Equal(t, size, ldb.size)
+	has, err := ldb.Has([]byte("c"))
+	assert.Nil(t, err)
+	assert.False(t, has)
+	// the log is writable after the truncated record
+	assert.Nil(t, ldb.Set([]byte("c"), []byte("3")))
+	assert.Nil(t, ldb.Close())
+	ldb, err = NewLogDB("test", dir)
+	assert.Nil(t, err)
+	value, err := ldb.Get([]byte("c"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("3"), value)
+	assert.Nil(t, ldb.Close())
+}
+
+func TestLogDB_Compact(t *testing.T) {
+	ldb, err := NewLogDB("test", t.TempDir())
+	assert.Nil(t, err)
+	defer ldb.Close()
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, ldb.Set([]byte(fmt.Sprintf("key%d", i%3)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	assert.Nil(t, ldb.Delete([]byte("key0")))
+	assert.True(t, ldb.live < ldb.size)
+	assert.Nil(t, ldb.compact())
+	assert.Equal(t, ldb.live, ldb.size)
+	stat, err := os.Stat(ldb.logPath())
+	assert.Nil(t, err)
+	assert.Equal(t, ldb.size, stat.Size())
+	value, err := ldb.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value7"), value)
+	value, err = ldb.Get([]byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value8"), value)
+	has, err := ldb.Has([]byte("key0"))
+	assert.Nil(t, err)
+	assert.False(t, has)
+	// appends continue after the compacted log
+	assert.Nil(t, ldb.Set([]byte("key3"), []byte("value10")))
+	value, err = ldb.Get([]byte("key3"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value10"), value)
+}
+
+func TestLogDB_CompactWhileWriting(t *testing.T) {
+	dir := t.TempDir()
+	ldb, err := NewLogDB("test", dir)
+	assert.Nil(t, err)
+	for i := 0; i < 1000; i++ {
+		assert.Nil(t, ldb.Set([]byte(fmt.Sprintf("key%d", i%200)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	compacted := make(chan error)
+	go func() { compacted <- ldb.compact() }()
+	// the writes made while the snapshot is copied are kept
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, ldb.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("new%d", i))))
+		assert.Nil(t, ldb.Delete([]byte(fmt.Sprintf("key%d", i+100))))
+		assert.Nil(t, ldb.Set([]byte(fmt.Sprintf("key%d", i+200)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	assert.Nil(t, <-compacted)
+	check := func(ldb *LogDB) {
+		for i := 0; i < 100; i++ {
+			value, err := ldb.Get([]byte(fmt.Sprintf("key%d", i)))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte(fmt.Sprintf("new%d", i)), value)
+			value, err = ldb.Get([]byte(fmt.Sprintf("key%d", i+200)))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), value)
+			has, err := ldb.Has([]byte(fmt.Sprintf("key%d", i+100)))
+			assert.Nil(t, err)
+			assert.False(t, has)
+		}
+	}
+	check(ldb)
+	assert.Len(t, ldb.index, 200)
+	stat, err := os.Stat(ldb.logPath())
+	assert.Nil(t, err)
+	assert.Equal(t, ldb.size, stat.Size())
+	assert.Nil(t, ldb.Close())
+	// the compacted log replays to the same records
+	ldb, err = NewLogDB("test", dir)
+	assert.Nil(t, err)
+	check(ldb)
+	assert.Len(t, ldb.index, 200)
+	assert.Nil(t, ldb.Close())
+}
+
+func TestConvertEvidenceDB(t *testing.T) {
+	config := sdk.DefaultTestingPocketConfig()
+	config.PocketConfig.DataDir = t.TempDir()
+	src, err := NewEvidenceDB(EvidenceDBBackendLevelDB, config.PocketConfig.EvidenceDBName, config.PocketConfig.DataDir, config.TendermintConfig.LevelDBOptions, 0)
+	assert.Nil(t, err)
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, src.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	assert.Nil(t, src.Close())
+	converted := 0
+	err = ConvertEvidenceDB(config, EvidenceDBBackendLevelDB, EvidenceDBBackendLog, func(name string, records int) {
+		converted += records
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 5, converted)
+	dst, err := NewEvidenceDB(EvidenceDBBackendLog, config.PocketConfig.EvidenceDBName, config.PocketConfig.DataDir, config.TendermintConfig.LevelDBOptions, 0)
+	assert.Nil(t, err)
+	value, err := dst.Get([]byte("key3"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value3"), value)
+	assert.Nil(t, dst.Close())
+	// the target already exists
+	assert.NotNil(t, ConvertEvidenceDB(config, EvidenceDBBackendLevelDB, EvidenceDBBackendLog, nil))
+	assert.NotNil(t, ConvertEvidenceDB(config, EvidenceDBBackendLog, EvidenceDBBackendMemory, nil))
+}
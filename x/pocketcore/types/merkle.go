@@ -245,21 +245,7 @@ func sortAndStructure(proofs []Proof) (d []HashRange, sortedProofs []Proof) { //
 		// update the lower
 		lower = hashRanges[i].Range.Upper
 	}
-	// calculate the proper length of the merkle tree
-	properLength := nextPowerOfTwo(uint(numberOfProofs))
-	// generate padding to make it a proper merkle tree
-	padding := make([]HashRange, int(properLength)-numberOfProofs)
-	// add it to the merkleHash rangeds
-	hashRanges = append(hashRanges, padding...)
-	// add padding to the end of the hashRange
-	for i := numberOfProofs; i < int(properLength); i++ {
-		hashRanges[i] = HashRange{
-			Hash:  merkleHash([]byte(strconv.Itoa(i))),
-			Range: Range{Lower: lower, Upper: lower + 1},
-		}
-		lower = hashRanges[i].Range.Upper
-	}
-	return hashRanges, proofs
+	return padHashRanges(hashRanges, lower), proofs
 }
 
 // "structureProofs" - structure hash ranges when proofs are already sorted
@@ -285,6 +271,13 @@ func structureProofs(proofs []Proof) (d []HashRange, sortedProofs []Proof) {
 		}
 	}
 
+	return padHashRanges(hashRanges, lower), proofs
+}
+
+// "padHashRanges" - Pads the leaf hash ranges to a proper merkle tree, lower is the upper of the last leaf
+func padHashRanges(hashRanges []HashRange, lower uint64) []HashRange {
+	numberOfProofs := len(hashRanges)
+	// calculate the proper length of the merkle tree
 	properLength := nextPowerOfTwo(uint(numberOfProofs))
 	// generate padding to make it a proper merkle tree
 	padding := make([]HashRange, int(properLength)-numberOfProofs)
@@ -298,7 +291,7 @@ func structureProofs(proofs []Proof) (d []HashRange, sortedProofs []Proof) {
 		}
 		lower = hashRanges[i].Range.Upper
 	}
-	return hashRanges, proofs
+	return hashRanges
 }
 
 func MultiAppend(dest []byte, s ...[]byte) []byte {
@@ -0,0 +1,175 @@
+package types
+
+import (
+	"sort"
+	"sync"
+)
+
+// the leaves are kept in sorted buckets, so storing a relay moves at most one bucket instead of every leaf
+const (
+	merkleLeavesBucketSize    = 512
+	merkleLeavesMaxBucketSize = 2 * merkleLeavesBucketSize
+)
+
+// "MerkleLeaves" - The leaves of the evidence merkle tree, kept sorted by sum as the proofs are stored
+// so neither the claim nor the proof has to hash and sort every proof of the session
+type MerkleLeaves struct {
+	l       sync.Mutex
+	buckets [][]MerkleLeaf
+	length  int
+}
+
+// "NewMerkleLeaves" - Hashes and sorts the proofs into merkle leaves
+func NewMerkleLeaves(proofs []Proof) *MerkleLeaves {
+	leaves := make([]MerkleLeaf, len(proofs))
+	for i, p := range proofs {
+		leaves[i] = MerkleLeaf{Hash: merkleHash(p.Bytes()), Index: int64(i)}
+	}
+	sort.SliceStable(leaves, func(i, j int) bool { return leaves[i].sum() < leaves[j].sum() })
+	return newMerkleLeavesFromSorted(leaves)
+}
+
+// "MerkleLeavesFromSorted" - Restores the persisted leaves of numOfProofs proofs,
+// returns nil if they are not sorted or do not index every proof once
+func MerkleLeavesFromSorted(leaves []MerkleLeaf, numOfProofs int) *MerkleLeaves {
+	if len(leaves) == 0 || len(leaves) != numOfProofs {
+		return nil
+	}
+	indexed := make([]bool, numOfProofs)
+	for i, leaf := range leaves {
+		if len(leaf.Hash) != MerkleHashLength || leaf.Index < 0 || leaf.Index >= int64(numOfProofs) || indexed[leaf.Index] {
+			return nil
+		}
+		if i > 0 && leaves[i-1].sum() > leaf.sum() {
+			return nil
+		}
+		indexed[leaf.Index] = true
+	}
+	return newMerkleLeavesFromSorted(leaves)
+}
+
+func newMerkleLeavesFromSorted(leaves []MerkleLeaf) *MerkleLeaves {
+	ml := &MerkleLeaves{length: len(leaves)}
+	for len(leaves) > 0 {
+		n := merkleLeavesBucketSize
+		if len(leaves) < n {
+			n = len(leaves)
+		}
+		ml.buckets = append(ml.buckets, append(make([]MerkleLeaf, 0, n), leaves[:n]...))
+		leaves = leaves[n:]
+	}
+	return ml
+}
+
+// "sum" - The upper range of the leaf
+func (leaf MerkleLeaf) sum() uint64 {
+	return sumFromHash(leaf.Hash)
+}
+
+// "Insert" - Adds the leaf after the leaves of a lower or equal sum
+func (ml *MerkleLeaves) Insert(leaf MerkleLeaf) {
+	ml.l.Lock()
+	defer ml.l.Unlock()
+	ml.length++
+	s := leaf.sum()
+	if len(ml.buckets) == 0 {
+		ml.buckets = append(ml.buckets, []MerkleLeaf{leaf})
+		return
+	}
+	// the first bucket ending after the sum, or the last one
+	b := sort.Search(len(ml.buckets), func(i int) bool {
+		bucket := ml.buckets[i]
+		return bucket[len(bucket)-1].sum() > s
+	})
+	if b == len(ml.buckets) {
+		b--
+	}
+	bucket := ml.buckets[b]
+	i := sort.Search(len(bucket), func(i int) bool { return bucket[i].sum() > s })
+	bucket = append(bucket, MerkleLeaf{})
+	copy(bucket[i+1:], bucket[i:])
+	bucket[i] = leaf
+	if len(bucket) <= merkleLeavesMaxBucketSize {
+		ml.buckets[b] = bucket
+		return
+	}
+	// split the full bucket in two
+	half := len(bucket) / 2
+	left := append(make([]MerkleLeaf, 0, merkleLeavesMaxBucketSize), bucket[:half]...)
+	right := append(make([]MerkleLeaf, 0, merkleLeavesMaxBucketSize), bucket[half:]...)
+	ml.buckets = append(ml.buckets, nil)
+	copy(ml.buckets[b+2:], ml.buckets[b+1:])
+	ml.buckets[b], ml.buckets[b+1] = left, right
+}
+
+// "Len" - The number of leaves
+func (ml *MerkleLeaves) Len() int {
+	if ml == nil {
+		return 0
+	}
+	ml.l.Lock()
+	defer ml.l.Unlock()
+	return ml.length
+}
+
+// "Sorted" - Returns a copy of the leaves in order
+func (ml *MerkleLeaves) Sorted() []MerkleLeaf {
+	if ml == nil {
+		return nil
+	}
+	ml.l.Lock()
+	defer ml.l.Unlock()
+	leaves := make([]MerkleLeaf, 0, ml.length)
+	for _, bucket := range ml.buckets {
+		leaves = append(leaves, bucket...)
+	}
+	return leaves
+}
+
+// "Truncate" - Returns the leaves of the first n proofs
+func (ml *MerkleLeaves) Truncate(n int) *MerkleLeaves {
+	leaves := ml.Sorted()
+	truncated := make([]MerkleLeaf, 0, n)
+	for _, leaf := range leaves {
+		if leaf.Index < int64(n) {
+			truncated = append(truncated, leaf)
+		}
+	}
+	return newMerkleLeavesFromSorted(truncated)
+}
+
+// "structure" - Returns the hash ranges of the leaves, padded to a proper merkle tree, and the proof index of each leaf;
+// the same hash ranges sortAndStructure returns for the proofs
+func (ml *MerkleLeaves) structure() (d []HashRange, indexes []int64) {
+	leaves := ml.Sorted()
+	numberOfProofs := len(leaves)
+	hashRanges := make([]HashRange, numberOfProofs, nextPowerOfTwo(uint(numberOfProofs)))
+	indexes = make([]int64, numberOfProofs)
+	// keep track of previous upper (next values lower)
+	lower := uint64(0)
+	for i, leaf := range leaves {
+		upper := leaf.sum()
+		hashRanges[i] = HashRange{Hash: leaf.Hash, Range: Range{Lower: lower, Upper: upper}}
+		indexes[i] = leaf.Index
+		lower = upper
+	}
+	return padHashRanges(hashRanges, lower), indexes
+}
+
+// "Root" - Generates the merkle root of the leaves, the same root as GenerateRoot of the proofs
+func (ml *MerkleLeaves) Root(height int64) HashRange {
+	hashRanges, _ := ml.structure()
+	return root(height, hashRanges)
+}
+
+// "Proof" - Generates the merkle proof of the leaf at index, the same proof as GenerateProofs of the proofs,
+// and the index of its proof
+func (ml *MerkleLeaves) Proof(height int64, index int) (mProof MerkleProof, proofIndex int64) {
+	hashRanges, indexes := ml.structure()
+	// the merkle proof function manipulates the slice
+	target := hashRanges[index]
+	mProof = merkleProof(height, hashRanges, index, &MerkleProof{})
+	mProof.TargetIndex = int64(index)
+	mProof.Target = target
+	return mProof, indexes[index]
+}
@@ -534,3 +534,121 @@ func Benchmark_sortAndStructure(b *testing.B) {
 		})
 	}
 }
+
+func randomRelayProofs(n int) []Proof {
+	proofs := make([]Proof, n)
+	for i := range proofs {
+		proofs[i] = RelayProof{
+			RequestHash:        RandStringBytes(9),
+			Entropy:            rand.Int63n(1000000000000),
+			SessionBlockHeight: 1,
+			ServicerPubKey:     RandStringBytes(32),
+			Blockchain:         "0001",
+			Token:              AAT{},
+			Signature:          RandStringBytes(64),
+		}
+	}
+	return proofs
+}
+
+func copyProofs(proofs []Proof) []Proof {
+	return append([]Proof(nil), proofs...)
+}
+
+func TestMerkleLeaves(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	for _, n := range []int{2, 3, 5, 8, 100, 1500, 5000} {
+		proofs := randomRelayProofs(n)
+		// stored one by one like the relays
+		e := Evidence{}
+		for _, p := range proofs {
+			e.AddProof(p)
+		}
+		assert.Equal(t, n, e.MerkleLeaves.Len())
+		for _, height := range []int64{0, -1} {
+			// the proofs are sorted in place when generating the tree at once
+			root, _ := GenerateRoot(height, copyProofs(proofs))
+			assert.Equal(t, root, e.MerkleLeaves.Root(height))
+			for _, index := range []int{0, n / 2, n - 1} {
+				mProof, leaf := GenerateProofs(height, copyProofs(proofs), index)
+				incrementalProof, proofIndex := e.MerkleLeaves.Proof(height, index)
+				assert.Equal(t, mProof, incrementalProof)
+				assert.Equal(t, leaf, proofs[proofIndex])
+				isValid, _ := incrementalProof.Validate(height, root, leaf, len(incrementalProof.HashRanges))
+				assert.True(t, isValid)
+			}
+		}
+		// persisted and restored
+		restored := MerkleLeavesFromSorted(e.MerkleLeaves.Sorted(), n)
+		assert.Equal(t, e.MerkleLeaves.Root(0), restored.Root(0))
+		assert.Equal(t, NewMerkleLeaves(proofs).Sorted(), restored.Sorted())
+		// truncated to the first proofs
+		if n > 2 {
+			root, _ := GenerateRoot(0, copyProofs(proofs[:n-1]))
+			assert.Equal(t, root, e.MerkleLeaves.Truncate(n-1).Root(0))
+		}
+	}
+	// corrupt leaves are not restored
+	leaves := NewMerkleLeaves(randomRelayProofs(10)).Sorted()
+	assert.Nil(t, MerkleLeavesFromSorted(leaves, 11))
+	leaves[0], leaves[9] = leaves[9], leaves[0]
+	assert.Nil(t, MerkleLeavesFromSorted(leaves, 10))
+}
+
+func TestEvidence_MerkleLeavesPersisted(t *testing.T) {
+	proofs := randomRelayProofs(50)
+	e := Evidence{
+		Bloom:         *bloom.New(10000, 4),
+		SessionHeader: SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1},
+		EvidenceType:  RelayEvidence,
+		Proofs:        make([]Proof, 0),
+	}
+	for _, p := range proofs {
+		e.AddProof(p)
+	}
+	// the leaves of the evidence still written are not persisted
+	bz, err := e.MarshalObject()
+	assert.Nil(t, err)
+	co, err := Evidence{}.UnmarshalObject(bz)
+	assert.Nil(t, err)
+	assert.Nil(t, co.(Evidence).MerkleLeaves)
+	e.sealed = true
+	bz, err = e.MarshalObject()
+	assert.Nil(t, err)
+	co, err = Evidence{}.UnmarshalObject(bz)
+	assert.Nil(t, err)
+	restored := co.(Evidence)
+	assert.Equal(t, e.MerkleLeaves.Sorted(), restored.MerkleLeaves.Sorted())
+	root, _ := GenerateRoot(0, copyProofs(proofs))
+	assert.Equal(t, root, restored.merkleLeaves().Root(0))
+	mProof, leaf := restored.GenerateMerkleProof(0, 7, 50)
+	expectedProof, expectedLeaf := GenerateProofs(0, copyProofs(proofs), 7)
+	assert.Equal(t, expectedProof, mProof)
+	// the restored proofs are pointers
+	relayProof := expectedLeaf.(RelayProof)
+	assert.Equal(t, &relayProof, leaf)
+}
+
+func Benchmark_MerkleLeavesRoot(b *testing.B) {
+	proofs := randomRelayProofs(100000)
+	leaves := NewMerkleLeaves(proofs)
+	b.Run("sort_at_claim", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			GenerateRoot(0, proofs)
+		}
+	})
+	b.Run("incremental", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			leaves.Root(0)
+		}
+	})
+}
+
+func Benchmark_MerkleLeavesInsert(b *testing.B) {
+	proofs := randomRelayProofs(b.N)
+	leaves := NewMerkleLeaves(nil)
+	b.ResetTimer()
+	for i, p := range proofs {
+		leaves.Insert(MerkleLeaf{Hash: merkleHash(p.Bytes()), Index: int64(i)})
+	}
+}
@@ -35,14 +35,39 @@ const (
 	AvgClaimTimeHelp        = "the average time in ms to generate the work needed for claim tx:"
 	AvgProofTimeName        = "avg_proof_time_for_"
 	AvgProofTimeHelp        = "the average time in ms to generate the work needed for claim tx:"
+	CorruptRecordsName      = "corrupt_cache_records"
+	CorruptRecordsHelp      = "the number of evidence and session records that failed the integrity check and were quarantined"
+	GatewayRelayCountName   = "gateway_relay_count"
+	GatewayRelayCountHelp   = "the number of relays sent by the gateway to session nodes"
+	GatewayErrCountName     = "gateway_err_count"
+	GatewayErrCountHelp     = "the number of gateway relays that failed on a session node"
+	GatewayRelayTimeName    = "gateway_relay_time"
+	GatewayRelayTimeHelp    = "the relay time in ms of the session nodes, as seen by the gateway"
+	RelayCacheHitsName      = "relay_cache_hits"
+	RelayCacheHitsHelp      = "the number of relays served from the response cache of the chain"
+	RelayCacheMissesName    = "relay_cache_misses"
+	RelayCacheMissesHelp    = "the number of cacheable relays that were not in the response cache of the chain"
+	ClaimJobsWaitingName    = "claim_jobs_waiting"
+	ClaimJobsWaitingHelp    = "the number of servicers waiting for a claim and proof worker"
+	ClaimJobsRunningName    = "claim_jobs_running"
+	ClaimJobsRunningHelp    = "the number of claim and proof jobs running"
+	ClaimJobsDroppedName    = "claim_jobs_dropped"
+	ClaimJobsDroppedHelp    = "the number of claim and proof jobs replaced by the job of a newer block or dropped at shutdown"
+	ClaimJobTimeName        = "claim_job_time"
+	ClaimJobTimeHelp        = "the time in ms to run the claims and proofs of a servicer"
 )
 
 type ServiceMetrics struct {
-	l               sync.Mutex
-	tmLogger        log.Logger
-	ServiceMetric   `json:"accumulated_service_metrics"` // total metrics
-	NonNativeChains map[string]ServiceMetric             `json:"individual_service_metrics"` // metrics per chain
-	prometheusSrv   *http.Server
+	l                sync.Mutex
+	tmLogger         log.Logger
+	ServiceMetric    `json:"accumulated_service_metrics"` // total metrics
+	NonNativeChains  map[string]ServiceMetric             `json:"individual_service_metrics"` // metrics per chain
+	CorruptRecords   metrics.Counter                      `json:"-"`                          // quarantined cache records
+	Gateway          GatewayMetric                        `json:"-"`                          // relays sent in gateway mode
+	RelayCacheHits   metrics.Counter                      `json:"-"`                          // relays served from the response cache
+	RelayCacheMisses metrics.Counter                      `json:"-"`                          // cacheable relays sent to the chain
+	ClaimScheduler   ClaimSchedulerMetric                 `json:"-"`                          // background claim and proof jobs
+	prometheusSrv    *http.Server
 }
 
 type ServiceMetricsEncodable struct {
@@ -229,7 +254,7 @@ func (sm *ServiceMetrics) AddSessionFor(networkID string, nodeAddress *sdk.Addre
 			sm.tmLogger.Error("unable to load privateKey", networkID)
 			return
 		}
-		addr := sdk.GetAddress(node.PrivateKey.PublicKey())
+		addr := sdk.GetAddress(node.Signer.PublicKey())
 		nodeAddress = &addr
 	}
 	labels := sm.getValidatorLabel(nodeAddress)
@@ -262,6 +287,55 @@ func (sm *ServiceMetrics) AddUPOKTEarnedFor(networkID string, upoktEarned float6
 	sm.NonNativeChains[networkID] = nnc
 }
 
+func (sm *ServiceMetrics) AddCorruptRecordFor(store string) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	sm.CorruptRecords.With("store", store).Add(1)
+}
+
+func (sm *ServiceMetrics) AddGatewayRelayFor(networkID string, relayTime float64, nodeAddress *sdk.Address) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	labels := []string{"chain", networkID, "validator_address", nodeAddress.String()}
+	sm.Gateway.RelayCount.With(labels...).Add(1)
+	sm.Gateway.RelayTime.With(labels...).Observe(relayTime)
+}
+
+func (sm *ServiceMetrics) AddGatewayErrorFor(networkID string, nodeAddress *sdk.Address) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	sm.Gateway.ErrCount.With("chain", networkID, "validator_address", nodeAddress.String()).Add(1)
+}
+
+func (sm *ServiceMetrics) AddRelayCacheFor(networkID string, hit bool) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	if hit {
+		sm.RelayCacheHits.With("chain", networkID).Add(1)
+		return
+	}
+	sm.RelayCacheMisses.With("chain", networkID).Add(1)
+}
+
+func (sm *ServiceMetrics) SetClaimJobs(waiting, running int) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	sm.ClaimScheduler.Waiting.Set(float64(waiting))
+	sm.ClaimScheduler.Running.Set(float64(running))
+}
+
+func (sm *ServiceMetrics) AddDroppedClaimJobs(n int) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	sm.ClaimScheduler.Dropped.Add(float64(n))
+}
+
+func (sm *ServiceMetrics) AddClaimJobTimeFor(time float64, nodeAddress *sdk.Address) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	sm.ClaimScheduler.JobTime.With(sm.getValidatorLabel(nodeAddress)...).Observe(time)
+}
+
 func KeyForServiceMetrics() []byte {
 	return []byte(ServiceMetricsKey)
 }
@@ -270,6 +344,26 @@ func NewServiceMetrics(hostedBlockchains *HostedBlockchains, logger log.Logger)
 	serviceMetrics := ServiceMetrics{
 		ServiceMetric:   NewServiceMetricsFor("all"),
 		NonNativeChains: make(map[string]ServiceMetric),
+		CorruptRecords: prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      CorruptRecordsName,
+			Help:      CorruptRecordsHelp,
+		}, []string{"store"}),
+		Gateway: NewGatewayMetric(),
+		RelayCacheHits: prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      RelayCacheHitsName,
+			Help:      RelayCacheHitsHelp,
+		}, []string{"chain"}),
+		RelayCacheMisses: prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      RelayCacheMissesName,
+			Help:      RelayCacheMissesHelp,
+		}, []string{"chain"}),
+		ClaimScheduler: NewClaimSchedulerMetric(),
 	}
 	if hostedBlockchains != nil {
 		for _, hb := range hostedBlockchains.M {
@@ -367,3 +461,72 @@ func NewServiceMetricsFor(networkID string) ServiceMetric {
 		AverageProofTime: avgProofTime,
 	}
 }
+
+// GatewayMetric is the view of the session nodes from a node running in gateway mode, labeled by chain and node
+type GatewayMetric struct {
+	RelayCount metrics.Counter   `json:"relay_count"`
+	ErrCount   metrics.Counter   `json:"err_count"`
+	RelayTime  metrics.Histogram `json:"relay_time"`
+}
+
+func NewGatewayMetric() GatewayMetric {
+	labels := []string{"chain", "validator_address"}
+	return GatewayMetric{
+		RelayCount: prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      GatewayRelayCountName,
+			Help:      GatewayRelayCountHelp,
+		}, labels),
+		ErrCount: prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      GatewayErrCountName,
+			Help:      GatewayErrCountHelp,
+		}, labels),
+		RelayTime: prometheus.NewHistogramFrom(stdPrometheus.HistogramOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      GatewayRelayTimeName,
+			Help:      GatewayRelayTimeHelp,
+			Buckets:   stdPrometheus.LinearBuckets(1, 20, 20),
+		}, labels),
+	}
+}
+
+type ClaimSchedulerMetric struct {
+	Waiting metrics.Gauge     `json:"waiting"`
+	Running metrics.Gauge     `json:"running"`
+	Dropped metrics.Counter   `json:"dropped"`
+	JobTime metrics.Histogram `json:"job_time"`
+}
+
+func NewClaimSchedulerMetric() ClaimSchedulerMetric {
+	return ClaimSchedulerMetric{
+		Waiting: prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      ClaimJobsWaitingName,
+			Help:      ClaimJobsWaitingHelp,
+		}, nil),
+		Running: prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      ClaimJobsRunningName,
+			Help:      ClaimJobsRunningHelp,
+		}, nil),
+		Dropped: prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      ClaimJobsDroppedName,
+			Help:      ClaimJobsDroppedHelp,
+		}, nil),
+		JobTime: prometheus.NewHistogramFrom(stdPrometheus.HistogramOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      ClaimJobTimeName,
+			Help:      ClaimJobTimeHelp,
+			Buckets:   stdPrometheus.ExponentialBuckets(10, 2, 16),
+		}, []string{"validator_address"}),
+	}
+}
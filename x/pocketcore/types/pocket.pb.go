@@ -283,6 +283,7 @@ type ProtoEvidence struct {
 	NumOfProofs   int64          `protobuf:"varint,3,opt,name=numOfProofs,proto3" json:"num_of_proofs"`
 	Proofs        ProofIs        `protobuf:"bytes,4,rep,name=proofs,proto3,castrepeated=ProofIs" json:"proofs"`
 	EvidenceType  EvidenceType   `protobuf:"varint,5,opt,name=evidenceType,proto3,casttype=EvidenceType" json:"evidence_type"`
+	MerkleLeaves  []MerkleLeaf   `protobuf:"bytes,6,rep,name=merkleLeaves,proto3" json:"merkle_leaves"`
 }
 
 func (m *ProtoEvidence) Reset()         { *m = ProtoEvidence{} }
@@ -609,6 +610,45 @@ func (m *HashRange) GetRange() Range {
 	return Range{}
 }
 
+// MerkleLeaf is a leaf of the evidence merkle tree, the proof index is its position in the evidence proofs
+type MerkleLeaf struct {
+	Hash  []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash"`
+	Index int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index"`
+}
+
+func (m *MerkleLeaf) Reset()         { *m = MerkleLeaf{} }
+func (m *MerkleLeaf) String() string { return proto.CompactTextString(m) }
+func (*MerkleLeaf) ProtoMessage()    {}
+func (*MerkleLeaf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd7cbfa14fd73888, []int{13}
+}
+func (m *MerkleLeaf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleLeaf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleLeaf.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerkleLeaf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleLeaf.Merge(m, src)
+}
+func (m *MerkleLeaf) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleLeaf) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleLeaf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleLeaf proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SessionHeader)(nil), "x.pocketcore.SessionHeader")
 	proto.RegisterType((*Session)(nil), "x.pocketcore.Session")
@@ -623,95 +663,99 @@ func init() {
 	proto.RegisterType((*MerkleProof)(nil), "x.pocketcore.MerkleProof")
 	proto.RegisterType((*Range)(nil), "x.pocketcore.Range")
 	proto.RegisterType((*HashRange)(nil), "x.pocketcore.HashRange")
+	proto.RegisterType((*MerkleLeaf)(nil), "x.pocketcore.MerkleLeaf")
 }
 
 func init() { proto.RegisterFile("x/pocketcore/pocket.proto", fileDescriptor_fd7cbfa14fd73888) }
 
 var fileDescriptor_fd7cbfa14fd73888 = []byte{
-	// 1322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x13, 0x17, 0x4d, 0xc9, 0x8e, 0x47, 0x94, 0x3f, 0x36, 0x0e, 0xfe, 0x72, 0xfe, 0x80, 0xe9, 0x1a,
-	0x28, 0x62, 0x20, 0x88, 0x8c, 0x3a, 0x6d, 0x50, 0x04, 0x09, 0x50, 0x31, 0x35, 0xea, 0xb4, 0x4d,
-	0xe3, 0xac, 0x8d, 0x1e, 0x7a, 0x21, 0x28, 0x6a, 0x2d, 0xb1, 0xa2, 0xb8, 0xec, 0x72, 0xe5, 0x58,
-	0x6f, 0x90, 0x63, 0x1f, 0xa1, 0xe8, 0xa1, 0x87, 0x3c, 0x43, 0x1f, 0x20, 0xc7, 0x5c, 0x0a, 0xe4,
-	0x50, 0x30, 0x85, 0x7d, 0x23, 0xfa, 0x00, 0x45, 0x4e, 0xc5, 0x7e, 0x50, 0x22, 0x6d, 0xc7, 0x29,
-	0xfa, 0x71, 0x31, 0x57, 0x33, 0xbf, 0x99, 0x9d, 0xef, 0x59, 0xc3, 0xea, 0xf1, 0x56, 0x4c, 0xfd,
-	0x01, 0xe1, 0x3e, 0x65, 0x44, 0x1f, 0x5b, 0x31, 0xa3, 0x9c, 0x22, 0xeb, 0xb8, 0x35, 0x65, 0x5d,
-	0x5f, 0xe9, 0xd1, 0x1e, 0x95, 0x8c, 0x2d, 0x71, 0x52, 0x98, 0x8d, 0x9f, 0x0d, 0x68, 0xec, 0x93,
-	0x24, 0x09, 0x68, 0xb4, 0x4b, 0xbc, 0x2e, 0x61, 0xe8, 0x13, 0x58, 0xf6, 0xe2, 0x38, 0x0c, 0x7c,
-	0x8f, 0x07, 0x34, 0xda, 0x1b, 0x75, 0xbe, 0x20, 0xe3, 0xa6, 0xb1, 0x6e, 0x6c, 0xce, 0x3b, 0x28,
-	0x4b, 0xed, 0x05, 0x2f, 0x8e, 0xdd, 0x78, 0xd4, 0x09, 0x03, 0xdf, 0x1d, 0x90, 0x31, 0x3e, 0x0f,
-	0x46, 0x36, 0xd4, 0xfc, 0xbe, 0x17, 0x44, 0xcd, 0x19, 0x29, 0x35, 0x9f, 0xa5, 0xb6, 0x22, 0x60,
-	0xf5, 0x41, 0x0e, 0xa0, 0x44, 0xdd, 0xe9, 0x84, 0xd4, 0x1f, 0xec, 0x92, 0xa0, 0xd7, 0xe7, 0x4d,
-	0x73, 0xdd, 0xd8, 0x34, 0xd5, 0x1d, 0x9a, 0xeb, 0xf6, 0x25, 0x07, 0x5f, 0x80, 0xbe, 0x5b, 0x7d,
-	0xf6, 0x83, 0x5d, 0xd9, 0x78, 0x65, 0xc0, 0x9c, 0x36, 0x1f, 0x3d, 0x81, 0x46, 0x52, 0xf4, 0x44,
-	0x1a, 0x5d, 0xdf, 0xfe, 0x7f, 0xab, 0x18, 0x86, 0x56, 0xc9, 0x59, 0x67, 0xe1, 0x45, 0x6a, 0x57,
-	0xb2, 0xd4, 0x9e, 0xed, 0xcb, 0xdf, 0xb8, 0xac, 0x01, 0x7d, 0x04, 0xa0, 0x09, 0x22, 0x08, 0xc2,
-	0x1d, 0xcb, 0xb9, 0x96, 0xa5, 0xb6, 0x39, 0x20, 0xe3, 0x37, 0xa9, 0x0d, 0xfb, 0x13, 0x26, 0x2e,
-	0x00, 0xd1, 0x7d, 0xb0, 0xf4, 0xaf, 0xaf, 0x68, 0x97, 0x24, 0x4d, 0x73, 0xdd, 0xdc, 0xb4, 0x9c,
-	0x55, 0x11, 0x87, 0x48, 0x10, 0x9e, 0xbf, 0xb6, 0xad, 0xfd, 0x02, 0x00, 0x97, 0xe0, 0xda, 0xb5,
-	0x5f, 0x4d, 0xb8, 0xf2, 0x28, 0xe9, 0x3d, 0x08, 0xbd, 0x60, 0xf8, 0x5f, 0xf8, 0xf6, 0x25, 0xc0,
-	0x90, 0xb0, 0x41, 0x48, 0x30, 0xa5, 0x5c, 0xfa, 0x56, 0xdf, 0xfe, 0x5f, 0x59, 0xdf, 0xae, 0x97,
-	0xf4, 0xb1, 0x17, 0xf5, 0x88, 0x73, 0x55, 0xeb, 0xaa, 0x2b, 0x11, 0x97, 0x51, 0xca, 0x71, 0x41,
-	0x1e, 0x6d, 0x43, 0x9d, 0x53, 0xee, 0x85, 0x7b, 0x8c, 0xd2, 0xc3, 0x44, 0xe7, 0x72, 0x29, 0x4b,
-	0x6d, 0x4b, 0x92, 0xdd, 0x58, 0xd2, 0x71, 0x11, 0x84, 0x7a, 0x50, 0x3f, 0x64, 0x74, 0xd8, 0xee,
-	0x76, 0x19, 0x49, 0x92, 0x66, 0x55, 0x86, 0x77, 0x47, 0xc8, 0x08, 0xb2, 0xeb, 0x29, 0xfa, 0x9b,
-	0xd4, 0xfe, 0xa0, 0x17, 0xf0, 0xfe, 0xa8, 0xd3, 0xf2, 0xe9, 0x70, 0x2b, 0xa6, 0x03, 0x7e, 0x2b,
-	0x22, 0xfc, 0x29, 0x65, 0x03, 0x5d, 0xee, 0xb7, 0x64, 0xe9, 0xf3, 0x71, 0x4c, 0x92, 0x96, 0x56,
-	0x86, 0x8b, 0x9a, 0xd1, 0x0e, 0x58, 0xe4, 0x28, 0xe8, 0x92, 0xc8, 0x27, 0x07, 0xe3, 0x98, 0x34,
-	0x6b, 0xeb, 0xc6, 0x66, 0xcd, 0x79, 0x2f, 0x4b, 0xed, 0x46, 0x4e, 0x77, 0x85, 0xf8, 0x9b, 0xd4,
-	0xb6, 0x76, 0x0a, 0x40, 0x5c, 0x12, 0x43, 0x6d, 0x58, 0x22, 0xc7, 0x71, 0xc0, 0x64, 0xad, 0xeb,
-	0xa2, 0x9d, 0x95, 0x8e, 0x8a, 0x9a, 0x58, 0x9e, 0xf2, 0xf2, 0xba, 0x3d, 0x07, 0xbf, 0x7b, 0x45,
-	0xa4, 0xf6, 0xd9, 0x8f, 0xb6, 0xb1, 0xf1, 0xbb, 0x01, 0x8d, 0x47, 0x49, 0x6f, 0x4f, 0x74, 0xa1,
-	0x8c, 0x07, 0xc2, 0xa0, 0xa3, 0x2b, 0x7f, 0xea, 0x0c, 0xaf, 0x96, 0x33, 0xf2, 0x68, 0x0a, 0x70,
-	0xae, 0xe9, 0x9c, 0x34, 0x74, 0x4e, 0xf2, 0x10, 0x17, 0x94, 0xa0, 0x3b, 0x50, 0x0d, 0x89, 0x77,
-	0xa8, 0xd3, 0xbb, 0x52, 0x56, 0x26, 0x21, 0x0f, 0x1d, 0x4b, 0xeb, 0x91, 0x48, 0x2c, 0xff, 0x9e,
-	0x8b, 0x98, 0xf9, 0xb7, 0x22, 0x56, 0x70, 0xf7, 0x27, 0x03, 0x66, 0xd5, 0x7d, 0xe8, 0x2e, 0x00,
-	0x23, 0xa1, 0x37, 0x2e, 0xba, 0xd9, 0x2c, 0x5b, 0x86, 0x27, 0xfc, 0xdd, 0x0a, 0x2e, 0xa0, 0xd1,
-	0x13, 0x58, 0xf0, 0xfb, 0x5e, 0x18, 0x92, 0xa8, 0xa7, 0xc3, 0xa4, 0x3c, 0xbb, 0x51, 0x96, 0x7f,
-	0x50, 0xc2, 0x3c, 0x8c, 0x8e, 0xbc, 0x30, 0xe8, 0x7e, 0xea, 0x71, 0x6f, 0xb7, 0x82, 0xcf, 0x28,
-	0x50, 0xdd, 0xe6, 0xcc, 0x41, 0x4d, 0xc6, 0x6f, 0xe3, 0x74, 0x06, 0x1a, 0x32, 0x29, 0xb9, 0x5b,
-	0x68, 0x0b, 0xa0, 0x13, 0x52, 0x3a, 0x74, 0xc6, 0x9c, 0x24, 0xd2, 0x5e, 0xcb, 0x59, 0x14, 0xbd,
-	0x20, 0xa9, 0x6e, 0x47, 0x90, 0x71, 0x01, 0x82, 0xbe, 0x3e, 0xdb, 0xac, 0x33, 0xef, 0x6e, 0xd6,
-	0xab, 0x59, 0x6a, 0x2f, 0x4e, 0x42, 0x7b, 0x71, 0xc7, 0xde, 0x86, 0x7a, 0x34, 0x1a, 0x3e, 0x3e,
-	0x2c, 0xf5, 0xd8, 0xb2, 0xc8, 0x49, 0x34, 0x1a, 0xba, 0xf4, 0x70, 0x52, 0x01, 0x05, 0x14, 0xfa,
-	0x0c, 0x66, 0x15, 0xb9, 0x59, 0x5d, 0x37, 0xdf, 0x5a, 0x03, 0xab, 0xf9, 0xac, 0x50, 0xd8, 0xe7,
-	0xaf, 0xed, 0x39, 0xc5, 0x49, 0xb0, 0x26, 0xfd, 0x4b, 0x4d, 0xa4, 0x87, 0xdb, 0x33, 0x13, 0x60,
-	0x9a, 0x64, 0x31, 0x3d, 0x18, 0xf9, 0x6e, 0x44, 0x12, 0x2e, 0x46, 0x8e, 0xde, 0x36, 0x72, 0x7a,
-	0x68, 0xb2, 0xdb, 0x17, 0xa3, 0xa8, 0x08, 0x42, 0xef, 0xc3, 0x1c, 0x89, 0x38, 0xa3, 0xb1, 0x1a,
-	0xcc, 0xa6, 0x53, 0xcf, 0x52, 0x3b, 0x27, 0xe1, 0xfc, 0x80, 0x76, 0x2f, 0xd9, 0x35, 0xcd, 0x2c,
-	0xb5, 0x57, 0xf2, 0x5d, 0xd3, 0x11, 0xec, 0x4b, 0x36, 0x0e, 0xba, 0x07, 0x0b, 0x09, 0x61, 0x47,
-	0x81, 0x4f, 0x98, 0xde, 0x8a, 0x55, 0x69, 0xe7, 0x4a, 0x96, 0xda, 0x4b, 0x39, 0x47, 0xac, 0x46,
-	0xb9, 0x17, 0xcf, 0x60, 0x51, 0x4b, 0x56, 0x91, 0x3f, 0x50, 0x9b, 0xb1, 0x26, 0x25, 0x17, 0xb2,
-	0xd4, 0x2e, 0x50, 0x71, 0xe1, 0x8c, 0x3e, 0x84, 0x1a, 0xa7, 0x03, 0x12, 0xc9, 0x09, 0x53, 0xdf,
-	0x5e, 0x2e, 0xa7, 0xad, 0xdd, 0x3e, 0x70, 0xea, 0x3a, 0x67, 0xa6, 0xe7, 0x71, 0xac, 0xc0, 0xe8,
-	0x26, 0xcc, 0x27, 0x41, 0x2f, 0xf2, 0xf8, 0x88, 0x91, 0xe6, 0x9c, 0xbc, 0xa4, 0x91, 0xa5, 0xf6,
-	0x94, 0x88, 0xa7, 0x47, 0x9d, 0x8a, 0x93, 0x19, 0x58, 0x7d, 0x6b, 0xbf, 0x20, 0x02, 0xcb, 0x43,
-	0xef, 0x5b, 0xca, 0x02, 0x3e, 0xc6, 0x24, 0x89, 0x69, 0x94, 0xc8, 0x1e, 0x30, 0xcf, 0xd7, 0xb3,
-	0x4c, 0x67, 0x8e, 0x71, 0xae, 0x6b, 0xe3, 0x50, 0x2e, 0xed, 0xb2, 0x5c, 0x1c, 0x9f, 0xd7, 0x88,
-	0x3a, 0xb0, 0x34, 0x0c, 0xa2, 0x12, 0xf1, 0xe2, 0xae, 0x29, 0xdf, 0x92, 0x97, 0xed, 0x72, 0x2e,
-	0x3c, 0xb9, 0x05, 0x9f, 0xd3, 0x87, 0x38, 0x2c, 0x32, 0x12, 0x53, 0xc6, 0x09, 0xcb, 0x57, 0x8e,
-	0x29, 0x9b, 0xf9, 0x73, 0xa1, 0x21, 0x67, 0x25, 0xff, 0x6c, 0xef, 0x9c, 0xbd, 0x42, 0x07, 0xf9,
-	0xb9, 0x01, 0x8d, 0x92, 0xe9, 0xe5, 0x4c, 0x19, 0x97, 0x67, 0x0a, 0xdd, 0x80, 0x2b, 0xac, 0x18,
-	0x96, 0x79, 0x55, 0xec, 0xb1, 0x37, 0x0e, 0xa9, 0xd7, 0xc5, 0x13, 0x26, 0xba, 0xaf, 0xc7, 0x98,
-	0xf4, 0xec, 0x92, 0xb1, 0xea, 0x34, 0x74, 0xe4, 0x14, 0x1c, 0xab, 0x8f, 0x36, 0xf6, 0x0f, 0x03,
-	0xcc, 0x76, 0xfb, 0x40, 0x74, 0xd8, 0x11, 0x61, 0xa2, 0x0d, 0xb4, 0x81, 0xf2, 0x52, 0x4d, 0xc2,
-	0xf9, 0x01, 0x3d, 0x80, 0x95, 0xf2, 0x1b, 0x30, 0x0c, 0xfc, 0xfc, 0xb9, 0x34, 0xaf, 0x26, 0xa5,
-	0x7e, 0x33, 0xca, 0xc6, 0xb8, 0x10, 0x8c, 0xee, 0xc1, 0xa2, 0x1f, 0x06, 0x24, 0xe2, 0x53, 0x79,
-	0x73, 0xfa, 0xe6, 0x54, 0xac, 0x89, 0x8a, 0xb3, 0x50, 0xd4, 0x2e, 0x99, 0xb0, 0x3f, 0x89, 0x6b,
-	0xf5, 0xa2, 0xb8, 0x5e, 0x08, 0xd5, 0xae, 0xff, 0x62, 0x40, 0xbd, 0xb0, 0x63, 0xd1, 0x4d, 0xa8,
-	0x1f, 0x78, 0xac, 0x47, 0xf8, 0xc3, 0xa8, 0x4b, 0x8e, 0x65, 0x18, 0x4c, 0xf5, 0xa0, 0x0d, 0x04,
-	0x01, 0x17, 0xb9, 0xe2, 0x45, 0xd5, 0xcf, 0x5f, 0x4c, 0x49, 0x73, 0x46, 0x36, 0xc9, 0xbb, 0x5f,
-	0x54, 0x42, 0xc4, 0x65, 0x52, 0x06, 0x17, 0xe4, 0xd1, 0x0e, 0xcc, 0x72, 0xa9, 0x5c, 0xe7, 0xf2,
-	0xad, 0x9a, 0x56, 0xb4, 0x26, 0x4b, 0xc1, 0x95, 0x2e, 0xac, 0x85, 0xb5, 0x5f, 0x8f, 0xa1, 0x26,
-	0xc1, 0xe2, 0x6d, 0x1e, 0xd2, 0xa7, 0xfa, 0x01, 0x59, 0x55, 0xae, 0x48, 0x02, 0x56, 0x1f, 0x01,
-	0x18, 0xc5, 0xb1, 0x5e, 0x5a, 0x1a, 0x20, 0x09, 0x58, 0x7d, 0xb4, 0xc2, 0x00, 0xe6, 0x27, 0x16,
-	0xa0, 0x0d, 0xa8, 0xf6, 0xf3, 0xb9, 0x6d, 0xa9, 0xa9, 0xa6, 0x1e, 0x21, 0x12, 0x22, 0x79, 0xe8,
-	0x63, 0xa8, 0x49, 0xc3, 0x74, 0x5b, 0x5f, 0x3d, 0x53, 0x99, 0xd2, 0x93, 0x49, 0x51, 0x2a, 0x17,
-	0xd4, 0xc7, 0xd9, 0x7b, 0x71, 0xb2, 0x66, 0xbc, 0x3c, 0x59, 0x33, 0x7e, 0x3b, 0x59, 0x33, 0xbe,
-	0x3f, 0x5d, 0xab, 0xbc, 0x3c, 0x5d, 0xab, 0xbc, 0x3a, 0x5d, 0xab, 0x7c, 0x73, 0xe7, 0xaf, 0xf4,
-	0x67, 0xe9, 0xff, 0x23, 0xd9, 0xac, 0x9d, 0x59, 0xf9, 0xbf, 0xcf, 0xed, 0x3f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xa5, 0xfb, 0x71, 0x3c, 0x3c, 0x0d, 0x00, 0x00,
+	// 1371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x4d, 0x49, 0xb6, 0x47, 0x94, 0x7f, 0x36, 0x0e, 0x4a, 0xa7, 0x85, 0xa9, 0x1a, 0x28,
+	0x62, 0x20, 0x88, 0x8c, 0x3a, 0x6d, 0x50, 0x04, 0x09, 0x50, 0x31, 0x35, 0xea, 0xb4, 0x71, 0xe3,
+	0xac, 0x8d, 0x1e, 0x7a, 0x11, 0x28, 0x6a, 0x2d, 0xb1, 0xa2, 0xb8, 0x2c, 0xb9, 0x72, 0xac, 0x37,
+	0xc8, 0xb1, 0x8f, 0x50, 0xf4, 0xd0, 0x43, 0x9e, 0xa0, 0x87, 0x3e, 0x40, 0x8e, 0xb9, 0x14, 0xc8,
+	0xa1, 0x60, 0x0a, 0xe7, 0x26, 0xf4, 0x01, 0x8a, 0x9c, 0x8a, 0xfd, 0xa1, 0x44, 0x5a, 0xb2, 0x1b,
+	0xf4, 0xe7, 0x62, 0xae, 0x66, 0xbe, 0x99, 0xdd, 0xf9, 0x1f, 0xc3, 0xfa, 0xe9, 0x76, 0x48, 0xdd,
+	0x1e, 0x61, 0x2e, 0x8d, 0x88, 0x3a, 0xd6, 0xc3, 0x88, 0x32, 0x8a, 0x8c, 0xd3, 0xfa, 0x84, 0x75,
+	0x6d, 0xad, 0x43, 0x3b, 0x54, 0x30, 0xb6, 0xf9, 0x49, 0x62, 0x36, 0x7f, 0xd1, 0xa0, 0x7a, 0x48,
+	0xe2, 0xd8, 0xa3, 0xc1, 0x1e, 0x71, 0xda, 0x24, 0x42, 0x9f, 0xc2, 0xaa, 0x13, 0x86, 0xbe, 0xe7,
+	0x3a, 0xcc, 0xa3, 0xc1, 0xc1, 0xa0, 0xf5, 0x25, 0x19, 0x9a, 0x5a, 0x4d, 0xdb, 0x5a, 0xb4, 0xd1,
+	0x28, 0xb1, 0x96, 0x9c, 0x30, 0x6c, 0x86, 0x83, 0x96, 0xef, 0xb9, 0xcd, 0x1e, 0x19, 0xe2, 0x69,
+	0x30, 0xb2, 0xa0, 0xe4, 0x76, 0x1d, 0x2f, 0x30, 0xe7, 0x84, 0xd4, 0xe2, 0x28, 0xb1, 0x24, 0x01,
+	0xcb, 0x0f, 0xb2, 0x01, 0xc5, 0xf2, 0x4e, 0xdb, 0xa7, 0x6e, 0x6f, 0x8f, 0x78, 0x9d, 0x2e, 0x33,
+	0xf5, 0x9a, 0xb6, 0xa5, 0xcb, 0x3b, 0x14, 0xb7, 0xd9, 0x15, 0x1c, 0x3c, 0x03, 0x7d, 0xa7, 0xf8,
+	0xf4, 0x07, 0xab, 0xb0, 0xf9, 0x52, 0x83, 0x79, 0xf5, 0x7c, 0xf4, 0x18, 0xaa, 0x71, 0xd6, 0x12,
+	0xf1, 0xe8, 0xca, 0xce, 0xbb, 0xf5, 0xac, 0x1b, 0xea, 0x39, 0x63, 0xed, 0xa5, 0xe7, 0x89, 0x55,
+	0x18, 0x25, 0x56, 0xb9, 0x2b, 0x7e, 0xe3, 0xbc, 0x06, 0xf4, 0x31, 0x80, 0x22, 0x70, 0x27, 0x70,
+	0x73, 0x0c, 0xfb, 0xea, 0x28, 0xb1, 0xf4, 0x1e, 0x19, 0xbe, 0x49, 0x2c, 0x38, 0x1c, 0x33, 0x71,
+	0x06, 0x88, 0xee, 0x81, 0xa1, 0x7e, 0x7d, 0x45, 0xdb, 0x24, 0x36, 0xf5, 0x9a, 0xbe, 0x65, 0xd8,
+	0xeb, 0xdc, 0x0f, 0x01, 0x27, 0x3c, 0x7b, 0x65, 0x19, 0x87, 0x19, 0x00, 0xce, 0xc1, 0x95, 0x69,
+	0xbf, 0xe9, 0xb0, 0xb0, 0x1f, 0x77, 0xee, 0xfb, 0x8e, 0xd7, 0xff, 0x3f, 0x6c, 0x7b, 0x08, 0xd0,
+	0x27, 0x51, 0xcf, 0x27, 0x98, 0x52, 0x26, 0x6c, 0xab, 0xec, 0xbc, 0x93, 0xd7, 0xb7, 0xe7, 0xc4,
+	0x5d, 0xec, 0x04, 0x1d, 0x62, 0x5f, 0x51, 0xba, 0x2a, 0x52, 0xa4, 0x19, 0x51, 0xca, 0x70, 0x46,
+	0x1e, 0xed, 0x40, 0x85, 0x51, 0xe6, 0xf8, 0x07, 0x11, 0xa5, 0xc7, 0xb1, 0x8a, 0xe5, 0xca, 0x28,
+	0xb1, 0x0c, 0x41, 0x6e, 0x86, 0x82, 0x8e, 0xb3, 0x20, 0xd4, 0x81, 0xca, 0x71, 0x44, 0xfb, 0x8d,
+	0x76, 0x3b, 0x22, 0x71, 0x6c, 0x16, 0x85, 0x7b, 0x77, 0xb9, 0x0c, 0x27, 0x37, 0x1d, 0x49, 0x7f,
+	0x93, 0x58, 0x1f, 0x76, 0x3c, 0xd6, 0x1d, 0xb4, 0xea, 0x2e, 0xed, 0x6f, 0x87, 0xb4, 0xc7, 0x6e,
+	0x06, 0x84, 0x3d, 0xa1, 0x51, 0x4f, 0xa5, 0xfb, 0x4d, 0x91, 0xfa, 0x6c, 0x18, 0x92, 0xb8, 0xae,
+	0x94, 0xe1, 0xac, 0x66, 0xb4, 0x0b, 0x06, 0x39, 0xf1, 0xda, 0x24, 0x70, 0xc9, 0xd1, 0x30, 0x24,
+	0x66, 0xa9, 0xa6, 0x6d, 0x95, 0xec, 0xf7, 0x47, 0x89, 0x55, 0x4d, 0xe9, 0x4d, 0x2e, 0xfe, 0x26,
+	0xb1, 0x8c, 0xdd, 0x0c, 0x10, 0xe7, 0xc4, 0x50, 0x03, 0x56, 0xc8, 0x69, 0xe8, 0x45, 0x22, 0xd7,
+	0x55, 0xd2, 0x96, 0x85, 0xa1, 0x3c, 0x27, 0x56, 0x27, 0xbc, 0x34, 0x6f, 0xa7, 0xe0, 0x77, 0x16,
+	0x78, 0x68, 0x9f, 0xfe, 0x68, 0x69, 0x9b, 0x7f, 0x68, 0x50, 0xdd, 0x8f, 0x3b, 0x07, 0xbc, 0x0a,
+	0x85, 0x3f, 0x10, 0x06, 0xe5, 0x5d, 0xf1, 0x53, 0x45, 0x78, 0x3d, 0x1f, 0x91, 0xfd, 0x09, 0xc0,
+	0xbe, 0xaa, 0x62, 0x52, 0x55, 0x31, 0x49, 0x5d, 0x9c, 0x51, 0x82, 0x6e, 0x43, 0xd1, 0x27, 0xce,
+	0xb1, 0x0a, 0xef, 0x5a, 0x5e, 0x99, 0x80, 0x3c, 0xb0, 0x0d, 0xa5, 0x47, 0x20, 0xb1, 0xf8, 0x3b,
+	0xe5, 0x31, 0xfd, 0x1f, 0x79, 0x2c, 0x63, 0xee, 0x4f, 0x1a, 0x94, 0xe5, 0x7d, 0xe8, 0x0e, 0x40,
+	0x44, 0x7c, 0x67, 0x98, 0x35, 0xd3, 0xcc, 0xbf, 0x0c, 0x8f, 0xf9, 0x7b, 0x05, 0x9c, 0x41, 0xa3,
+	0xc7, 0xb0, 0xe4, 0x76, 0x1d, 0xdf, 0x27, 0x41, 0x47, 0xb9, 0x49, 0x5a, 0x76, 0x3d, 0x2f, 0x7f,
+	0x3f, 0x87, 0x79, 0x10, 0x9c, 0x38, 0xbe, 0xd7, 0xfe, 0xcc, 0x61, 0xce, 0x5e, 0x01, 0x9f, 0x53,
+	0x20, 0xab, 0xcd, 0x9e, 0x87, 0x92, 0xf0, 0xdf, 0xe6, 0xcf, 0x3a, 0x54, 0x45, 0x50, 0x52, 0xb3,
+	0xd0, 0x36, 0x40, 0xcb, 0xa7, 0xb4, 0x6f, 0x0f, 0x19, 0x89, 0xc5, 0x7b, 0x0d, 0x7b, 0x99, 0xd7,
+	0x82, 0xa0, 0x36, 0x5b, 0x9c, 0x8c, 0x33, 0x10, 0xf4, 0xf5, 0xf9, 0x62, 0x9d, 0xfb, 0xfb, 0x62,
+	0xbd, 0x32, 0x4a, 0xac, 0xe5, 0xb1, 0x6b, 0x67, 0x57, 0xec, 0x2d, 0xa8, 0x04, 0x83, 0xfe, 0xa3,
+	0xe3, 0x5c, 0x8d, 0xad, 0xf2, 0x98, 0x04, 0x83, 0x7e, 0x93, 0x1e, 0x8f, 0x33, 0x20, 0x83, 0x42,
+	0x9f, 0x43, 0x59, 0x92, 0xcd, 0x62, 0x4d, 0xbf, 0x30, 0x07, 0xd6, 0xd3, 0x5e, 0x21, 0xb1, 0xcf,
+	0x5e, 0x59, 0xf3, 0x92, 0x13, 0x63, 0x45, 0xfa, 0xaf, 0x8a, 0xe8, 0x10, 0x0c, 0x99, 0xa0, 0x0f,
+	0x89, 0x73, 0x42, 0x62, 0xb3, 0x5c, 0xd3, 0xa7, 0xe3, 0xbf, 0x9f, 0x22, 0xa6, 0xb3, 0xdc, 0x17,
+	0x62, 0x38, 0xa7, 0x44, 0x75, 0xcc, 0xa7, 0x3a, 0xc0, 0x24, 0x73, 0x78, 0x4b, 0x8a, 0xc8, 0x77,
+	0x03, 0x12, 0x33, 0xde, 0xc7, 0xd4, 0x08, 0x13, 0x2d, 0x49, 0x91, 0x9b, 0x5d, 0xde, 0xdf, 0xb2,
+	0x20, 0xf4, 0x01, 0xcc, 0x93, 0x80, 0x45, 0x34, 0x94, 0xdd, 0x5e, 0xb7, 0x2b, 0xa3, 0xc4, 0x4a,
+	0x49, 0x38, 0x3d, 0xa0, 0xbd, 0x4b, 0x06, 0x98, 0x39, 0x4a, 0xac, 0xb5, 0x74, 0x80, 0xb5, 0x38,
+	0xfb, 0x92, 0x31, 0x86, 0xee, 0xc2, 0x52, 0x4c, 0xa2, 0x13, 0xcf, 0x25, 0x91, 0x1a, 0xb5, 0x45,
+	0xf1, 0xce, 0xb5, 0x51, 0x62, 0xad, 0xa4, 0x1c, 0x3e, 0x6f, 0xc5, 0xb0, 0x3d, 0x87, 0x45, 0x75,
+	0x91, 0x9a, 0x6e, 0x4f, 0x8e, 0xdb, 0x92, 0x90, 0x5c, 0x1a, 0x25, 0x56, 0x86, 0x8a, 0x33, 0x67,
+	0xf4, 0x11, 0x94, 0x18, 0xed, 0x91, 0x40, 0xb4, 0xad, 0xca, 0xce, 0x6a, 0xde, 0xeb, 0x8d, 0xc6,
+	0x91, 0x5d, 0x51, 0xee, 0xd6, 0x1d, 0x87, 0x61, 0x09, 0x46, 0x37, 0x60, 0x31, 0xf6, 0x3a, 0x81,
+	0xc3, 0x06, 0x11, 0x31, 0xe7, 0xc5, 0x25, 0xd5, 0x51, 0x62, 0x4d, 0x88, 0x78, 0x72, 0x54, 0xa1,
+	0x38, 0x9b, 0x83, 0xf5, 0x0b, 0x8b, 0x10, 0x11, 0x58, 0xed, 0x3b, 0xdf, 0xd2, 0xc8, 0x63, 0x43,
+	0x4c, 0xe2, 0x90, 0x06, 0xb1, 0x28, 0x2c, 0x7d, 0xba, 0x48, 0x44, 0x38, 0x53, 0x8c, 0x7d, 0x4d,
+	0x3d, 0x0e, 0xa5, 0xd2, 0xcd, 0x28, 0x15, 0xc7, 0xd3, 0x1a, 0x51, 0x0b, 0x56, 0xfa, 0x5e, 0x90,
+	0x23, 0xce, 0x2e, 0xc5, 0xfc, 0x2d, 0x69, 0x2d, 0xac, 0xa6, 0xc2, 0xe3, 0x5b, 0xf0, 0x94, 0x3e,
+	0xc4, 0x60, 0x39, 0x22, 0x21, 0x8d, 0x18, 0x89, 0xd2, 0x39, 0xa6, 0x8b, 0x0e, 0xf1, 0x05, 0xd7,
+	0x90, 0xb2, 0xe2, 0x7f, 0x37, 0xcc, 0xce, 0x5f, 0xa1, 0x9c, 0xfc, 0x4c, 0x83, 0x6a, 0xee, 0xe9,
+	0xf9, 0x48, 0x69, 0x97, 0x47, 0x0a, 0x5d, 0x87, 0x85, 0x28, 0xeb, 0x96, 0x45, 0x99, 0xec, 0xa1,
+	0x33, 0xf4, 0xa9, 0xd3, 0xc6, 0x63, 0x26, 0xba, 0xa7, 0x7a, 0xa3, 0xb0, 0xec, 0x92, 0x5e, 0x6d,
+	0x57, 0x95, 0xe7, 0x24, 0x1c, 0xcb, 0x8f, 0x7a, 0xec, 0x9f, 0x1a, 0xe8, 0x8d, 0xc6, 0x11, 0xaf,
+	0xb0, 0x13, 0x12, 0xf1, 0x32, 0x30, 0xb5, 0xc9, 0xa5, 0x8a, 0x84, 0xd3, 0x03, 0xba, 0x0f, 0x6b,
+	0xf9, 0xc5, 0xd2, 0xf7, 0xdc, 0x74, 0x07, 0x5b, 0x94, 0xed, 0x57, 0x2d, 0xa2, 0xa2, 0x30, 0x66,
+	0x82, 0xd1, 0x5d, 0x58, 0x76, 0x7d, 0x8f, 0x04, 0x6c, 0x22, 0xaf, 0x4f, 0x16, 0x59, 0xc9, 0x1a,
+	0xab, 0x38, 0x0f, 0x45, 0x8d, 0xdc, 0x13, 0x0e, 0xc7, 0x7e, 0x2d, 0xce, 0xf2, 0xeb, 0x4c, 0xa8,
+	0x32, 0xfd, 0x57, 0x0d, 0x2a, 0x99, 0xc1, 0x8d, 0x6e, 0x40, 0xe5, 0xc8, 0x89, 0x3a, 0x84, 0x3d,
+	0x08, 0xda, 0xe4, 0x54, 0xb8, 0x41, 0x97, 0x5b, 0xb2, 0xc7, 0x09, 0x38, 0xcb, 0xe5, 0x6b, 0x5a,
+	0x37, 0x5d, 0xc3, 0x62, 0x73, 0xae, 0xa6, 0xbf, 0xd5, 0x9a, 0xc6, 0x45, 0x9a, 0x91, 0x90, 0xc1,
+	0x19, 0x79, 0xb4, 0x0b, 0x65, 0x26, 0x94, 0xab, 0x58, 0x5e, 0xa8, 0x69, 0x4d, 0x69, 0x32, 0x24,
+	0x5c, 0xea, 0xc2, 0x4a, 0x58, 0xd9, 0xf5, 0x08, 0x4a, 0x02, 0xcc, 0x17, 0x7e, 0x9f, 0x3e, 0x51,
+	0x5b, 0x69, 0x51, 0x9a, 0x22, 0x08, 0x58, 0x7e, 0x38, 0x60, 0x10, 0x86, 0x6a, 0x12, 0x2a, 0x80,
+	0x20, 0x60, 0xf9, 0x51, 0x0a, 0x3d, 0x58, 0x1c, 0xbf, 0x00, 0x6d, 0x42, 0xb1, 0x9b, 0xf6, 0x6d,
+	0x43, 0x76, 0x35, 0xd9, 0xf3, 0x05, 0x44, 0xf0, 0xd0, 0x27, 0x50, 0x12, 0x0f, 0x53, 0x65, 0x7d,
+	0xe5, 0x5c, 0x66, 0x0a, 0x4b, 0xc6, 0x49, 0x29, 0x4d, 0x90, 0x9f, 0xcd, 0xc7, 0x00, 0x93, 0x21,
+	0x83, 0xde, 0xcb, 0xdd, 0xb5, 0xc0, 0x97, 0xa1, 0xee, 0xe4, 0x16, 0x0b, 0x64, 0x60, 0xd4, 0x48,
+	0xc8, 0x44, 0x4a, 0x7e, 0xd4, 0x0a, 0x71, 0xf0, 0xfc, 0x6c, 0x43, 0x7b, 0x71, 0xb6, 0xa1, 0xfd,
+	0x7e, 0xb6, 0xa1, 0x7d, 0xff, 0x7a, 0xa3, 0xf0, 0xe2, 0xf5, 0x46, 0xe1, 0xe5, 0xeb, 0x8d, 0xc2,
+	0x37, 0xb7, 0xdf, 0xa6, 0xe4, 0x73, 0xff, 0xc7, 0x89, 0xfa, 0x6f, 0x95, 0xc5, 0xff, 0x68, 0xb7,
+	0xfe, 0x1a, 0x00, 0x4a, 0xc4, 0xe4, 0x34, 0xe4, 0x0d, 0x00, 0x00,
 }
 
 func (m *SessionHeader) Marshal() (dAtA []byte, err error) {
@@ -1012,6 +1056,20 @@ func (m *ProtoEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MerkleLeaves) > 0 {
+		for iNdEx := len(m.MerkleLeaves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleLeaves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPocket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.EvidenceType != 0 {
 		i = encodeVarintPocket(dAtA, i, uint64(m.EvidenceType))
 		i--
@@ -1406,6 +1464,41 @@ func (m *HashRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MerkleLeaf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleLeaf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleLeaf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintPocket(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintPocket(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPocket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPocket(v)
 	base := offset
@@ -1562,6 +1655,12 @@ func (m *ProtoEvidence) Size() (n int) {
 	if m.EvidenceType != 0 {
 		n += 1 + sovPocket(uint64(m.EvidenceType))
 	}
+	if len(m.MerkleLeaves) > 0 {
+		for _, e := range m.MerkleLeaves {
+			l = e.Size()
+			n += 1 + l + sovPocket(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1713,6 +1812,22 @@ func (m *HashRange) Size() (n int) {
 	return n
 }
 
+func (m *MerkleLeaf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPocket(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovPocket(uint64(m.Index))
+	}
+	return n
+}
+
 func sovPocket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2649,6 +2764,40 @@ func (m *ProtoEvidence) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleLeaves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPocket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPocket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleLeaves = append(m.MerkleLeaves, MerkleLeaf{})
+			if err := m.MerkleLeaves[len(m.MerkleLeaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPocket(dAtA[iNdEx:])
@@ -3760,6 +3909,112 @@ func (m *HashRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MerkleLeaf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPocket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleLeaf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleLeaf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPocket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPocket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPocket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPocket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPocket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPocket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPocket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,6 +8,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/privval"
 	"sync"
+	"time"
 )
 
 // GlobalEvidenceCache & GlobalSessionCache is used for the first pocket node and acts as backwards-compatibility for pre-lean pocket
@@ -18,25 +19,30 @@ var GlobalPocketNodes = map[string]*PocketNode{}
 
 // PocketNode represents an entity in the network that is able to handle dispatches, servicing, challenges, and submit proofs/claims.
 type PocketNode struct {
-	PrivateKey      crypto.PrivateKey
+	Signer          Signer
 	EvidenceStore   *CacheStorage
 	SessionStore    *CacheStorage
 	DoCacheInitOnce sync.Once
 }
 
 func (n *PocketNode) GetAddress() sdk.Address {
-	return sdk.GetAddress(n.PrivateKey.PublicKey())
+	return sdk.GetAddress(n.Signer.PublicKey())
 }
 
 func AddPocketNode(pk crypto.PrivateKey, logger log.Logger) *PocketNode {
-	key := sdk.GetAddress(pk.PublicKey()).String()
+	return AddPocketNodeSigner(KeySigner{pk}, logger)
+}
+
+// AddPocketNodeSigner adds the pocket node of the signer, whose key may not be held by this process
+func AddPocketNodeSigner(s Signer, logger log.Logger) *PocketNode {
+	key := sdk.GetAddress(s.PublicKey()).String()
 	logger.Info("Adding " + key + " to list of pocket nodes")
 	node, exists := GlobalPocketNodes[key]
 	if exists {
 		return node
 	}
 	node = &PocketNode{
-		PrivateKey: pk,
+		Signer: s,
 	}
 	GlobalPocketNodes[key] = node
 	return node
@@ -62,8 +68,25 @@ func InitPocketNodeCache(node *PocketNode, c types.Config, logger log.Logger) {
 		logger.Info("Initializing " + address + " session and evidence cache")
 		node.EvidenceStore = &CacheStorage{}
 		node.SessionStore = &CacheStorage{}
-		node.EvidenceStore.Init(c.PocketConfig.DataDir, evidenceDbName, c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxEvidenceCacheEntires, false)
-		node.SessionStore.Init(c.PocketConfig.DataDir, "", c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxSessionCacheEntries, true)
+		node.EvidenceStore.Init(c.PocketConfig.DataDir, evidenceDbName, c.PocketConfig.EvidenceDBBackend, c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxEvidenceCacheEntires)
+		node.SessionStore.Init(c.PocketConfig.DataDir, "", EvidenceDBBackendMemory, c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxSessionCacheEntries)
+		node.EvidenceStore.SetSyncInterval(time.Duration(c.PocketConfig.EvidenceDBSyncInterval) * time.Millisecond)
+		if c.PocketConfig.EvidenceEncryption {
+			key, err := EvidenceEncryptionKey(c.PocketConfig, SignerPrivateKey(node.Signer))
+			if err != nil {
+				panic(fmt.Errorf("unable to load the evidence encryption key: %s", err.Error()))
+			}
+			for _, store := range []*CacheStorage{node.EvidenceStore, node.SessionStore} {
+				if err := store.SetEncryptionKey(key); err != nil {
+					panic(fmt.Errorf("unable to set the evidence encryption key: %s", err.Error()))
+				}
+			}
+		}
+		for _, store := range []*CacheStorage{node.EvidenceStore, node.SessionStore} {
+			if err := store.MigrateRecords(); err != nil {
+				panic(fmt.Errorf("unable to migrate the %s records: %s", store.Name(), err.Error()))
+			}
+		}
 
 		// Set the GOBSession and GOBEvidence Global for backwards compatibility for pre-LeanPocket
 		if GlobalSessionCache == nil {
@@ -101,6 +124,9 @@ func CleanPocketNodes() {
 				continue
 			}
 			r.Clear()
+			if r.Quarantine != nil {
+				r.Quarantine.Close()
+			}
 			if r.DB == nil {
 				continue
 			}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	DefaultRelayCacheMaxEntries = 1000
+)
+
+// block tags that move with the chain, a request that refers to one is never cached
+var movingBlockTags = []string{`"latest"`, `"pending"`, `"safe"`, `"finalized"`}
+
+// RelayCacheConfig is the opt-in response cache of a hosted chain, set in chains.json
+type RelayCacheConfig struct {
+	Methods    []string `json:"methods"`     // the json rpc methods, or rest paths, whose responses are cached
+	TTL        int64    `json:"ttl"`         // seconds a response is served from the cache, 0 never expires
+	MaxEntries int      `json:"max_entries"` // the size of the cache, least recently used responses are evicted first
+}
+
+// "Validate" - Validates the relay cache config
+func (c RelayCacheConfig) Validate() error {
+	if len(c.Methods) == 0 {
+		return fmt.Errorf("the relay cache needs at least one method")
+	}
+	if c.TTL < 0 || c.MaxEntries < 0 {
+		return fmt.Errorf("the relay cache ttl and max entries can't be negative")
+	}
+	return nil
+}
+
+// "Equal" - Returns true if both configs cache the same responses
+func (c RelayCacheConfig) Equal(other RelayCacheConfig) bool {
+	if c.TTL != other.TTL || c.MaxEntries != other.MaxEntries || len(c.Methods) != len(other.Methods) {
+		return false
+	}
+	for i := range c.Methods {
+		if c.Methods[i] != other.Methods[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// RelayCache holds the responses of the allowed methods of a hosted chain
+type RelayCache struct {
+	config  RelayCacheConfig
+	methods map[string]struct{}
+	cache   *sdk.Cache
+}
+
+// relayCacheEntry is a cached response
+type relayCacheEntry struct {
+	response string
+	expires  time.Time // zero never expires
+}
+
+// "NewRelayCache" - Returns an empty cache for the config
+func NewRelayCache(config RelayCacheConfig) *RelayCache {
+	size := config.MaxEntries
+	if size == 0 {
+		size = DefaultRelayCacheMaxEntries
+	}
+	methods := make(map[string]struct{}, len(config.Methods))
+	for _, m := range config.Methods {
+		methods[m] = struct{}{}
+	}
+	return &RelayCache{
+		config:  config,
+		methods: methods,
+		cache:   sdk.NewCache(size),
+	}
+}
+
+// "Key" - Returns the cache key of the payload, and false if the payload is not cacheable
+func (c *RelayCache) Key(p Payload) (string, bool) {
+	if !c.cacheable(p) {
+		return "", false
+	}
+	return p.HashString(), true
+}
+
+// cacheable returns true if the payload is an allowed rest path, or a single json rpc call of an allowed method that
+// doesn't refer to a moving block tag. The method is read like the relay policy reads it, so both classify a request
+// the same way
+func (c *RelayCache) cacheable(p Payload) bool {
+	if p.Path != "" {
+		if _, ok := c.methods[strings.Trim(p.Path, "/")]; ok {
+			return true
+		}
+	}
+	// batches are not cached
+	if strings.HasPrefix(strings.TrimSpace(p.Data), "[") {
+		return false
+	}
+	methods, ok, err := rpcMethods(p.Data)
+	if err != nil || !ok || len(methods) != 1 {
+		return false
+	}
+	if _, ok := c.methods[methods[0]]; !ok {
+		return false
+	}
+	// the whole call is searched, the params may be under any case of their key
+	for _, tag := range movingBlockTags {
+		if strings.Contains(p.Data, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// "Get" - Returns the cached response of the key, if it has not expired
+func (c *RelayCache) Get(key string) (string, bool) {
+	v, ok := c.cache.Get(key)
+	if !ok {
+		return "", false
+	}
+	entry := v.(relayCacheEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		c.cache.Remove(key)
+		return "", false
+	}
+	return entry.response, true
+}
+
+// "Add" - Caches the response of the key, unless it is a json rpc error or a null result: the chain may not have
+// the data yet (e.g. a block not yet mined), which would be served for as long as the response is cached
+func (c *RelayCache) Add(key, response string) {
+	var res struct {
+		Result json.RawMessage `json:"result"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(response), &res); err == nil {
+		if (len(res.Error) != 0 && string(res.Error) != "null") || string(res.Result) == "null" {
+			return
+		}
+	}
+	entry := relayCacheEntry{response: response}
+	if c.config.TTL > 0 {
+		entry.expires = time.Now().Add(time.Duration(c.config.TTL) * time.Second)
+	}
+	c.cache.Add(key, entry)
+}
+
+// "Len" - Returns the number of cached responses
+func (c *RelayCache) Len() int {
+	return c.cache.Len()
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRelayCache_Key(t *testing.T) {
+	cache := NewRelayCache(RelayCacheConfig{Methods: []string{"eth_chainId", "eth_getBlockByNumber", "v1/status"}})
+	tests := []struct {
+		name      string
+		payload   Payload
+		cacheable bool
+	}{
+		{"allowed method", Payload{Data: `{"jsonrpc":"2.0","method":"eth_chainId","id":1}`}, true},
+		{"finalized height", Payload{Data: `{"method":"eth_getBlockByNumber","params":["0x10",false]}`}, true},
+		{"moving block tag", Payload{Data: `{"method":"eth_getBlockByNumber","params":["latest",false]}`}, false},
+		{"method not allowed", Payload{Data: `{"method":"eth_blockNumber"}`}, false},
+		{"batch", Payload{Data: `[{"method":"eth_chainId"}]`}, false},
+		{"duplicate method", Payload{Data: `{"method":"eth_chainId","Method":"eth_sendRawTransaction"}`}, false},
+		{"moving block tag of other params case", Payload{Data: `{"method":"eth_getBlockByNumber","Params":["latest",false]}`}, false},
+		{"not json", Payload{Data: "foo"}, false},
+		{"allowed path", Payload{Path: "/v1/status", Method: "GET"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok := cache.Key(tt.payload)
+			assert.Equal(t, tt.cacheable, ok)
+		})
+	}
+	// the id of the call is part of the key, so the response matches the request
+	k1, _ := cache.Key(Payload{Data: `{"method":"eth_chainId","id":1}`})
+	k2, _ := cache.Key(Payload{Data: `{"method":"eth_chainId","id":2}`})
+	assert.NotEqual(t, k1, k2)
+}
+
+func TestRelayCache_GetAdd(t *testing.T) {
+	cache := NewRelayCache(RelayCacheConfig{Methods: []string{"eth_chainId"}, TTL: 1, MaxEntries: 2})
+	cache.Add("a", `{"result":"0x1"}`)
+	res, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, `{"result":"0x1"}`, res)
+	// errors and null results are not cached
+	cache.Add("b", `{"error":{"code":-32000}}`)
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	cache.Add("b", `{"jsonrpc":"2.0","id":1,"result":null}`)
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	// least recently used is evicted
+	cache.Add("b", "1")
+	cache.Add("c", "2")
+	_, ok = cache.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 2, cache.Len())
+	// expired
+	cache.cache.Add("c", relayCacheEntry{response: "2", expires: time.Now().Add(-time.Second)})
+	_, ok = cache.Get("c")
+	assert.False(t, ok)
+}
+
+func TestRelay_ExecuteCached(t *testing.T) {
+	nodeAddr := sdk.Address(getRandomPubKey().Address())
+	ethereum := hex.EncodeToString([]byte{01})
+	relay := Relay{
+		Payload: Payload{Data: `{"method":"eth_chainId","id":1}`, Method: "POST"},
+		Proof:   RelayProof{Blockchain: ethereum},
+	}
+	defer gock.Off()
+	// a single upstream response
+	gock.New("https://server.com").
+		Post("/relay").
+		Reply(200).
+		BodyString(`{"result":"0x1"}`)
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:    ethereum,
+			URL:   "https://server.com/relay/",
+			Cache: &RelayCacheConfig{Methods: []string{"eth_chainId"}},
+		}},
+	}
+	for i := 0; i < 3; i++ {
+		response, err := relay.Execute(&hb, &nodeAddr)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"result":"0x1"}`, response)
+	}
+	assert.True(t, gock.IsDone())
+	// a reloaded config starts with an empty cache
+	cache := hb.GetRelayCache(ethereum)
+	assert.Equal(t, 1, cache.Len())
+	hb.M[ethereum] = HostedBlockchain{ID: ethereum, URL: "https://server.com/relay/", Cache: &RelayCacheConfig{Methods: []string{"eth_chainId"}, TTL: 5}}
+	assert.Equal(t, 0, hb.GetRelayCache(ethereum).Len())
+}
@@ -0,0 +1,214 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// RelayPolicy is what a hosted chain accepts from relays, set per chain in the relay_policy of chains.json. Unset
+// fields don't restrict
+type RelayPolicy struct {
+	Timeout          int64             `json:"timeout,omitempty"`            // ms the chain has to respond, defaults to the rpc timeout
+	MaxRequestBytes  int               `json:"max_request_bytes,omitempty"`  // max size of the relay payload data
+	MaxResponseBytes int64             `json:"max_response_bytes,omitempty"` // max size of the chain response
+	HTTPMethods      []string          `json:"http_methods,omitempty"`       // allowed http methods
+	Paths            []string          `json:"paths,omitempty"`              // allowed rest paths and their sub paths
+	AllowedMethods   []string          `json:"allowed_methods,omitempty"`    // allowed json rpc methods
+	DeniedMethods    []string          `json:"denied_methods,omitempty"`     // denied json rpc methods
+	Headers          map[string]string `json:"headers,omitempty"`            // static headers sent to the chain, over the relay headers
+}
+
+// redactedHeaderValue replaces the values of the static headers when the chains are shown
+const redactedHeaderValue = "<redacted>"
+
+// errAmbiguousRPCMethod is returned for a call with several method keys, which the chain may read differently
+var errAmbiguousRPCMethod = errors.New("the json rpc call has more than one method key")
+
+var validHTTPMethods = map[string]struct{}{
+	http.MethodGet:     {},
+	http.MethodHead:    {},
+	http.MethodPost:    {},
+	http.MethodPut:     {},
+	http.MethodPatch:   {},
+	http.MethodDelete:  {},
+	http.MethodOptions: {},
+}
+
+// "Validate" - Validates the relay policy
+func (p RelayPolicy) Validate() error {
+	if p.Timeout < 0 || p.MaxRequestBytes < 0 || p.MaxResponseBytes < 0 {
+		return fmt.Errorf("the timeout and max bytes can't be negative")
+	}
+	for _, m := range p.HTTPMethods {
+		if _, ok := validHTTPMethods[strings.ToUpper(m)]; !ok {
+			return fmt.Errorf("invalid http method %s", m)
+		}
+	}
+	if len(p.AllowedMethods) != 0 && len(p.DeniedMethods) != 0 {
+		return fmt.Errorf("only one of allowed_methods and denied_methods can be set")
+	}
+	for k := range p.Headers {
+		if k == "" || strings.ContainsAny(k, " \t\r\n:") {
+			return fmt.Errorf("invalid header name %q", k)
+		}
+	}
+	return nil
+}
+
+// "CheckRequest" - Returns an error if the payload is not allowed by the policy
+func (p RelayPolicy) CheckRequest(payload Payload) sdk.Error {
+	if p.MaxRequestBytes != 0 && len(payload.Data) > p.MaxRequestBytes {
+		return NewRequestTooLargeError(ModuleName)
+	}
+	if len(p.HTTPMethods) != 0 {
+		method := payload.Method
+		if method == "" {
+			method = DEFAULTHTTPMETHOD
+		}
+		if !containsFold(p.HTTPMethods, method) {
+			return NewHTTPMethodNotAllowedError(ModuleName, method)
+		}
+	}
+	path := strings.Trim(payload.Path, "/")
+	if path != "" && len(p.Paths) != 0 && !pathAllowed(p.Paths, path) {
+		return NewPathNotAllowedError(ModuleName, path)
+	}
+	if len(p.AllowedMethods) == 0 && len(p.DeniedMethods) == 0 {
+		return nil
+	}
+	methods, ok, err := rpcMethods(payload.Data)
+	if err != nil {
+		return NewRPCMethodNotAllowedError(ModuleName, "")
+	}
+	if !ok {
+		// rest requests are restricted by path, anything else must be json rpc to be checked
+		if path != "" || len(p.AllowedMethods) == 0 {
+			return nil
+		}
+		return NewRPCMethodNotAllowedError(ModuleName, "")
+	}
+	for _, m := range methods {
+		if len(p.AllowedMethods) != 0 && !containsString(p.AllowedMethods, m) {
+			return NewRPCMethodNotAllowedError(ModuleName, m)
+		}
+		if containsString(p.DeniedMethods, m) {
+			return NewRPCMethodNotAllowedError(ModuleName, m)
+		}
+	}
+	return nil
+}
+
+// rpcMethods returns the methods of a json rpc call or batch, ok is false if the data isn't json rpc. The keys are
+// scanned one by one, as decoding into a struct matches the keys case insensitively and keeps the last duplicate,
+// and a call with more than one method key is an error
+func rpcMethods(data string) (methods []string, ok bool, err error) {
+	dec := json.NewDecoder(strings.NewReader(data))
+	tok, er := dec.Token()
+	if er != nil {
+		return nil, false, nil
+	}
+	switch tok {
+	case json.Delim('['):
+		for dec.More() {
+			if tok, er := dec.Token(); er != nil || tok != json.Delim('{') {
+				return nil, false, nil
+			}
+			method, ok, err := rpcCallMethod(dec)
+			if err != nil || !ok {
+				return nil, false, err
+			}
+			methods = append(methods, method)
+		}
+		if _, er := dec.Token(); er != nil || len(methods) == 0 {
+			return nil, false, nil
+		}
+	case json.Delim('{'):
+		method, ok, err := rpcCallMethod(dec)
+		if err != nil || !ok || method == "" {
+			return nil, false, err
+		}
+		methods = []string{method}
+	default:
+		return nil, false, nil
+	}
+	// nothing may follow the call or batch
+	if _, er := dec.Token(); er != io.EOF {
+		return nil, false, nil
+	}
+	return methods, true, nil
+}
+
+// rpcCallMethod reads the object of a json rpc call, after its opening brace, and returns its method
+func rpcCallMethod(dec *json.Decoder) (method string, ok bool, err error) {
+	found := false
+	for dec.More() {
+		tok, er := dec.Token()
+		key, isString := tok.(string)
+		if er != nil || !isString {
+			return "", false, nil
+		}
+		if !strings.EqualFold(key, "method") {
+			var value json.RawMessage
+			if er := dec.Decode(&value); er != nil {
+				return "", false, nil
+			}
+			continue
+		}
+		if found || key != "method" {
+			return "", false, errAmbiguousRPCMethod
+		}
+		found = true
+		if tok, er = dec.Token(); er != nil {
+			return "", false, nil
+		}
+		if method, isString = tok.(string); !isString {
+			return "", false, nil
+		}
+	}
+	if _, er := dec.Token(); er != nil {
+		return "", false, nil
+	}
+	return method, true, nil
+}
+
+// "Redacted" - Returns a copy of the policy without the values of the static headers, which may hold credentials
+func (p RelayPolicy) Redacted() RelayPolicy {
+	if len(p.Headers) == 0 {
+		return p
+	}
+	headers := make(map[string]string, len(p.Headers))
+	for k := range p.Headers {
+		headers[k] = redactedHeaderValue
+	}
+	p.Headers = headers
+	return p
+}
+
+// pathAllowed returns true if the path is one of the allowed paths or below one of them
+func pathAllowed(allowed []string, path string) bool {
+	if strings.Contains("/"+path+"/", "/../") {
+		return false
+	}
+	for _, a := range allowed {
+		a = strings.Trim(a, "/")
+		if path == a || strings.HasPrefix(path, a+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+	return false
+}
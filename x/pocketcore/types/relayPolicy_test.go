@@ -0,0 +1,122 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelayPolicy_Validate(t *testing.T) {
+	assert.Nil(t, RelayPolicy{}.Validate())
+	assert.Nil(t, RelayPolicy{Timeout: 100, HTTPMethods: []string{"get", "POST"}, Headers: map[string]string{"Authorization": "Bearer x"}}.Validate())
+	assert.NotNil(t, RelayPolicy{Timeout: -1}.Validate())
+	assert.NotNil(t, RelayPolicy{HTTPMethods: []string{"CONNECT"}}.Validate())
+	assert.NotNil(t, RelayPolicy{AllowedMethods: []string{"a"}, DeniedMethods: []string{"b"}}.Validate())
+	assert.NotNil(t, RelayPolicy{Headers: map[string]string{"Bad Header": "x"}}.Validate())
+	assert.NotNil(t, HostedBlockchain{ID: "0001", Cache: &RelayCacheConfig{}}.ValidateConfig())
+}
+
+func TestRelayPolicy_CheckRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  RelayPolicy
+		payload Payload
+		code    sdk.CodeType
+	}{
+		{"no policy", RelayPolicy{}, Payload{Data: "anything"}, 0},
+		{"request too large", RelayPolicy{MaxRequestBytes: 4}, Payload{Data: "12345"}, CodeRequestTooLargeError},
+		{"http method allowed", RelayPolicy{HTTPMethods: []string{"post"}}, Payload{Data: "{}"}, 0},
+		{"http method not allowed", RelayPolicy{HTTPMethods: []string{"POST"}}, Payload{Path: "v1", Method: "DELETE"}, CodeHTTPMethodNotAllowedError},
+		{"sub path allowed", RelayPolicy{Paths: []string{"/v1/blocks"}}, Payload{Path: "/v1/blocks/10", Method: "GET"}, 0},
+		{"path not allowed", RelayPolicy{Paths: []string{"v1/blocks"}}, Payload{Path: "v1/blocksx", Method: "GET"}, CodePathNotAllowedError},
+		{"path traversal", RelayPolicy{Paths: []string{"v1"}}, Payload{Path: "v1/../admin", Method: "GET"}, CodePathNotAllowedError},
+		{"rpc method allowed", RelayPolicy{AllowedMethods: []string{"eth_call"}}, Payload{Data: `{"method":"eth_call"}`}, 0},
+		{"rpc method not allowed", RelayPolicy{AllowedMethods: []string{"eth_call"}}, Payload{Data: `{"method":"debug_traceTransaction"}`}, CodeRPCMethodNotAllowedError},
+		{"batch with a method not allowed", RelayPolicy{AllowedMethods: []string{"eth_call"}}, Payload{Data: `[{"method":"eth_call"},{"method":"admin_peers"}]`}, CodeRPCMethodNotAllowedError},
+		{"not json rpc with an allowlist", RelayPolicy{AllowedMethods: []string{"eth_call"}}, Payload{Data: "foo"}, CodeRPCMethodNotAllowedError},
+		{"rest with an allowlist", RelayPolicy{AllowedMethods: []string{"eth_call"}}, Payload{Path: "v1", Method: "GET"}, 0},
+		{"rpc method denied", RelayPolicy{DeniedMethods: []string{"admin_peers"}}, Payload{Data: `{"method":"admin_peers"}`}, CodeRPCMethodNotAllowedError},
+		{"rpc method not denied", RelayPolicy{DeniedMethods: []string{"admin_peers"}}, Payload{Data: `{"method":"eth_call"}`}, 0},
+		{"duplicate method key", RelayPolicy{DeniedMethods: []string{"admin_peers"}}, Payload{Data: `{"method":"admin_peers","method":"eth_call"}`}, CodeRPCMethodNotAllowedError},
+		{"case variant method key", RelayPolicy{DeniedMethods: []string{"admin_peers"}}, Payload{Data: `{"method":"eth_call","Method":"admin_peers"}`}, CodeRPCMethodNotAllowedError},
+		{"case variant method key in a batch", RelayPolicy{AllowedMethods: []string{"eth_call"}}, Payload{Data: `[{"method":"eth_call"},{"METHOD":"admin_peers"}]`}, CodeRPCMethodNotAllowedError},
+		{"method key in the params", RelayPolicy{AllowedMethods: []string{"eth_call"}}, Payload{Data: `{"method":"eth_call","params":[{"method":"x"}]}`}, 0},
+		{"data after the call", RelayPolicy{AllowedMethods: []string{"eth_call"}}, Payload{Data: `{"method":"eth_call"}{"method":"admin_peers"}`}, CodeRPCMethodNotAllowedError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.CheckRequest(tt.payload)
+			if tt.code == 0 {
+				assert.Nil(t, err)
+				return
+			}
+			if assert.NotNil(t, err) {
+				assert.Equal(t, tt.code, err.Code())
+			}
+		})
+	}
+}
+
+func TestRelay_ExecutePolicy(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		case "/large":
+			_, _ = w.Write([]byte(strings.Repeat("a", 100)))
+			return
+		}
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer srv.Close()
+	nodeAddr := sdk.Address(getRandomPubKey().Address())
+	ethereum := hex.EncodeToString([]byte{01})
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:  ethereum,
+			URL: srv.URL,
+			RelayPolicy: &RelayPolicy{
+				Timeout:          50,
+				MaxResponseBytes: 10,
+				Headers:          map[string]string{"Authorization": "Bearer x"},
+			},
+		}},
+	}
+	execute := func(path string) (string, sdk.Error) {
+		relay := Relay{Payload: Payload{Data: "{}", Method: "POST", Path: path}, Proof: RelayProof{Blockchain: ethereum}}
+		return relay.Execute(&hb, &nodeAddr)
+	}
+	// the static header is injected
+	res, err := execute("")
+	assert.Nil(t, err)
+	assert.Equal(t, "Bearer x", res)
+	_, err = execute("slow")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, sdk.CodeType(CodeChainTimeoutError), err.Code())
+	}
+	_, err = execute("large")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, sdk.CodeType(CodeResponseTooLargeError), err.Code())
+	}
+}
+
+func TestHostedBlockchain_Redacted(t *testing.T) {
+	chain := HostedBlockchain{ID: "0001", RelayPolicy: &RelayPolicy{Timeout: 10, Headers: map[string]string{"Authorization": "Bearer x"}}}
+	redacted := chain.Redacted()
+	assert.Equal(t, map[string]string{"Authorization": redactedHeaderValue}, redacted.RelayPolicy.Headers)
+	assert.Equal(t, int64(10), redacted.RelayPolicy.Timeout)
+	// the policy of the chain is left as is
+	assert.Equal(t, "Bearer x", chain.RelayPolicy.Headers["Authorization"])
+	bz, err := json.Marshal(redacted)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(bz), "Bearer x")
+	assert.Contains(t, string(bz), `"relay_policy":{"timeout":10`)
+	assert.Equal(t, HostedBlockchain{ID: "0001"}, HostedBlockchain{ID: "0001"}.Redacted())
+}
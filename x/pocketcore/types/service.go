@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
+	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -39,9 +42,14 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, appsKeeper AppsKeeper
 		return sdk.ZeroInt(), NewRequestHashError(ModuleName)
 	}
 	// ensure the blockchain is supported locally
-	if !hb.Contains(r.Proof.Blockchain) {
+	chain, err := hb.GetChain(r.Proof.Blockchain)
+	if err != nil {
 		return sdk.ZeroInt(), NewUnsupportedBlockchainNodeError(ModuleName)
 	}
+	// ensure the chain accepts the request, before the proof is stored
+	if err := chain.Policy().CheckRequest(r.Payload); err != nil {
+		return sdk.ZeroInt(), err
+	}
 	// ensure session block height == one in the relay proof
 	if r.Proof.SessionBlockHeight != sessionBlockHeight {
 		return sdk.ZeroInt(), NewInvalidBlockHeightError(ModuleName)
@@ -120,6 +128,12 @@ func addServiceMetricErrorFor(blockchain string, address *sdk.Address) {
 	}
 }
 
+func addServiceMetricRelayCacheFor(blockchain string, hit bool) {
+	if m := GlobalServiceMetric(); m != nil {
+		m.AddRelayCacheFor(blockchain, hit)
+	}
+}
+
 // "Execute" - Attempts to do a request on the non-native blockchain specified
 func (r Relay) Execute(hostedBlockchains *HostedBlockchains, address *sdk.Address) (string, sdk.Error) {
 	// retrieve the hosted blockchain url requested
@@ -129,17 +143,45 @@ func (r Relay) Execute(hostedBlockchains *HostedBlockchains, address *sdk.Addres
 		addServiceMetricErrorFor(r.Proof.Blockchain, address)
 		return "", err
 	}
+	// enforce the relay policy of the chain
+	if err := chain.Policy().CheckRequest(r.Payload); err != nil {
+		addServiceMetricErrorFor(r.Proof.Blockchain, address)
+		return "", err
+	}
 	url := strings.Trim(chain.URL, `/`)
 	if len(r.Payload.Path) > 0 {
 		url = url + "/" + strings.Trim(r.Payload.Path, `/`)
 	}
+	// serve deterministic requests from the response cache, the proof was stored already
+	cache := hostedBlockchains.GetRelayCache(r.Proof.Blockchain)
+	cacheKey, cacheable := "", false
+	if cache != nil {
+		cacheKey, cacheable = cache.Key(r.Payload)
+	}
+	if cacheable {
+		if res, ok := cache.Get(cacheKey); ok {
+			addServiceMetricRelayCacheFor(r.Proof.Blockchain, true)
+			return res, nil
+		}
+		addServiceMetricRelayCacheFor(r.Proof.Blockchain, false)
+	}
 	// do basic http request on the relay
-	res, er := executeHTTPRequest(r.Payload.Data, url, GlobalPocketConfig.UserAgent, chain.BasicAuth, r.Payload.Method, r.Payload.Headers)
+	res, er := executeHTTPRequest(r.Payload.Data, url, GlobalPocketConfig.UserAgent, chain.BasicAuth, r.Payload.Method, r.Payload.Headers, chain.Policy())
 	if er != nil {
 		// metric track
 		addServiceMetricErrorFor(r.Proof.Blockchain, address)
+		var netErr net.Error
+		switch {
+		case errors.Is(er, errResponseTooLarge):
+			return "", NewResponseTooLargeError(ModuleName)
+		case errors.As(er, &netErr) && netErr.Timeout():
+			return "", NewChainTimeoutError(ModuleName)
+		}
 		return res, NewHTTPExecutionError(ModuleName, er)
 	}
+	if cacheable {
+		cache.Add(cacheKey, res)
+	}
 	return res, nil
 }
 
@@ -294,8 +336,10 @@ type DispatchSession struct {
 	SessionNodes  []exported.ValidatorI `json:"nodes"`
 }
 
+var errResponseTooLarge = errors.New("response too large")
+
 // "executeHTTPRequest" takes in the raw json string and forwards it to the RPC endpoint
-func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, method string, headers map[string]string) (string, error) {
+func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, method string, headers map[string]string, policy RelayPolicy) (string, error) {
 	// generate an http request
 	req, err := http.NewRequest(method, url, bytes.NewBuffer([]byte(payload)))
 	if err != nil {
@@ -315,17 +359,32 @@ func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, met
 			req.Header.Set(k, v)
 		}
 	}
+	// the static headers of the chain win over the relay headers
+	for k, v := range policy.Headers {
+		req.Header.Set(k, v)
+	}
+	timeout := globalRPCTimeout
+	if policy.Timeout > 0 {
+		timeout = time.Duration(policy.Timeout)
+	}
 	// execute the request
-	resp, err := (&http.Client{Timeout: globalRPCTimeout * time.Millisecond}).Do(req)
+	resp, err := (&http.Client{Timeout: timeout * time.Millisecond}).Do(req)
 	if err != nil {
 		return "", err
 	}
-	// read all bz
-	body, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	// read all bz, one byte over the limit tells a response too large
+	var reader io.Reader = resp.Body
+	if policy.MaxResponseBytes > 0 {
+		reader = io.LimitReader(resp.Body, policy.MaxResponseBytes+1)
+	}
+	body, err := ioutil.ReadAll(reader)
 	if err != nil {
 		return "", err
 	}
-	defer resp.Body.Close()
+	if policy.MaxResponseBytes > 0 && int64(len(body)) > policy.MaxResponseBytes {
+		return "", errResponseTooLarge
+	}
 	if GlobalPocketConfig.JSONSortRelayResponses {
 		body = []byte(sortJSONResponse(string(body)))
 	}
@@ -397,6 +397,10 @@ func (m MockPosKeeper) StakeDenom(ctx sdk.Ctx) (res string) {
 	panic("implement me")
 }
 
+func (m MockPosKeeper) ServicerStakeWeight(ctx sdk.Ctx, stake sdk.BigInt) sdk.BigDec {
+	return stake.QuoRaw(10000).ToDec()
+}
+
 func makeTestCodec() *codec.Codec {
 	var cdc = codec.NewCodec(types2.NewInterfaceRegistry())
 	auth.RegisterCodec(cdc)
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
 	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
@@ -40,6 +41,58 @@ func NewSession(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, sessionHeader Session
 	}, nil
 }
 
+// "SessionCandidate" - A node drawn while generating the session nodes, and why it was rejected if it was
+type SessionCandidate struct {
+	Address  sdk.Address `json:"address"`
+	Selected bool        `json:"selected"`
+	Reason   string      `json:"reason,omitempty"`
+}
+
+const (
+	CandidateAlreadyDrawn = "already drawn"
+	CandidateNotFound     = "not found"
+	CandidateJailed       = "jailed"
+	CandidateMissingChain = "missing chain"
+	CandidateDuplicate    = "duplicate"
+)
+
+// "SessionCalculation" - A session computed from state, with the candidates walked through to select its nodes
+type SessionCalculation struct {
+	SessionHeader SessionHeader      `json:"header"`
+	SessionKey    string             `json:"key"`
+	StakeWeighted bool               `json:"stake_weighted"`
+	SessionNodes  []sdk.Address      `json:"nodes"`
+	Candidates    []SessionCandidate `json:"candidates"`
+	Error         string             `json:"error,omitempty"`
+}
+
+// "CalculateSession" - Generates the session like NewSession, keeping the ordered list of candidates that were drawn.
+// Failing to fill the session is reported in the calculation, not as an error, so the candidates can be inspected
+func CalculateSession(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, sessionHeader SessionHeader, blockHash string, sessionNodesCount int) (*SessionCalculation, sdk.Error) {
+	sessionKey, err := NewSessionKey(sessionHeader.ApplicationPubKey, sessionHeader.Chain, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	res := &SessionCalculation{
+		SessionHeader: sessionHeader,
+		SessionKey:    hex.EncodeToString(sessionKey),
+		StakeWeighted: isStakeWeightedSession(sessionCtx),
+		Candidates:    []SessionCandidate{},
+	}
+	var sessionNodes SessionNodes
+	if res.StakeWeighted {
+		sessionNodes, err = stakeWeightedSessionNodes(sessionCtx, ctx, keeper, sessionHeader.Chain, sessionKey, sessionNodesCount, &res.Candidates)
+	} else {
+		sessionNodes, err = uniformSessionNodes(sessionCtx, ctx, keeper, sessionHeader.Chain, sessionKey, sessionNodesCount, &res.Candidates)
+	}
+	if err != nil {
+		res.Error = err.Error()
+		return res, nil
+	}
+	res.SessionNodes = sessionNodes
+	return res, nil
+}
+
 // "Validate" - Validates a session object
 func (s Session) Validate(node sdk.Address, app appexported.ApplicationI, sessionNodeCount int) sdk.Error {
 	// validate chain
@@ -102,6 +155,42 @@ type SessionNodes []sdk.Address
 
 // "NewSessionNodes" - Generates nodes for the session
 func NewSessionNodes(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, chain string, sessionKey SessionKey, sessionNodesCount int) (sessionNodes SessionNodes, err sdk.Error) {
+	if isStakeWeightedSession(sessionCtx) {
+		return NewStakeWeightedSessionNodes(sessionCtx, ctx, keeper, chain, sessionKey, sessionNodesCount)
+	}
+	return uniformSessionNodes(sessionCtx, ctx, keeper, chain, sessionKey, sessionNodesCount, nil)
+}
+
+// "isStakeWeightedSession" - The activation is checked at session genesis so the selection never changes mid session
+func isStakeWeightedSession(sessionCtx sdk.Ctx) bool {
+	return ModuleCdc.IsAfterNamedFeatureActivationHeight(sessionCtx.BlockHeight(), codec.StakeWeightedSessionKey)
+}
+
+// "recordCandidate" - Adds a drawn node to the candidates if they are being recorded
+func recordCandidate(candidates *[]SessionCandidate, addr sdk.Address, reason string) {
+	if candidates == nil {
+		return
+	}
+	*candidates = append(*candidates, SessionCandidate{Address: addr, Selected: reason == "", Reason: reason})
+}
+
+// "candidateRejection" - Why a drawn node can't be part of the session, empty if it can
+func candidateRejection(node exported.ValidatorI, chain string, sessionNodes SessionNodes) string {
+	switch {
+	case node == nil:
+		return CandidateNotFound
+	case node.IsJailed():
+		return CandidateJailed
+	case !NodeHasChain(chain, node):
+		return CandidateMissingChain
+	case sessionNodes.Contains(node.GetAddress()):
+		return CandidateDuplicate
+	}
+	return ""
+}
+
+// "uniformSessionNodes" - Selects the session nodes uniformly at random, optionally recording every drawn candidate
+func uniformSessionNodes(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, chain string, sessionKey SessionKey, sessionNodesCount int, candidates *[]SessionCandidate) (sessionNodes SessionNodes, err sdk.Error) {
 	// all nodesAddrs at session genesis
 	nodesAddrs, totalNodes := keeper.GetValidatorsByChain(sessionCtx, chain)
 	// validate nodesAddrs
@@ -126,6 +215,7 @@ func NewSessionNodes(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, chain string, se
 		n := nodesAddrs[index.Int64()]
 		//if we already have seen this address we continue as it's either on the list or discarded
 		if _, ok := m[n.String()]; ok {
+			recordCandidate(candidates, n, CandidateAlreadyDrawn)
 			continue
 		}
 		//add the node address to the map
@@ -134,9 +224,11 @@ func NewSessionNodes(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, chain string, se
 		// cross check the node from the `new` or `end` world state
 		node = keeper.Validator(ctx, n)
 		// if not found or jailed, don't add to session and continue
-		if node == nil || node.IsJailed() || !NodeHasChain(chain, node) || sessionNodes.Contains(node.GetAddress()) {
+		if reason := candidateRejection(node, chain, sessionNodes); reason != "" {
+			recordCandidate(candidates, n, reason)
 			continue
 		}
+		recordCandidate(candidates, n, "")
 		// else add the node to the session
 		sessionNodes[numOfNodes] = n
 		// increment the number of nodesAddrs in the sessionNodes slice
@@ -150,6 +242,75 @@ func NewSessionNodes(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, chain string, se
 	return sessionNodes, nil
 }
 
+// "selectionWeightPrecision" - The decimal precision kept when converting stake weights to integers for the selection
+const selectionWeightPrecision = 1000000
+
+// "NewStakeWeightedSessionNodes" - Generates nodes for the session, the chance of a node being picked is proportional to
+// the weight of its stake bin
+func NewStakeWeightedSessionNodes(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, chain string, sessionKey SessionKey, sessionNodesCount int) (sessionNodes SessionNodes, err sdk.Error) {
+	return stakeWeightedSessionNodes(sessionCtx, ctx, keeper, chain, sessionKey, sessionNodesCount, nil)
+}
+
+// "stakeWeightedSessionNodes" - Selects the session nodes by stake weight, optionally recording every drawn candidate
+func stakeWeightedSessionNodes(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, chain string, sessionKey SessionKey, sessionNodesCount int, candidates *[]SessionCandidate) (sessionNodes SessionNodes, err sdk.Error) {
+	// all nodesAddrs at session genesis
+	nodesAddrs, totalNodes := keeper.GetValidatorsByChain(sessionCtx, chain)
+	// validate nodesAddrs
+	if totalNodes < sessionNodesCount {
+		return nil, NewInsufficientNodesError(ModuleName)
+	}
+	// the weights come from the stake at session genesis, like the candidates
+	weights := make([]sdk.BigInt, len(nodesAddrs))
+	totalWeight := sdk.ZeroInt()
+	for i, addr := range nodesAddrs {
+		weights[i] = sdk.ZeroInt()
+		v := keeper.Validator(sessionCtx, addr)
+		if v == nil {
+			continue
+		}
+		weight := keeper.ServicerStakeWeight(sessionCtx, v.GetTokens()).MulInt64(selectionWeightPrecision).TruncateInt()
+		// every staked servicer keeps a chance of being selected
+		if !weight.IsPositive() {
+			weight = sdk.OneInt()
+		}
+		weights[i] = weight
+		totalWeight = totalWeight.Add(weight)
+	}
+	sessionNodes = make(SessionNodes, sessionNodesCount)
+	for numOfNodes := 0; numOfNodes < sessionNodesCount; {
+		// every candidate was drawn already
+		if !totalWeight.IsPositive() {
+			return nil, NewInsufficientNodesError(ModuleName)
+		}
+		// generate the random point in the cumulative weights
+		point := PseudorandomSelection(totalWeight, sessionKey)
+		// merkleHash the session key to provide new entropy
+		sessionKey = Hash(sessionKey)
+		index := 0
+		for ; index < len(weights); index++ {
+			if point.LT(weights[index]) {
+				break
+			}
+			point = point.Sub(weights[index])
+		}
+		n := nodesAddrs[index]
+		// draw without replacement
+		totalWeight = totalWeight.Sub(weights[index])
+		weights[index] = sdk.ZeroInt()
+		// cross check the node from the `new` or `end` world state
+		node := keeper.Validator(ctx, n)
+		// if not found or jailed, don't add to session and continue
+		if reason := candidateRejection(node, chain, sessionNodes); reason != "" {
+			recordCandidate(candidates, n, reason)
+			continue
+		}
+		recordCandidate(candidates, n, "")
+		sessionNodes[numOfNodes] = n
+		numOfNodes++
+	}
+	return sessionNodes, nil
+}
+
 // "Validate" - Validates the session node object
 func (sn SessionNodes) Validate(sessionNodesCount int) sdk.Error {
 	if len(sn) < sessionNodesCount {
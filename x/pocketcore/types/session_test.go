@@ -2,8 +2,15 @@ package types
 
 import (
 	"encoding/hex"
-	"github.com/stretchr/testify/assert"
+	"math"
+	"strconv"
 	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestNewSessionKey(t *testing.T) {
@@ -248,3 +255,148 @@ func TestSessionKey_Validate(t *testing.T) {
 //	assert.Nil(t, sessionNodes.Validate(5))
 //	assert.NotNil(t, SessionNodes(make([]exported.ValidatorI, 5)).Validate(5))
 //}
+
+func newWeightedTestNodes(chain string, stakes ...int64) (nodes []exported.ValidatorI) {
+	for _, stake := range stakes {
+		pubKey := getRandomPubKey()
+		nodes = append(nodes, nodesTypes.Validator{
+			Address:      sdk.Address(pubKey.Address()),
+			PublicKey:    pubKey,
+			Status:       sdk.Staked,
+			Chains:       []string{chain},
+			ServiceURL:   "https://www.google.com:443",
+			StakedTokens: sdk.NewInt(stake),
+		})
+	}
+	return
+}
+
+func TestNewStakeWeightedSessionNodes_Distribution(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	ctx := newContext(t, false).WithAppVersion("0.0.0")
+	// weights of 1, 2, 3 and 4 in the mock keeper
+	nodes := newWeightedTestNodes(ethereum, 10000, 20000, 30000, 40000)
+	k := MockPosKeeper{Validators: nodes}
+	const draws = 20000
+	counts := make(map[string]int)
+	for i := 0; i < draws; i++ {
+		sessionKey := SessionKey(merkleHash([]byte(strconv.Itoa(i))))
+		sessionNodes, err := NewStakeWeightedSessionNodes(ctx, ctx, k, ethereum, sessionKey, 1)
+		assert.Nil(t, err)
+		counts[sessionNodes[0].String()]++
+	}
+	for i, n := range nodes {
+		p := float64(i+1) / 10
+		expected := draws * p
+		// allow 4 standard deviations of the binomial distribution
+		tolerance := 4 * math.Sqrt(draws*p*(1-p))
+		observed := float64(counts[n.GetAddress().String()])
+		assert.InDeltaf(t, expected, observed, tolerance, "node with weight %d was selected %v times, expected %v", i+1, observed, expected)
+	}
+}
+
+func TestNewStakeWeightedSessionNodes(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	ctx := newContext(t, false).WithAppVersion("0.0.0")
+	sessionKey := SessionKey(merkleHash([]byte("sessionKey")))
+	// a stake below the first bin still has a chance of being selected
+	nodes := newWeightedTestNodes(ethereum, 1, 10000, 500000, 500000)
+	k := MockPosKeeper{Validators: nodes}
+	// draws without replacement
+	sessionNodes, err := NewStakeWeightedSessionNodes(ctx, ctx, k, ethereum, sessionKey, 4)
+	assert.Nil(t, err)
+	for _, n := range nodes {
+		assert.True(t, sessionNodes.Contains(n.GetAddress()))
+	}
+	// deterministic from the session key
+	first, err := NewStakeWeightedSessionNodes(ctx, ctx, k, ethereum, sessionKey, 2)
+	assert.Nil(t, err)
+	second, err := NewStakeWeightedSessionNodes(ctx, ctx, k, ethereum, sessionKey, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, first, second)
+	// not enough nodes for the chain
+	_, err = NewStakeWeightedSessionNodes(ctx, ctx, k, bitcoin, sessionKey, 1)
+	assert.NotNil(t, err)
+	// jailed nodes are skipped
+	jailed := nodes[2].(nodesTypes.Validator)
+	jailed.Jailed = true
+	nodes[2] = jailed
+	_, err = NewStakeWeightedSessionNodes(ctx, ctx, MockPosKeeper{Validators: nodes}, ethereum, sessionKey, 4)
+	assert.NotNil(t, err)
+	sessionNodes, err = NewStakeWeightedSessionNodes(ctx, ctx, MockPosKeeper{Validators: nodes}, ethereum, sessionKey, 3)
+	assert.Nil(t, err)
+	assert.False(t, sessionNodes.Contains(jailed.Address))
+}
+
+func TestNewSessionNodes_StakeWeightedActivation(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	ctx := newContext(t, false).WithAppVersion("0.0.0").WithBlockHeight(10)
+	nodes := newWeightedTestNodes(ethereum, 10000, 20000, 30000, 40000, 50000, 60000)
+	k := MockPosKeeper{Validators: nodes}
+	sessionKey := SessionKey(merkleHash([]byte("sessionKey")))
+	weighted, err := NewStakeWeightedSessionNodes(ctx, ctx, k, ethereum, sessionKey, 3)
+	assert.Nil(t, err)
+	codec.UpgradeFeatureMap[codec.StakeWeightedSessionKey] = 10
+	defer delete(codec.UpgradeFeatureMap, codec.StakeWeightedSessionKey)
+	sessionNodes, err := NewSessionNodes(ctx, ctx, k, ethereum, sessionKey, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, weighted, sessionNodes)
+}
+
+func TestCalculateSession(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	ctx := newContext(t, false).WithAppVersion("0.0.0")
+	blockHash := hex.EncodeToString(merkleHash([]byte("blockHash")))
+	header := SessionHeader{
+		ApplicationPubKey:  getRandomPubKey().RawString(),
+		Chain:              ethereum,
+		SessionBlockHeight: 1,
+	}
+	nodes := newWeightedTestNodes(ethereum, 10000, 10000, 10000, 10000, 10000, 10000)
+	jailed := nodes[0].(nodesTypes.Validator)
+	jailed.Jailed = true
+	nodes[0] = jailed
+	k := MockPosKeeper{Validators: nodes}
+	session, err := NewSession(ctx, ctx, k, header, blockHash, 5)
+	assert.Nil(t, err)
+	res, err := CalculateSession(ctx, ctx, k, header, blockHash, 5)
+	assert.Nil(t, err)
+	assert.Empty(t, res.Error)
+	assert.False(t, res.StakeWeighted)
+	assert.Equal(t, hex.EncodeToString(session.SessionKey), res.SessionKey)
+	assert.Equal(t, []sdk.Address(session.SessionNodes), res.SessionNodes)
+	// every unjailed node is drawn to fill the session, the jailed one is rejected if drawn
+	seen := make(map[string]bool)
+	for _, c := range res.Candidates {
+		if c.Address.Equals(jailed.Address) {
+			assert.False(t, c.Selected)
+			if !seen[c.Address.String()] {
+				assert.Equal(t, CandidateJailed, c.Reason)
+			}
+		} else if seen[c.Address.String()] {
+			assert.Equal(t, CandidateAlreadyDrawn, c.Reason)
+		}
+		seen[c.Address.String()] = true
+	}
+	assert.GreaterOrEqual(t, len(seen), 5)
+	// not enough nodes is reported with the candidates
+	res, err = CalculateSession(ctx, ctx, k, header, blockHash, 6)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, res.Error)
+	assert.Nil(t, res.SessionNodes)
+	assert.NotEmpty(t, res.Candidates)
+}
+
+func TestCandidateRejection(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	nodes := newWeightedTestNodes(ethereum, 10000, 10000)
+	jailed := nodes[1].(nodesTypes.Validator)
+	jailed.Jailed = true
+	assert.Equal(t, CandidateNotFound, candidateRejection(nil, ethereum, SessionNodes{}))
+	assert.Equal(t, CandidateJailed, candidateRejection(jailed, ethereum, SessionNodes{}))
+	assert.Equal(t, CandidateMissingChain, candidateRejection(nodes[0], bitcoin, SessionNodes{}))
+	assert.Equal(t, CandidateDuplicate, candidateRejection(nodes[0], ethereum, SessionNodes{nodes[0].GetAddress()}))
+	assert.Empty(t, candidateRejection(nodes[0], ethereum, SessionNodes{nodes[1].GetAddress()}))
+}
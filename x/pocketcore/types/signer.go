@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/pokt-network/pocket-core/crypto"
+)
+
+// "Signer" - Signs for a pocket node: its relay responses and its claim and proof transactions.
+// The key may live in the node (KeySigner) or in a separate signer process
+type Signer interface {
+	crypto.Signer
+	// "SignRelayResponse" - Signs the hash of the relay response
+	SignRelayResponse(resp RelayResponse) ([]byte, error)
+}
+
+var _ Signer = KeySigner{}
+
+// "KeySigner" - Signs with a private key loaded in memory
+type KeySigner struct {
+	crypto.PrivateKey
+}
+
+// "SignRelayResponse" - Signs the hash of the relay response
+func (s KeySigner) SignRelayResponse(resp RelayResponse) ([]byte, error) {
+	return s.Sign(resp.Hash())
+}
+
+// "SignerPrivateKey" - Returns the private key of the signer, nil when the key is not held by the node
+func SignerPrivateKey(s Signer) crypto.PrivateKey {
+	if ks, ok := s.(KeySigner); ok {
+		return ks.PrivateKey
+	}
+	return nil
+}